@@ -0,0 +1,142 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type CaBundleClientContext utl.ClientContext
+
+func NewCabundlesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *CaBundleClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewCabundlesClient(connector)
+
+	case utl.Global:
+		client = client1.NewCabundlesClient(connector)
+
+	default:
+		return nil
+	}
+	return &CaBundleClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c CaBundleClientContext) Get(cabundleIdParam string) (model0.CaBundle, error) {
+	var obj model0.CaBundle
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CabundlesClient)
+		obj, err = client.Get(cabundleIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.CabundlesClient)
+		gmObj, err1 := client.Get(cabundleIdParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.CaBundleBindingType(), model0.CaBundleBindingType())
+		obj = rawObj.(model0.CaBundle)
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c CaBundleClientContext) Delete(cabundleIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CabundlesClient)
+		err = client.Delete(cabundleIdParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CabundlesClient)
+		err = client.Delete(cabundleIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c CaBundleClientContext) Update(cabundleIdParam string, caBundleParam model0.CaBundle) (model0.CaBundle, error) {
+	var err error
+	var obj model0.CaBundle
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CabundlesClient)
+		obj, err = client.Update(cabundleIdParam, caBundleParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CabundlesClient)
+		gmObj, err := utl.ConvertModelBindingType(caBundleParam, model0.CaBundleBindingType(), model1.CaBundleBindingType())
+		if err != nil {
+			return obj, err
+		}
+		gmObj, err = client.Update(cabundleIdParam, gmObj.(model1.CaBundle))
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.CaBundleBindingType(), model0.CaBundleBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.CaBundle)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c CaBundleClientContext) List(cursorParam *string, detailsParam *bool, includedFieldsParam *string, nodeIdParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string, type_Param *string) (model0.CaBundleListResult, error) {
+	var err error
+	var obj model0.CaBundleListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CabundlesClient)
+		obj, err = client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+
+	case utl.Global:
+		client := c.Client.(client1.CabundlesClient)
+		gmObj, err := client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.CaBundleListResultBindingType(), model0.CaBundleListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.CaBundleListResult)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -0,0 +1,192 @@
+//nolint:revive
+package groups
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/domains/groups"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains/groups"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client2 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/domains/groups"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PolicyFirewallSessionTimerProfileBindingMapClientContext utl.ClientContext
+
+func NewFirewallSessionTimerProfileBindingMapsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PolicyFirewallSessionTimerProfileBindingMapClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewFirewallSessionTimerProfileBindingMapsClient(connector)
+
+	case utl.Global:
+		client = client1.NewFirewallSessionTimerProfileBindingMapsClient(connector)
+
+	case utl.Multitenancy:
+		client = client2.NewFirewallSessionTimerProfileBindingMapsClient(connector)
+
+	default:
+		return nil
+	}
+	return &PolicyFirewallSessionTimerProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c PolicyFirewallSessionTimerProfileBindingMapClientContext) Get(domainIdParam string, groupIdParam string, firewallSessionTimerProfileBindingMapIdParam string) (model0.PolicyFirewallSessionTimerProfileBindingMap, error) {
+	var obj model0.PolicyFirewallSessionTimerProfileBindingMap
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.Get(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfileBindingMapsClient)
+		gmObj, err1 := client.Get(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileBindingMapBindingType(), model0.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		obj = rawObj.(model0.PolicyFirewallSessionTimerProfileBindingMap)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyFirewallSessionTimerProfileBindingMapClientContext) Delete(domainIdParam string, groupIdParam string, firewallSessionTimerProfileBindingMapIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfileBindingMapsClient)
+		err = client.Delete(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfileBindingMapsClient)
+		err = client.Delete(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfileBindingMapsClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyFirewallSessionTimerProfileBindingMapClientContext) Patch(domainIdParam string, groupIdParam string, firewallSessionTimerProfileBindingMapIdParam string, policyFirewallSessionTimerProfileBindingMapParam model0.PolicyFirewallSessionTimerProfileBindingMap) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfileBindingMapsClient)
+		err = client.Patch(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, policyFirewallSessionTimerProfileBindingMapParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfileBindingMapsClient)
+		gmObj, err1 := utl.ConvertModelBindingType(policyFirewallSessionTimerProfileBindingMapParam, model0.PolicyFirewallSessionTimerProfileBindingMapBindingType(), model1.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		if err1 != nil {
+			return err1
+		}
+		err = client.Patch(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, gmObj.(model1.PolicyFirewallSessionTimerProfileBindingMap))
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfileBindingMapsClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, policyFirewallSessionTimerProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyFirewallSessionTimerProfileBindingMapClientContext) Update(domainIdParam string, groupIdParam string, firewallSessionTimerProfileBindingMapIdParam string, policyFirewallSessionTimerProfileBindingMapParam model0.PolicyFirewallSessionTimerProfileBindingMap) (model0.PolicyFirewallSessionTimerProfileBindingMap, error) {
+	var err error
+	var obj model0.PolicyFirewallSessionTimerProfileBindingMap
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.Update(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, policyFirewallSessionTimerProfileBindingMapParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfileBindingMapsClient)
+		gmObj, err := utl.ConvertModelBindingType(policyFirewallSessionTimerProfileBindingMapParam, model0.PolicyFirewallSessionTimerProfileBindingMapBindingType(), model1.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		if err != nil {
+			return obj, err
+		}
+		gmObj, err = client.Update(domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, gmObj.(model1.PolicyFirewallSessionTimerProfileBindingMap))
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileBindingMapBindingType(), model0.PolicyFirewallSessionTimerProfileBindingMapBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.PolicyFirewallSessionTimerProfileBindingMap)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, firewallSessionTimerProfileBindingMapIdParam, policyFirewallSessionTimerProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyFirewallSessionTimerProfileBindingMapClientContext) List(domainIdParam string, groupIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PolicyFirewallSessionTimerProfileBindingMapListResult, error) {
+	var err error
+	var obj model0.PolicyFirewallSessionTimerProfileBindingMapListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.List(domainIdParam, groupIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfileBindingMapsClient)
+		gmObj, err := client.List(domainIdParam, groupIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileBindingMapListResultBindingType(), model0.PolicyFirewallSessionTimerProfileBindingMapListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.PolicyFirewallSessionTimerProfileBindingMapListResult)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfileBindingMapsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, domainIdParam, groupIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
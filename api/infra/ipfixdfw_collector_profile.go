@@ -0,0 +1,110 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type IPFIXDFWCollectorProfileClientContext utl.ClientContext
+
+func NewIpfixDfwCollectorProfilesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *IPFIXDFWCollectorProfileClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewIpfixDfwCollectorProfilesClient(connector)
+
+	default:
+		return nil
+	}
+	return &IPFIXDFWCollectorProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c IPFIXDFWCollectorProfileClientContext) Get(ipfixDfwCollectorProfileIdParam string) (model0.IPFIXDFWCollectorProfile, error) {
+	var obj model0.IPFIXDFWCollectorProfile
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwCollectorProfilesClient)
+		obj, err = client.Get(ipfixDfwCollectorProfileIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c IPFIXDFWCollectorProfileClientContext) Delete(ipfixDfwCollectorProfileIdParam string, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwCollectorProfilesClient)
+		err = client.Delete(ipfixDfwCollectorProfileIdParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c IPFIXDFWCollectorProfileClientContext) Patch(ipfixDfwCollectorProfileIdParam string, iPFIXDFWCollectorProfileParam model0.IPFIXDFWCollectorProfile, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwCollectorProfilesClient)
+		err = client.Patch(ipfixDfwCollectorProfileIdParam, iPFIXDFWCollectorProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c IPFIXDFWCollectorProfileClientContext) Update(ipfixDfwCollectorProfileIdParam string, iPFIXDFWCollectorProfileParam model0.IPFIXDFWCollectorProfile, overrideParam *bool) (model0.IPFIXDFWCollectorProfile, error) {
+	var err error
+	var obj model0.IPFIXDFWCollectorProfile
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwCollectorProfilesClient)
+		obj, err = client.Update(ipfixDfwCollectorProfileIdParam, iPFIXDFWCollectorProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c IPFIXDFWCollectorProfileClientContext) List(cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.IPFIXDFWCollectorProfileListResult, error) {
+	var err error
+	var obj model0.IPFIXDFWCollectorProfileListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwCollectorProfilesClient)
+		obj, err = client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
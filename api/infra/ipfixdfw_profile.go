@@ -0,0 +1,110 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type IPFIXDFWProfileClientContext utl.ClientContext
+
+func NewIpfixDfwProfilesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *IPFIXDFWProfileClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewIpfixDfwProfilesClient(connector)
+
+	default:
+		return nil
+	}
+	return &IPFIXDFWProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c IPFIXDFWProfileClientContext) Get(ipfixDfwProfileIdParam string) (model0.IPFIXDFWProfile, error) {
+	var obj model0.IPFIXDFWProfile
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwProfilesClient)
+		obj, err = client.Get(ipfixDfwProfileIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c IPFIXDFWProfileClientContext) Delete(ipfixDfwProfileIdParam string, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwProfilesClient)
+		err = client.Delete(ipfixDfwProfileIdParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c IPFIXDFWProfileClientContext) Patch(ipfixDfwProfileIdParam string, iPFIXDFWProfileParam model0.IPFIXDFWProfile, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwProfilesClient)
+		err = client.Patch(ipfixDfwProfileIdParam, iPFIXDFWProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c IPFIXDFWProfileClientContext) Update(ipfixDfwProfileIdParam string, iPFIXDFWProfileParam model0.IPFIXDFWProfile, overrideParam *bool) (model0.IPFIXDFWProfile, error) {
+	var err error
+	var obj model0.IPFIXDFWProfile
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwProfilesClient)
+		obj, err = client.Update(ipfixDfwProfileIdParam, iPFIXDFWProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c IPFIXDFWProfileClientContext) List(cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.IPFIXDFWProfileListResult, error) {
+	var err error
+	var obj model0.IPFIXDFWProfileListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.IpfixDfwProfilesClient)
+		obj, err = client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
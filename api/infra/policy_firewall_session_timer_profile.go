@@ -0,0 +1,192 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client2 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PolicyFirewallSessionTimerProfileClientContext utl.ClientContext
+
+func NewFirewallSessionTimerProfilesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PolicyFirewallSessionTimerProfileClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewFirewallSessionTimerProfilesClient(connector)
+
+	case utl.Global:
+		client = client1.NewFirewallSessionTimerProfilesClient(connector)
+
+	case utl.Multitenancy:
+		client = client2.NewFirewallSessionTimerProfilesClient(connector)
+
+	default:
+		return nil
+	}
+	return &PolicyFirewallSessionTimerProfileClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c PolicyFirewallSessionTimerProfileClientContext) Get(firewallSessionTimerProfileIdParam string) (model0.PolicyFirewallSessionTimerProfile, error) {
+	var obj model0.PolicyFirewallSessionTimerProfile
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfilesClient)
+		obj, err = client.Get(firewallSessionTimerProfileIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfilesClient)
+		gmObj, err1 := client.Get(firewallSessionTimerProfileIdParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileBindingType(), model0.PolicyFirewallSessionTimerProfileBindingType())
+		obj = rawObj.(model0.PolicyFirewallSessionTimerProfile)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfilesClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, firewallSessionTimerProfileIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyFirewallSessionTimerProfileClientContext) Delete(firewallSessionTimerProfileIdParam string, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfilesClient)
+		err = client.Delete(firewallSessionTimerProfileIdParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfilesClient)
+		err = client.Delete(firewallSessionTimerProfileIdParam, overrideParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfilesClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, firewallSessionTimerProfileIdParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyFirewallSessionTimerProfileClientContext) Patch(firewallSessionTimerProfileIdParam string, policyFirewallSessionTimerProfileParam model0.PolicyFirewallSessionTimerProfile, overrideParam *bool) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfilesClient)
+		err = client.Patch(firewallSessionTimerProfileIdParam, policyFirewallSessionTimerProfileParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfilesClient)
+		gmObj, err1 := utl.ConvertModelBindingType(policyFirewallSessionTimerProfileParam, model0.PolicyFirewallSessionTimerProfileBindingType(), model1.PolicyFirewallSessionTimerProfileBindingType())
+		if err1 != nil {
+			return err1
+		}
+		err = client.Patch(firewallSessionTimerProfileIdParam, gmObj.(model1.PolicyFirewallSessionTimerProfile), overrideParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfilesClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, firewallSessionTimerProfileIdParam, policyFirewallSessionTimerProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PolicyFirewallSessionTimerProfileClientContext) Update(firewallSessionTimerProfileIdParam string, policyFirewallSessionTimerProfileParam model0.PolicyFirewallSessionTimerProfile, overrideParam *bool) (model0.PolicyFirewallSessionTimerProfile, error) {
+	var err error
+	var obj model0.PolicyFirewallSessionTimerProfile
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfilesClient)
+		obj, err = client.Update(firewallSessionTimerProfileIdParam, policyFirewallSessionTimerProfileParam, overrideParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfilesClient)
+		gmObj, err := utl.ConvertModelBindingType(policyFirewallSessionTimerProfileParam, model0.PolicyFirewallSessionTimerProfileBindingType(), model1.PolicyFirewallSessionTimerProfileBindingType())
+		if err != nil {
+			return obj, err
+		}
+		gmObj, err = client.Update(firewallSessionTimerProfileIdParam, gmObj.(model1.PolicyFirewallSessionTimerProfile), overrideParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileBindingType(), model0.PolicyFirewallSessionTimerProfileBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.PolicyFirewallSessionTimerProfile)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfilesClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, firewallSessionTimerProfileIdParam, policyFirewallSessionTimerProfileParam, overrideParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PolicyFirewallSessionTimerProfileClientContext) List(cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PolicyFirewallSessionTimerProfileListResult, error) {
+	var err error
+	var obj model0.PolicyFirewallSessionTimerProfileListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.FirewallSessionTimerProfilesClient)
+		obj, err = client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Global:
+		client := c.Client.(client1.FirewallSessionTimerProfilesClient)
+		gmObj, err := client.List(cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.PolicyFirewallSessionTimerProfileListResultBindingType(), model0.PolicyFirewallSessionTimerProfileListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.PolicyFirewallSessionTimerProfileListResult)
+
+	case utl.Multitenancy:
+		client := c.Client.(client2.FirewallSessionTimerProfilesClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -0,0 +1,137 @@
+//nolint:revive
+package ports
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/segments/ports"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/infra/segments/ports"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type PortDiscoveryProfileBindingMapClientContext utl.ClientContext
+
+func NewPortDiscoveryProfileBindingMapsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *PortDiscoveryProfileBindingMapClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewPortDiscoveryProfileBindingMapsClient(connector)
+
+	case utl.Multitenancy:
+		client = client1.NewPortDiscoveryProfileBindingMapsClient(connector)
+
+	default:
+		return nil
+	}
+	return &PortDiscoveryProfileBindingMapClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c PortDiscoveryProfileBindingMapClientContext) Get(infraSegmentIdParam string, infraPortIdParam string, portDiscoveryProfileBindingMapIdParam string) (model0.PortDiscoveryProfileBindingMap, error) {
+	var obj model0.PortDiscoveryProfileBindingMap
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.Get(infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam)
+		if err != nil {
+			return obj, err
+		}
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PortDiscoveryProfileBindingMapClientContext) Delete(infraSegmentIdParam string, infraPortIdParam string, portDiscoveryProfileBindingMapIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortDiscoveryProfileBindingMapsClient)
+		err = client.Delete(infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortDiscoveryProfileBindingMapsClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PortDiscoveryProfileBindingMapClientContext) Patch(infraSegmentIdParam string, infraPortIdParam string, portDiscoveryProfileBindingMapIdParam string, portDiscoveryProfileBindingMapParam model0.PortDiscoveryProfileBindingMap) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortDiscoveryProfileBindingMapsClient)
+		err = client.Patch(infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam, portDiscoveryProfileBindingMapParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortDiscoveryProfileBindingMapsClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam, portDiscoveryProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c PortDiscoveryProfileBindingMapClientContext) Update(infraSegmentIdParam string, infraPortIdParam string, portDiscoveryProfileBindingMapIdParam string, portDiscoveryProfileBindingMapParam model0.PortDiscoveryProfileBindingMap) (model0.PortDiscoveryProfileBindingMap, error) {
+	var err error
+	var obj model0.PortDiscoveryProfileBindingMap
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.Update(infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam, portDiscoveryProfileBindingMapParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.Update(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, portDiscoveryProfileBindingMapIdParam, portDiscoveryProfileBindingMapParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c PortDiscoveryProfileBindingMapClientContext) List(infraSegmentIdParam string, infraPortIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.PortDiscoveryProfileBindingMapListResult, error) {
+	var err error
+	var obj model0.PortDiscoveryProfileBindingMapListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.List(infraSegmentIdParam, infraPortIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortDiscoveryProfileBindingMapsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, infraSegmentIdParam, infraPortIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -32,26 +32,51 @@ func NewPortsClient(sessionContext utl.SessionContext, connector vapiProtocolCli
 	return &SegmentPortClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
 }
 
-func (c SegmentPortClientContext) List(tier1IdParam string, segmentIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.SegmentPortListResult, error) {
+func (c SegmentPortClientContext) Get(tier1IdParam string, segmentIdParam string, portIdParam string) (model0.SegmentPort, error) {
+	var obj model0.SegmentPort
 	var err error
-	var obj model0.SegmentPortListResult
 
 	switch c.ClientType {
 
 	case utl.Local:
 		client := c.Client.(client0.PortsClient)
-		obj, err = client.List(tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.Get(tier1IdParam, segmentIdParam, portIdParam)
+		if err != nil {
+			return obj, err
+		}
 
 	case utl.Multitenancy:
 		client := c.Client.(client1.PortsClient)
-		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+		obj, err = client.Get(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, portIdParam)
+		if err != nil {
+			return obj, err
+		}
 
 	default:
-		err = errors.New("invalid infrastructure for model")
+		return obj, errors.New("invalid infrastructure for model")
 	}
 	return obj, err
 }
 
+func (c SegmentPortClientContext) Patch(tier1IdParam string, segmentIdParam string, portIdParam string, segmentPortParam model0.SegmentPort) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortsClient)
+		err = client.Patch(tier1IdParam, segmentIdParam, portIdParam, segmentPortParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortsClient)
+		err = client.Patch(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, portIdParam, segmentPortParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
 func (c SegmentPortClientContext) Update(tier1IdParam string, segmentIdParam string, portIdParam string, segmentPortParam model0.SegmentPort) (model0.SegmentPort, error) {
 	var err error
 	var obj model0.SegmentPort
@@ -71,3 +96,42 @@ func (c SegmentPortClientContext) Update(tier1IdParam string, segmentIdParam str
 	}
 	return obj, err
 }
+
+func (c SegmentPortClientContext) Delete(tier1IdParam string, segmentIdParam string, portIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortsClient)
+		err = client.Delete(tier1IdParam, segmentIdParam, portIdParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortsClient)
+		err = client.Delete(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, portIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c SegmentPortClientContext) List(tier1IdParam string, segmentIdParam string, cursorParam *string, includeMarkForDeleteObjectsParam *bool, includedFieldsParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string) (model0.SegmentPortListResult, error) {
+	var err error
+	var obj model0.SegmentPortListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.PortsClient)
+		obj, err = client.List(tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	case utl.Multitenancy:
+		client := c.Client.(client1.PortsClient)
+		obj, err = client.List(utl.DefaultOrgID, c.ProjectID, tier1IdParam, segmentIdParam, cursorParam, includeMarkForDeleteObjectsParam, includedFieldsParam, pageSizeParam, sortAscendingParam, sortByParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -0,0 +1,110 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type TlsCertificateClientContext utl.ClientContext
+
+func NewCertificatesClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *TlsCertificateClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewCertificatesClient(connector)
+
+	case utl.Global:
+		client = client1.NewCertificatesClient(connector)
+
+	default:
+		return nil
+	}
+	return &TlsCertificateClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c TlsCertificateClientContext) Get(certificateIdParam string, detailsParam *bool) (model0.TlsCertificate, error) {
+	var obj model0.TlsCertificate
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CertificatesClient)
+		obj, err = client.Get(certificateIdParam, detailsParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.CertificatesClient)
+		gmObj, err1 := client.Get(certificateIdParam, detailsParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.TlsCertificateBindingType(), model0.TlsCertificateBindingType())
+		obj = rawObj.(model0.TlsCertificate)
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c TlsCertificateClientContext) Delete(certificateIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CertificatesClient)
+		err = client.Delete(certificateIdParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CertificatesClient)
+		err = client.Delete(certificateIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c TlsCertificateClientContext) List(cursorParam *string, detailsParam *bool, includedFieldsParam *string, nodeIdParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string, type_Param *string) (model0.TlsCertificateList, error) {
+	var err error
+	var obj model0.TlsCertificateList
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CertificatesClient)
+		obj, err = client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+
+	case utl.Global:
+		client := c.Client.(client1.CertificatesClient)
+		gmObj, err := client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.TlsCertificateListBindingType(), model0.TlsCertificateListBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.TlsCertificateList)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
@@ -0,0 +1,165 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	vapiProtocolClient_ "github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type TlsCrlClientContext utl.ClientContext
+
+func NewCrlsClient(sessionContext utl.SessionContext, connector vapiProtocolClient_.Connector) *TlsCrlClientContext {
+	var client interface{}
+
+	switch sessionContext.ClientType {
+
+	case utl.Local:
+		client = client0.NewCrlsClient(connector)
+
+	case utl.Global:
+		client = client1.NewCrlsClient(connector)
+
+	default:
+		return nil
+	}
+	return &TlsCrlClientContext{Client: client, ClientType: sessionContext.ClientType, ProjectID: sessionContext.ProjectID, VPCID: sessionContext.VPCID}
+}
+
+func (c TlsCrlClientContext) Get(crlIdParam string, detailsParam *bool) (model0.TlsCrl, error) {
+	var obj model0.TlsCrl
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CrlsClient)
+		obj, err = client.Get(crlIdParam, detailsParam)
+		if err != nil {
+			return obj, err
+		}
+
+	case utl.Global:
+		client := c.Client.(client1.CrlsClient)
+		gmObj, err1 := client.Get(crlIdParam, detailsParam)
+		if err1 != nil {
+			return obj, err1
+		}
+		var rawObj interface{}
+		rawObj, err = utl.ConvertModelBindingType(gmObj, model1.TlsCrlBindingType(), model0.TlsCrlBindingType())
+		obj = rawObj.(model0.TlsCrl)
+
+	default:
+		return obj, errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c TlsCrlClientContext) Delete(crlIdParam string) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CrlsClient)
+		err = client.Delete(crlIdParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CrlsClient)
+		err = client.Delete(crlIdParam)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c TlsCrlClientContext) Patch(crlIdParam string, tlsCrlParam model0.TlsCrl) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CrlsClient)
+		err = client.Patch(crlIdParam, tlsCrlParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CrlsClient)
+		gmObj, err1 := utl.ConvertModelBindingType(tlsCrlParam, model0.TlsCrlBindingType(), model1.TlsCrlBindingType())
+		if err1 != nil {
+			return err1
+		}
+		err = client.Patch(crlIdParam, gmObj.(model1.TlsCrl))
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
+
+func (c TlsCrlClientContext) Update(crlIdParam string, tlsCrlParam model0.TlsCrl) (model0.TlsCrl, error) {
+	var err error
+	var obj model0.TlsCrl
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CrlsClient)
+		obj, err = client.Update(crlIdParam, tlsCrlParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CrlsClient)
+		gmObj, err := utl.ConvertModelBindingType(tlsCrlParam, model0.TlsCrlBindingType(), model1.TlsCrlBindingType())
+		if err != nil {
+			return obj, err
+		}
+		gmObj, err = client.Update(crlIdParam, gmObj.(model1.TlsCrl))
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.TlsCrlBindingType(), model0.TlsCrlBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.TlsCrl)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
+
+func (c TlsCrlClientContext) List(cursorParam *string, detailsParam *bool, includedFieldsParam *string, nodeIdParam *string, pageSizeParam *int64, sortAscendingParam *bool, sortByParam *string, type_Param *string) (model0.TlsCrlListResult, error) {
+	var err error
+	var obj model0.TlsCrlListResult
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CrlsClient)
+		obj, err = client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+
+	case utl.Global:
+		client := c.Client.(client1.CrlsClient)
+		gmObj, err := client.List(cursorParam, detailsParam, includedFieldsParam, nodeIdParam, pageSizeParam, sortAscendingParam, sortByParam, type_Param)
+		if err != nil {
+			return obj, err
+		}
+		obj1, err1 := utl.ConvertModelBindingType(gmObj, model1.TlsCrlListResultBindingType(), model0.TlsCrlListResultBindingType())
+		if err1 != nil {
+			return obj, err1
+		}
+		obj = obj1.(model0.TlsCrlListResult)
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return obj, err
+}
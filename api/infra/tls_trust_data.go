@@ -0,0 +1,39 @@
+//nolint:revive
+package infra
+
+// The following file has been autogenerated. Please avoid any changes!
+import (
+	"errors"
+
+	client1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra"
+	model1 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	client0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	model0 "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+type TlsTrustDataClientContext utl.ClientContext
+
+func (c TlsTrustDataClientContext) Patch(certificateIdParam string, tlsTrustDataParam model0.TlsTrustData) error {
+	var err error
+
+	switch c.ClientType {
+
+	case utl.Local:
+		client := c.Client.(client0.CertificatesClient)
+		err = client.Patch(certificateIdParam, tlsTrustDataParam)
+
+	case utl.Global:
+		client := c.Client.(client1.CertificatesClient)
+		gmObj, err1 := utl.ConvertModelBindingType(tlsTrustDataParam, model0.TlsTrustDataBindingType(), model1.TlsTrustDataBindingType())
+		if err1 != nil {
+			return err1
+		}
+		err = client.Patch(certificateIdParam, gmObj.(model1.TlsTrustData))
+
+	default:
+		err = errors.New("invalid infrastructure for model")
+	}
+	return err
+}
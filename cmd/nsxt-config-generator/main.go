@@ -0,0 +1,53 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// nsxt-config-generator generates terraform configuration and import blocks
+// for existing NSX policy objects. Connection to NSX is configured with same
+// environment variables as the provider, such as NSXT_MANAGER_HOST,
+// NSXT_USERNAME and NSXT_PASSWORD.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/terraform-provider-nsxt/nsxt"
+)
+
+func main() {
+	var projectID string
+	var outputDir string
+	flag.StringVar(&projectID, "project", "", "generate configuration for objects in this project instead of /infra")
+	flag.StringVar(&outputDir, "output", ".", "directory to write generated .tf files to")
+	flag.Parse()
+
+	ctx := context.Background()
+	provider := nsxt.Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		log.Fatalf("Failed to configure provider: %s", diags[0].Summary)
+	}
+
+	result, err := nsxt.GeneratePolicyConfiguration(ctx, provider, projectID)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Fatal(err.Error())
+	}
+	for name, content := range result.Files {
+		fileName := filepath.Join(outputDir, name)
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			log.Fatal(err.Error())
+		}
+		fmt.Printf("Generated %s\n", fileName)
+	}
+}
@@ -0,0 +1,71 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// nsxt-mp-migration maps deprecated Manager objects that were promoted to
+// Policy to their Policy paths, and generates Policy configuration with
+// import and removed blocks. Connection to NSX is configured with same
+// environment variables as the provider, such as NSXT_MANAGER_HOST,
+// NSXT_USERNAME and NSXT_PASSWORD.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/terraform-provider-nsxt/nsxt"
+)
+
+func main() {
+	var stateFile string
+	var outputDir string
+	var dryRun bool
+	flag.StringVar(&stateFile, "state", "", "terraform state file to take Manager resources from, instead of looking them up on NSX")
+	flag.StringVar(&outputDir, "output", ".", "directory to write generated .tf files to")
+	flag.BoolVar(&dryRun, "dry-run", false, "only print mapping of Manager objects to Policy objects")
+	flag.Parse()
+
+	var state []byte
+	if stateFile != "" {
+		var err error
+		state, err = os.ReadFile(stateFile)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+
+	ctx := context.Background()
+	provider := nsxt.Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		log.Fatalf("Failed to configure provider: %s", diags[0].Summary)
+	}
+
+	result, err := nsxt.GenerateMPMigration(ctx, provider, state)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+	for _, mapping := range result.Mappings {
+		fmt.Printf("%s (%s) -> %s (%s)\n", mapping.Source, mapping.ID, mapping.Target, mapping.PolicyPath)
+	}
+	if dryRun {
+		return
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		log.Fatal(err.Error())
+	}
+	for name, content := range result.Files {
+		fileName := filepath.Join(outputDir, name)
+		if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+			log.Fatal(err.Error())
+		}
+		fmt.Printf("Generated %s\n", fileName)
+	}
+}
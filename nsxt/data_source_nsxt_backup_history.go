@@ -0,0 +1,103 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster/backups"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func getBackupOperationStatusSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backup_id": {
+					Type:        schema.TypeString,
+					Description: "Unique identifier of the backup",
+					Computed:    true,
+				},
+				"start_time": {
+					Type:        schema.TypeInt,
+					Description: "Time when backup was started, in epoch milliseconds",
+					Computed:    true,
+				},
+				"end_time": {
+					Type:        schema.TypeInt,
+					Description: "Time when backup was completed, in epoch milliseconds",
+					Computed:    true,
+				},
+				"success": {
+					Type:        schema.TypeBool,
+					Description: "Whether backup succeeded",
+					Computed:    true,
+				},
+				"error_code": {
+					Type:        schema.TypeString,
+					Description: "Error code of failed backup",
+					Computed:    true,
+				},
+				"error_message": {
+					Type:        schema.TypeString,
+					Description: "Error message of failed backup",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNsxtBackupHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtBackupHistoryRead),
+
+		Schema: map[string]*schema.Schema{
+			"id": getDataSourceIDSchema(),
+			"overall_backup_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the latest backup operation",
+				Computed:    true,
+			},
+			"cluster_backup":   getBackupOperationStatusSchema("Statuses of previous cluster backups"),
+			"node_backup":      getBackupOperationStatusSchema("Statuses of previous node backups"),
+			"inventory_backup": getBackupOperationStatusSchema("Statuses of previous inventory backups"),
+		},
+	}
+}
+
+func getBackupOperationStatusesForSchema(statuses []nsxModel.BackupOperationStatus) []interface{} {
+	var result []interface{}
+	for _, status := range statuses {
+		elem := make(map[string]interface{})
+		elem["backup_id"] = status.BackupId
+		elem["start_time"] = status.StartTime
+		elem["end_time"] = status.EndTime
+		elem["success"] = status.Success
+		elem["error_code"] = status.ErrorCode
+		elem["error_message"] = status.ErrorMessage
+		result = append(result, elem)
+	}
+	return result
+}
+
+func dataSourceNsxtBackupHistoryRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := backups.NewHistoryClient(connector)
+
+	obj, err := client.Get()
+	if err != nil {
+		return handleDataSourceReadError(d, "BackupHistory", "", err)
+	}
+
+	d.SetId(newUUID())
+	d.Set("overall_backup_status", obj.OverallBackupStatus)
+	d.Set("cluster_backup", getBackupOperationStatusesForSchema(obj.ClusterBackupStatuses))
+	d.Set("node_backup", getBackupOperationStatusesForSchema(obj.NodeBackupStatuses))
+	d.Set("inventory_backup", getBackupOperationStatusesForSchema(obj.InventoryBackupStatuses))
+
+	return nil
+}
@@ -0,0 +1,70 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtBackupHistory_basic(t *testing.T) {
+	testResourceName := "data.nsxt_backup_history.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nsxt_backup_history" "test" {
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "overall_backup_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestBackupHistorySimulator(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	srv.SeedManagerConfig("/api/v1/cluster/backups/history", map[string]interface{}{
+		"overall_backup_status": "FAILED",
+		"cluster_backup_statuses": []interface{}{
+			map[string]interface{}{"backup_id": "c1", "start_time": 1700000000000, "end_time": 1700000060000, "success": true},
+		},
+		"node_backup_statuses": []interface{}{
+			map[string]interface{}{"backup_id": "n1", "success": false, "error_code": "BACKUP_SERVER_UNREACHABLE", "error_message": "Server unreachable"},
+		},
+	})
+
+	r := dataSourceNsxtBackupHistory()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+	if diags := r.ReadContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	if d.Get("overall_backup_status") != "FAILED" {
+		t.Errorf("unexpected overall status %v", d.Get("overall_backup_status"))
+	}
+	if d.Get("cluster_backup.#") != 1 || d.Get("cluster_backup.0.backup_id") != "c1" || d.Get("cluster_backup.0.success") != true {
+		t.Errorf("unexpected cluster backups %v", d.Get("cluster_backup"))
+	}
+	if d.Get("cluster_backup.0.end_time") != 1700000060000 {
+		t.Errorf("unexpected end time %v", d.Get("cluster_backup.0.end_time"))
+	}
+	if d.Get("node_backup.0.error_code") != "BACKUP_SERVER_UNREACHABLE" || d.Get("node_backup.0.success") != false {
+		t.Errorf("unexpected node backups %v", d.Get("node_backup"))
+	}
+	if d.Get("inventory_backup.#") != 0 {
+		t.Errorf("expected no inventory backups, got %v", d.Get("inventory_backup"))
+	}
+}
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtCertificateRead,
+		ReadContext:        withContext(dataSourceNsxtCertificateRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtComputeCollection() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeCollectionRead,
+		ReadContext: withContext(dataSourceNsxtComputeCollectionRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtComputeManager() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeManagerRead,
+		ReadContext: withContext(dataSourceNsxtComputeManagerRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -18,7 +18,7 @@ import (
 
 func dataSourceNsxtComputeManagerRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtComputeManagerRealizationRead,
+		ReadContext: withContext(dataSourceNsxtComputeManagerRealizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -65,16 +65,16 @@ func dataSourceNsxtComputeManagerRealizationRead(d *schema.ResourceData, m inter
 
 	checkRegistration := d.Get("check_registration").(bool)
 
-	err := dataSourceNsxtComputeManagerRealizationWait(d, connector)
+	err := dataSourceNsxtComputeManagerRealizationWait(d, m, connector)
 
 	if !checkRegistration {
 		return err
 	}
 
-	return dataSourceNsxtComputeManagerRegistrationWait(d, connector)
+	return dataSourceNsxtComputeManagerRegistrationWait(d, m, connector)
 }
 
-func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, connector client.Connector) error {
+func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, m interface{}, connector client.Connector) error {
 	id := d.Get("id").(string)
 	delay := d.Get("delay").(int)
 	timeout := d.Get("timeout").(int)
@@ -116,14 +116,14 @@ func dataSourceNsxtComputeManagerRealizationWait(d *schema.ResourceData, connect
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func dataSourceNsxtComputeManagerRegistrationWait(d *schema.ResourceData, connector client.Connector) error {
+func dataSourceNsxtComputeManagerRegistrationWait(d *schema.ResourceData, m interface{}, connector client.Connector) error {
 	id := d.Get("id").(string)
 	delay := d.Get("delay").(int)
 	timeout := d.Get("timeout").(int)
@@ -158,7 +158,7 @@ func dataSourceNsxtComputeManagerRegistrationWait(d *schema.ResourceData, connec
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("failed to get registration information for %s: %v", id, err)
 	}
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtDiscoveredNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtDiscoveredNodeRead,
+		ReadContext: withContext(dataSourceNsxtDiscoveredNodeRead),
 		Schema: map[string]*schema.Schema{
 			"compute_manager_state": {
 				Type:        schema.TypeString,
@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtEdgeClusterRead,
+		ReadContext:        withContext(dataSourceNsxtEdgeClusterRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -20,7 +20,7 @@ var (
 
 func dataSourceNsxtEdgeUpgradeGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtEdgeUpgradeGroupRead,
+		ReadContext: withContext(dataSourceNsxtEdgeUpgradeGroupRead),
 
 		Schema: map[string]*schema.Schema{
 			"upgrade_prepare_id": {
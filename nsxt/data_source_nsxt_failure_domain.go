@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtFailureDomain() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtFailureDomainRead,
+		ReadContext: withContext(dataSourceNsxtFailureDomainRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtFirewallSection() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtFirewallSectionRead,
+		ReadContext:        withContext(dataSourceNsxtFirewallSectionRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
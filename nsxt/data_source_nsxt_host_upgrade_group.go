@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtHostUpgradeGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtHostUpgradeGroupRead,
+		ReadContext: withContext(dataSourceNsxtHostUpgradeGroupRead),
 
 		Schema: map[string]*schema.Schema{
 			"upgrade_prepare_id": {
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtIPPool() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtIPPoolRead,
+		ReadContext:        withContext(dataSourceNsxtIPPoolRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtLogicalTier0Router() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtLogicalTier0RouterRead,
+		ReadContext:        withContext(dataSourceNsxtLogicalTier0RouterRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtLogicalTier1Router() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtLogicalTier1RouterRead,
+		ReadContext:        withContext(dataSourceNsxtLogicalTier1RouterRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtMacPool() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtMacPoolRead,
+		ReadContext:        withContext(dataSourceNsxtMacPoolRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
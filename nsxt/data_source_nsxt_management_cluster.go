@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtManagementCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtManagementClusterRead,
+		ReadContext: withContext(dataSourceNsxtManagementClusterRead),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
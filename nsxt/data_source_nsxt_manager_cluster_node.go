@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtManagerClusterNode() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtManagerClusterNodeRead,
+		ReadContext:        withContext(dataSourceNsxtManagerClusterNodeRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
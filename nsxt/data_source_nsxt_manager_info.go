@@ -13,7 +13,7 @@ const nsxVersionID = "nsxversion"
 
 func dataSourceNsxtManagerInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtManagerInfoRead,
+		ReadContext: withContext(dataSourceNsxtManagerInfoRead),
 		Schema: map[string]*schema.Schema{
 			"version": {
 				Type:        schema.TypeString,
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtNsGroup() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsGroupRead,
+		ReadContext:        withContext(dataSourceNsxtNsGroupRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtNsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsGroupsRead,
+		ReadContext:        withContext(dataSourceNsxtNsGroupsRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"items": {
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtNsService() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsServiceRead,
+		ReadContext:        withContext(dataSourceNsxtNsServiceRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
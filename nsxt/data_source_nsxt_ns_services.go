@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtNsServices() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtNsServicesRead,
+		ReadContext:        withContext(dataSourceNsxtNsServicesRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"items": {
@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyBfdProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyBfdProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyBfdProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
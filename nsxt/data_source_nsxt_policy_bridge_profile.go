@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyBridgeProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyBridgeProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyBridgeProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
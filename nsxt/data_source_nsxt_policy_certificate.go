@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyCertificate() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyCertificateRead,
+		ReadContext: withContext(dataSourceNsxtPolicyCertificateRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
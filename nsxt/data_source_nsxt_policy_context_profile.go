@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyContextProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyContextProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyDhcpServerRead,
+		ReadContext: withContext(dataSourceNsxtPolicyDhcpServerRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyDistributedFloodProtectionProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyDistributedFloodProtectionProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyDistributedFloodProtectionProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeClusterRead,
+		ReadContext: withContext(dataSourceNsxtPolicyEdgeClusterRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
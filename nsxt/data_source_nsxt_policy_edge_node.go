@@ -19,7 +19,7 @@ import (
 
 func dataSourceNsxtPolicyEdgeNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyEdgeNodeRead,
+		ReadContext: withContext(dataSourceNsxtPolicyEdgeNodeRead),
 
 		Schema: map[string]*schema.Schema{
 			"edge_cluster_path": getPolicyPathSchema(true, false, "Edge cluster Path"),
@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayDNSForwarderRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayDNSForwarderRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyGatewayFloodProtectionProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayFloodProtectionProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayFloodProtectionProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -18,7 +18,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayInterfaceRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayInterfaceRealizationRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayInterfaceRealizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":      getDataSourceIDSchema(),
@@ -157,7 +157,7 @@ func dataSourceNsxtPolicyGatewayInterfaceRealizationRead(d *schema.ResourceData,
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to get gateway interface realization information for %s: %v", gatewayPath, err)
 	}
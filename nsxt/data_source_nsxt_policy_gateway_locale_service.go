@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayLocaleService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayLocaleServiceRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayLocaleServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"gateway_path": getPolicyPathSchema(true, true, "Gateway path"),
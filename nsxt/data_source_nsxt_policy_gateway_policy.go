@@ -22,7 +22,7 @@ var gatewayPolicyCategoryValues = []string{"Emergency", "SystemRules", "SharedPr
 
 func dataSourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayPolicyRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayPolicyRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -30,7 +30,7 @@ func dataSourceNsxtPolicyGatewayPolicy() *schema.Resource {
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"domain":       getDataSourceDomainNameSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 			"category": {
 				Type:         schema.TypeString,
 				Description:  "Category",
@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayPrefixList() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayPrefixListRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayPrefixListRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyGatewayQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayQosProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayQosProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
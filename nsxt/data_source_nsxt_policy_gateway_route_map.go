@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyGatewayRouteMap() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGatewayRouteMapRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGatewayRouteMapRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -5,11 +5,13 @@ package nsxt
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
 )
 
 func dataSourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyGroupRead,
+		ReadContext: withContext(dataSourceNsxtPolicyGroupRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -17,16 +19,20 @@ func dataSourceNsxtPolicyGroup() *schema.Resource {
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
 			"domain":       getDomainNameSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
 
 func dataSourceNsxtPolicyGroupRead(d *schema.ResourceData, m interface{}) error {
 	domain := d.Get("domain").(string)
+	context := getSessionContext(d, m)
 	query := make(map[string]string)
-	query["parent_path"] = "*/" + domain
-	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), getSessionContext(d, m), "Group", query)
+	if context.ClientType != utl.VPC {
+		// VPC groups are not placed under domain
+		query["parent_path"] = "*/" + domain
+	}
+	_, err := policyDataSourceResourceRead(d, getPolicyConnector(m), context, "Group", query)
 	if err != nil {
 		return err
 	}
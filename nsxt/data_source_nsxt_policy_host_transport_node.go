@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeRead,
+		ReadContext: withContext(dataSourceNsxtPolicyHostTransportNodeRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
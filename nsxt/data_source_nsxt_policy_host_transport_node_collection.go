@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeCollectionRead,
+		ReadContext: withContext(dataSourceNsxtPolicyHostTransportNodeCollectionRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -17,7 +17,7 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNodeCollectionRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead,
+		ReadContext: withContext(dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -103,7 +103,7 @@ func dataSourceNsxtPolicyHostTransportNodeCollectionRealizationRead(d *schema.Re
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return err
 	}
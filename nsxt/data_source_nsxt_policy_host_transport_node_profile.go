@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyHostTransportNodeProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyHostTransportNodeProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyHostTransportNodeProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIntrusionServiceProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIntrusionServiceProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPBlockRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIPBlockRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
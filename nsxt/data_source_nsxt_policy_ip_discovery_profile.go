@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPDiscoveryProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIPDiscoveryProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
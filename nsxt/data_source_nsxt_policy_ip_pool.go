@@ -12,14 +12,14 @@ import (
 
 func dataSourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPPoolRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIPPoolRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 			"realized_id": {
 				Type:        schema.TypeString,
 				Description: "The ID of the realized resource",
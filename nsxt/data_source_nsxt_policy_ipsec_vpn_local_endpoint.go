@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPSecVpnLocalEndpointRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIPSecVpnLocalEndpointRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
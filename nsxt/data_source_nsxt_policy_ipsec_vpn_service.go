@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyIPSecVpnService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIPSecVpnServiceRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIPSecVpnServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
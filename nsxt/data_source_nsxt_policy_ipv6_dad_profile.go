@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyIpv6DadProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyIpv6DadProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyIpv6DadProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
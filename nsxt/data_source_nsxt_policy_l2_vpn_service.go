@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyL2VpnService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyL2VpnServiceRead,
+		ReadContext: withContext(dataSourceNsxtPolicyL2VpnServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
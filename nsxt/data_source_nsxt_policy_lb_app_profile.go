@@ -24,7 +24,7 @@ var lbAppProfileTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBAppProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBAppProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLBAppProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBClientSslProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLBClientSslProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
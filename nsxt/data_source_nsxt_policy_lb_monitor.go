@@ -27,7 +27,7 @@ var lbMonitorTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLBMonitor() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBMonitorRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLBMonitorRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -23,7 +23,7 @@ var lbPersistenceTypeMap = map[string]string{
 
 func dataSourceNsxtPolicyLbPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLbPersistenceProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLbPersistenceProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
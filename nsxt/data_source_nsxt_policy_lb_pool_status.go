@@ -0,0 +1,142 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_pools"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbPoolStatus() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"id": getDataSourceIDSchema(),
+		"lb_service_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of the load balancer service the pool is attached to",
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"pool_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of the load balancer pool",
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the load balancer pool",
+			Computed:    true,
+		},
+		"member": {
+			Type:        schema.TypeList,
+			Description: "Status of pool members",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ip_address": {
+						Type:        schema.TypeString,
+						Description: "IP address of the pool member",
+						Computed:    true,
+					},
+					"port": {
+						Type:        schema.TypeString,
+						Description: "Port of the pool member",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Status of the pool member",
+						Computed:    true,
+					},
+					"failure_cause": {
+						Type:        schema.TypeString,
+						Description: "Cause of the last health check failure",
+						Computed:    true,
+					},
+					"last_check_time": {
+						Type:        schema.TypeInt,
+						Description: "Timestamp of the last health check in milliseconds since epoch",
+						Computed:    true,
+					},
+					"last_state_change_time": {
+						Type:        schema.TypeInt,
+						Description: "Timestamp of the last status change in milliseconds since epoch",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	for key, value := range getPolicyLbStatusWaitSchema() {
+		dataSchema[key] = value
+	}
+
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtPolicyLbPoolStatusRead),
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceNsxtPolicyLbPoolStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	client := lb_pools.NewDetailedStatusClient(connector)
+
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	poolPath := d.Get("pool_path").(string)
+	poolID := getPolicyIDFromPath(poolPath)
+
+	err := waitForPolicyLbStatus(d, m, poolPath, func() (string, error) {
+		aggregate, err := client.Get(serviceID, poolID, nil, nil)
+		if err != nil {
+			return "", handleDataSourceReadError(d, "LBPoolStatus", poolID, err)
+		}
+		if len(aggregate.Results) == 0 {
+			return "", fmt.Errorf("No status available for load balancer pool %s", poolPath)
+		}
+
+		obj, errs := converter.ConvertToGolang(aggregate.Results[0], model.LBPoolStatusBindingType())
+		if len(errs) > 0 {
+			return "", fmt.Errorf("Error converting LBPoolStatus for %s: %s", poolPath, errs[0])
+		}
+		status := obj.(model.LBPoolStatus)
+		setPolicyLbPoolStatusInSchema(d, status)
+
+		if status.Status == nil {
+			return model.LBPoolStatus_STATUS_UNKNOWN, nil
+		}
+		return *status.Status, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(poolID)
+	return nil
+}
+
+func setPolicyLbPoolStatusInSchema(d *schema.ResourceData, status model.LBPoolStatus) {
+	d.Set("status", status.Status)
+
+	var memberList []map[string]interface{}
+	for _, member := range status.Members {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = member.IpAddress
+		elem["port"] = member.Port
+		elem["status"] = member.Status
+		elem["failure_cause"] = member.FailureCause
+		elem["last_check_time"] = member.LastCheckTime
+		elem["last_state_change_time"] = member.LastStateChangeTime
+		memberList = append(memberList, elem)
+	}
+	d.Set("member", memberList)
+}
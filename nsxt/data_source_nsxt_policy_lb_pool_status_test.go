@@ -0,0 +1,38 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBPoolStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_pool_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBStatusTemplate() + `
+data "nsxt_policy_lb_pool_status" "test" {
+  lb_service_path = nsxt_policy_lb_service.test.path
+  pool_path       = nsxt_policy_lb_pool.test.path
+  depends_on      = [nsxt_policy_lb_virtual_server.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttr(testResourceName, "member.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "member.0.ip_address", "5.5.5.5"),
+					resource.TestCheckResourceAttrSet(testResourceName, "member.0.status"),
+				),
+			},
+		},
+	})
+}
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtPolicyLBServerSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLBServerSslProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLBServerSslProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
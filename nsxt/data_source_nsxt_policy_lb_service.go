@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicyLbService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyLbServiceRead,
+		ReadContext: withContext(dataSourceNsxtPolicyLbServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
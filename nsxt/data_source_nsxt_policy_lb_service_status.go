@@ -0,0 +1,212 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbServiceStatus() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"id": getDataSourceIDSchema(),
+		"lb_service_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of the load balancer service",
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"service_status": {
+			Type:        schema.TypeString,
+			Description: "Status of the load balancer service",
+			Computed:    true,
+		},
+		"error_message": {
+			Type:        schema.TypeString,
+			Description: "Error message, if available",
+			Computed:    true,
+		},
+		"cpu_usage": {
+			Type:        schema.TypeInt,
+			Description: "CPU usage in percentage",
+			Computed:    true,
+		},
+		"memory_usage": {
+			Type:        schema.TypeInt,
+			Description: "Memory usage in percentage",
+			Computed:    true,
+		},
+		"active_transport_nodes": {
+			Type:        schema.TypeList,
+			Description: "Transport nodes where the service is active",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"standby_transport_nodes": {
+			Type:        schema.TypeList,
+			Description: "Transport nodes where the service is standby",
+			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"instance_detail": {
+			Type:        schema.TypeList,
+			Description: "Status of service instances per transport node",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"transport_node_id": {
+						Type:        schema.TypeString,
+						Description: "Transport node ID",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Status of service instances on this transport node",
+						Computed:    true,
+					},
+					"instance_number": {
+						Type:        schema.TypeInt,
+						Description: "Number of service instances in this status",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"pool": {
+			Type:        schema.TypeList,
+			Description: "Status of pools attached to the service",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path": {
+						Type:        schema.TypeString,
+						Description: "Policy path of the pool",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Status of the pool",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"virtual_server": {
+			Type:        schema.TypeList,
+			Description: "Status of virtual servers attached to the service",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path": {
+						Type:        schema.TypeString,
+						Description: "Policy path of the virtual server",
+						Computed:    true,
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Description: "Status of the virtual server",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+	for key, value := range getPolicyLbStatusWaitSchema() {
+		dataSchema[key] = value
+	}
+
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtPolicyLbServiceStatusRead),
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceNsxtPolicyLbServiceStatusRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	client := lb_services.NewDetailedStatusClient(connector)
+
+	servicePath := d.Get("lb_service_path").(string)
+	serviceID := getPolicyIDFromPath(servicePath)
+	includeInstanceDetails := true
+
+	err := waitForPolicyLbStatus(d, m, servicePath, func() (string, error) {
+		aggregate, err := client.Get(serviceID, nil, &includeInstanceDetails, nil, nil)
+		if err != nil {
+			return "", handleDataSourceReadError(d, "LBServiceStatus", serviceID, err)
+		}
+		if len(aggregate.Results) == 0 {
+			return "", fmt.Errorf("No status available for load balancer service %s", servicePath)
+		}
+
+		obj, errs := converter.ConvertToGolang(aggregate.Results[0], model.LBServiceStatusBindingType())
+		if len(errs) > 0 {
+			return "", fmt.Errorf("Error converting LBServiceStatus for %s: %s", servicePath, errs[0])
+		}
+		status := obj.(model.LBServiceStatus)
+		setPolicyLbServiceStatusInSchema(d, status)
+
+		if status.ServiceStatus == nil {
+			return model.LBServiceStatus_SERVICE_STATUS_UNKNOWN, nil
+		}
+		return *status.ServiceStatus, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(serviceID)
+	return nil
+}
+
+func setPolicyLbServiceStatusInSchema(d *schema.ResourceData, status model.LBServiceStatus) {
+	d.Set("service_status", status.ServiceStatus)
+	d.Set("error_message", status.ErrorMessage)
+	d.Set("cpu_usage", status.CpuUsage)
+	d.Set("memory_usage", status.MemoryUsage)
+	d.Set("active_transport_nodes", status.ActiveTransportNodes)
+	d.Set("standby_transport_nodes", status.StandbyTransportNodes)
+
+	var instanceList []map[string]interface{}
+	for _, perNode := range status.InstanceDetailPerTn {
+		for _, perStatus := range perNode.InstanceDetailPerStatus {
+			elem := make(map[string]interface{})
+			elem["transport_node_id"] = perNode.TransportNodeId
+			elem["status"] = perStatus.Status
+			elem["instance_number"] = perStatus.InstanceNumber
+			instanceList = append(instanceList, elem)
+		}
+	}
+	d.Set("instance_detail", instanceList)
+
+	var poolList []map[string]interface{}
+	for _, pool := range status.Pools {
+		elem := make(map[string]interface{})
+		elem["path"] = pool.PoolPath
+		elem["status"] = pool.Status
+		poolList = append(poolList, elem)
+	}
+	d.Set("pool", poolList)
+
+	var vsList []map[string]interface{}
+	for _, vs := range status.VirtualServers {
+		elem := make(map[string]interface{})
+		elem["path"] = vs.VirtualServerPath
+		elem["status"] = vs.Status
+		vsList = append(vsList, elem)
+	}
+	d.Set("virtual_server", vsList)
+}
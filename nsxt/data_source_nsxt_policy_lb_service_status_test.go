@@ -0,0 +1,289 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccDataSourceNsxtPolicyLBServiceStatus_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_service_status.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBStatusTemplate() + `
+data "nsxt_policy_lb_service_status" "test" {
+  lb_service_path = nsxt_policy_lb_service.test.path
+  depends_on      = [nsxt_policy_lb_virtual_server.test]
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "service_status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "pool.#"),
+					resource.TestCheckResourceAttrSet(testResourceName, "virtual_server.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBStatusTemplate() string {
+	return testAccNsxtPolicyLBServiceDeps() + `
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name      = "terraform-lb-status-test"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_lb_service" "test" {
+  display_name      = "terraform-lb-status-test"
+  connectivity_path = nsxt_policy_tier1_gateway.test.path
+}
+
+data "nsxt_policy_lb_app_profile" "default_tcp" {
+  type         = "TCP"
+  display_name = "default-tcp-lb-app-profile"
+}
+
+resource "nsxt_policy_lb_pool" "test" {
+  display_name = "terraform-lb-status-test"
+
+  member {
+    display_name = "member1"
+    ip_address   = "5.5.5.5"
+    port         = "80"
+  }
+}
+
+resource "nsxt_policy_lb_virtual_server" "test" {
+  display_name             = "terraform-lb-status-test"
+  application_profile_path = data.nsxt_policy_lb_app_profile.default_tcp.path
+  ip_address               = "7.7.7.7"
+  ports                    = ["80"]
+  pool_path                = nsxt_policy_lb_pool.test.path
+  service_path             = nsxt_policy_lb_service.test.path
+}
+`
+}
+
+func TestPolicyLBStatusDataSources(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	srv.Seed("/infra/lb-services/svc1/detailed-status", map[string]interface{}{
+		"intent_path": "/infra/lb-services/svc1",
+		"results": []interface{}{
+			map[string]interface{}{
+				"resource_type":           "LBServiceStatus",
+				"service_path":            "/infra/lb-services/svc1",
+				"service_status":          "UP",
+				"active_transport_nodes":  []interface{}{"edge1"},
+				"standby_transport_nodes": []interface{}{"edge2"},
+				"cpu_usage":               5,
+				"instance_detail_per_tn": []interface{}{
+					map[string]interface{}{
+						"transport_node_id": "edge1",
+						"instance_detail_per_status": []interface{}{
+							map[string]interface{}{"status": "READY", "instance_number": 1},
+						},
+					},
+				},
+				"pools": []interface{}{
+					map[string]interface{}{"resource_type": "LBPoolStatus", "pool_path": "/infra/lb-pools/pool1", "status": "PARTIALLY_UP"},
+				},
+				"virtual_servers": []interface{}{
+					map[string]interface{}{"resource_type": "LBVirtualServerStatus", "virtual_server_path": "/infra/lb-virtual-servers/vs1", "status": "UP"},
+				},
+			},
+		},
+	})
+	srv.Seed("/infra/lb-services/svc1/lb-pools/pool1/detailed-status", map[string]interface{}{
+		"intent_path": "/infra/lb-pools/pool1",
+		"results": []interface{}{
+			map[string]interface{}{
+				"resource_type": "LBPoolStatus",
+				"pool_path":     "/infra/lb-pools/pool1",
+				"status":        "PARTIALLY_UP",
+				"members": []interface{}{
+					map[string]interface{}{"ip_address": "5.5.5.5", "port": "80", "status": "UP"},
+					map[string]interface{}{"ip_address": "5.5.5.6", "port": "80", "status": "DOWN", "failure_cause": "Connection refused", "last_check_time": 1700000000000},
+				},
+			},
+		},
+	})
+	srv.Seed("/infra/lb-services/svc1/lb-virtual-servers/vs1/detailed-status", map[string]interface{}{
+		"intent_path": "/infra/lb-virtual-servers/vs1",
+		"results": []interface{}{
+			map[string]interface{}{
+				"resource_type":       "LBVirtualServerStatus",
+				"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+				"status":              "UP",
+			},
+		},
+	})
+	srv.Seed("/infra/lb-services/svc1/lb-virtual-servers/vs1/statistics", map[string]interface{}{
+		"intent_path": "/infra/lb-virtual-servers/vs1",
+		"results": []interface{}{
+			map[string]interface{}{
+				"resource_type":       "LBVirtualServerStatistics",
+				"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+				"statistics": map[string]interface{}{
+					"bytes_in":         1024,
+					"bytes_out":        2048,
+					"bytes_in_rate":    1.5,
+					"current_sessions": 3,
+					"total_sessions":   42,
+				},
+			},
+		},
+	})
+
+	service := dataSourceNsxtPolicyLbServiceStatus()
+	serviceData := schema.TestResourceDataRaw(t, service.Schema, map[string]interface{}{
+		"lb_service_path":    "/infra/lb-services/svc1",
+		"wait_until_healthy": true,
+		"delay":              0,
+	})
+	if diags := service.ReadContext(context.Background(), serviceData, m); diags.HasError() {
+		t.Fatalf("service status read failed: %v", diags)
+	}
+	if serviceData.Get("service_status") != "UP" || serviceData.Get("cpu_usage") != 5 || serviceData.Get("active_transport_nodes.0") != "edge1" {
+		t.Errorf("unexpected service status: %v", serviceData.State())
+	}
+	if serviceData.Get("instance_detail.0.transport_node_id") != "edge1" || serviceData.Get("instance_detail.0.status") != "READY" {
+		t.Errorf("unexpected service instance details: %v", serviceData.Get("instance_detail"))
+	}
+	if serviceData.Get("pool.0.status") != "PARTIALLY_UP" || serviceData.Get("virtual_server.0.path") != "/infra/lb-virtual-servers/vs1" {
+		t.Errorf("unexpected service pool and virtual server status: %v", serviceData.State())
+	}
+
+	pool := dataSourceNsxtPolicyLbPoolStatus()
+	poolData := schema.TestResourceDataRaw(t, pool.Schema, map[string]interface{}{
+		"lb_service_path": "/infra/lb-services/svc1",
+		"pool_path":       "/infra/lb-pools/pool1",
+	})
+	if diags := pool.ReadContext(context.Background(), poolData, m); diags.HasError() {
+		t.Fatalf("pool status read failed: %v", diags)
+	}
+	if poolData.Get("status") != "PARTIALLY_UP" || poolData.Get("member.#") != 2 {
+		t.Errorf("unexpected pool status: %v", poolData.State())
+	}
+	if poolData.Get("member.1.status") != "DOWN" || poolData.Get("member.1.failure_cause") != "Connection refused" || poolData.Get("member.1.last_check_time") != 1700000000000 {
+		t.Errorf("unexpected pool member status: %v", poolData.Get("member"))
+	}
+
+	// Pool that is not fully UP fails the wait once timeout expires
+	poolData = schema.TestResourceDataRaw(t, pool.Schema, map[string]interface{}{
+		"lb_service_path":    "/infra/lb-services/svc1",
+		"pool_path":          "/infra/lb-pools/pool1",
+		"wait_until_healthy": true,
+		"timeout":            1,
+		"delay":              0,
+	})
+	if diags := pool.ReadContext(context.Background(), poolData, m); !diags.HasError() {
+		t.Errorf("expected wait for partially up pool to fail")
+	}
+
+	vs := dataSourceNsxtPolicyLbVirtualServerStatistics()
+	vsData := schema.TestResourceDataRaw(t, vs.Schema, map[string]interface{}{
+		"lb_service_path":     "/infra/lb-services/svc1",
+		"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+		"wait_until_healthy":  true,
+	})
+	if diags := vs.ReadContext(context.Background(), vsData, m); diags.HasError() {
+		t.Fatalf("virtual server statistics read failed: %v", diags)
+	}
+	if vsData.Get("status") != "UP" || vsData.Get("bytes_in") != 1024 || vsData.Get("bytes_out") != 2048 || vsData.Get("total_sessions") != 42 {
+		t.Errorf("unexpected virtual server statistics: %v", vsData.State())
+	}
+	if fmt.Sprint(vsData.Get("bytes_in_rate")) != "1.5" {
+		t.Errorf("unexpected virtual server bytes in rate: %v", vsData.Get("bytes_in_rate"))
+	}
+
+	// Status of a pool that does not exist is reported as error
+	missingData := schema.TestResourceDataRaw(t, pool.Schema, map[string]interface{}{
+		"lb_service_path": "/infra/lb-services/svc1",
+		"pool_path":       "/infra/lb-pools/missing",
+	})
+	if diags := pool.ReadContext(context.Background(), missingData, m); !diags.HasError() {
+		t.Errorf("expected status read for missing pool to fail")
+	}
+}
+
+func TestPolicyLBStatusWaitForStatus(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	// Status of newly created virtual server becomes available later
+	seeded := make(chan struct{})
+	go func() {
+		defer close(seeded)
+		time.Sleep(1500 * time.Millisecond)
+		srv.Seed("/infra/lb-services/svc1/lb-virtual-servers/vs1/detailed-status", map[string]interface{}{
+			"intent_path": "/infra/lb-virtual-servers/vs1",
+			"results": []interface{}{
+				map[string]interface{}{
+					"resource_type":       "LBVirtualServerStatus",
+					"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+					"status":              "UP",
+				},
+			},
+		})
+		srv.Seed("/infra/lb-services/svc1/lb-virtual-servers/vs1/statistics", map[string]interface{}{
+			"intent_path": "/infra/lb-virtual-servers/vs1",
+			"results": []interface{}{
+				map[string]interface{}{
+					"resource_type":       "LBVirtualServerStatistics",
+					"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+				},
+			},
+		})
+	}()
+	defer func() { <-seeded }()
+
+	vs := dataSourceNsxtPolicyLbVirtualServerStatistics()
+	vsData := schema.TestResourceDataRaw(t, vs.Schema, map[string]interface{}{
+		"lb_service_path":     "/infra/lb-services/svc1",
+		"virtual_server_path": "/infra/lb-virtual-servers/vs1",
+		"wait_until_healthy":  true,
+		"timeout":             30,
+		"delay":               0,
+	})
+	if diags := vs.ReadContext(context.Background(), vsData, m); diags.HasError() {
+		t.Fatalf("wait for virtual server status failed: %v", diags)
+	}
+	if vsData.Get("status") != "UP" {
+		t.Errorf("unexpected virtual server status: %v", vsData.Get("status"))
+	}
+
+	// Status that never becomes available fails the wait on timeout only
+	pool := dataSourceNsxtPolicyLbPoolStatus()
+	poolData := schema.TestResourceDataRaw(t, pool.Schema, map[string]interface{}{
+		"lb_service_path":    "/infra/lb-services/svc1",
+		"pool_path":          "/infra/lb-pools/missing",
+		"wait_until_healthy": true,
+		"timeout":            2,
+		"delay":              0,
+	})
+	start := time.Now()
+	diags := pool.ReadContext(context.Background(), poolData, m)
+	if !diags.HasError() {
+		t.Fatalf("expected wait for missing pool status to fail")
+	}
+	if time.Since(start) < 2*time.Second {
+		t.Errorf("expected wait to fail on timeout, failed after %v: %v", time.Since(start), diags)
+	}
+	if !strings.Contains(diags[len(diags)-1].Summary, "last error") {
+		t.Errorf("expected last error to be reported, got %v", diags)
+	}
+}
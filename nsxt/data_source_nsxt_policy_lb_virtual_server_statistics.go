@@ -0,0 +1,202 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/lb_services/lb_virtual_servers"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicyLbVirtualServerStatistics() *schema.Resource {
+	dataSchema := map[string]*schema.Schema{
+		"id": getDataSourceIDSchema(),
+		"lb_service_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of the load balancer service the virtual server is attached to",
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"virtual_server_path": {
+			Type:         schema.TypeString,
+			Description:  "Policy path of the load balancer virtual server",
+			Required:     true,
+			ValidateFunc: validatePolicyPath(),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Description: "Status of the load balancer virtual server",
+			Computed:    true,
+		},
+		"bytes_in": {
+			Type:        schema.TypeInt,
+			Description: "Number of bytes in",
+			Computed:    true,
+		},
+		"bytes_in_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of incoming bytes per second",
+			Computed:    true,
+		},
+		"bytes_out": {
+			Type:        schema.TypeInt,
+			Description: "Number of bytes out",
+			Computed:    true,
+		},
+		"bytes_out_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of outgoing bytes per second",
+			Computed:    true,
+		},
+		"current_session_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of new sessions per second",
+			Computed:    true,
+		},
+		"current_sessions": {
+			Type:        schema.TypeInt,
+			Description: "Number of current sessions",
+			Computed:    true,
+		},
+		"dropped_packets_by_access_list": {
+			Type:        schema.TypeInt,
+			Description: "Number of packets dropped by access list",
+			Computed:    true,
+		},
+		"dropped_sessions_by_lbrule_action": {
+			Type:        schema.TypeInt,
+			Description: "Number of sessions dropped by load balancer rule action",
+			Computed:    true,
+		},
+		"http_request_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of HTTP requests per second",
+			Computed:    true,
+		},
+		"http_requests": {
+			Type:        schema.TypeInt,
+			Description: "Number of HTTP requests",
+			Computed:    true,
+		},
+		"max_sessions": {
+			Type:        schema.TypeInt,
+			Description: "Maximum number of concurrent sessions",
+			Computed:    true,
+		},
+		"packets_in": {
+			Type:        schema.TypeInt,
+			Description: "Number of packets in",
+			Computed:    true,
+		},
+		"packets_in_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of incoming packets per second",
+			Computed:    true,
+		},
+		"packets_out": {
+			Type:        schema.TypeInt,
+			Description: "Number of packets out",
+			Computed:    true,
+		},
+		"packets_out_rate": {
+			Type:        schema.TypeFloat,
+			Description: "Rate of outgoing packets per second",
+			Computed:    true,
+		},
+		"total_sessions": {
+			Type:        schema.TypeInt,
+			Description: "Total number of sessions",
+			Computed:    true,
+		},
+	}
+	for key, value := range getPolicyLbStatusWaitSchema() {
+		dataSchema[key] = value
+	}
+
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtPolicyLbVirtualServerStatisticsRead),
+		Schema:      dataSchema,
+	}
+}
+
+func dataSourceNsxtPolicyLbVirtualServerStatisticsRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+	statusClient := lb_virtual_servers.NewDetailedStatusClient(connector)
+	statisticsClient := lb_virtual_servers.NewStatisticsClient(connector)
+
+	serviceID := getPolicyIDFromPath(d.Get("lb_service_path").(string))
+	vsPath := d.Get("virtual_server_path").(string)
+	vsID := getPolicyIDFromPath(vsPath)
+
+	err := waitForPolicyLbStatus(d, m, vsPath, func() (string, error) {
+		aggregate, err := statusClient.Get(serviceID, vsID, nil, nil)
+		if err != nil {
+			return "", handleDataSourceReadError(d, "LBVirtualServerStatus", vsID, err)
+		}
+		if len(aggregate.Results) == 0 {
+			return "", fmt.Errorf("No status available for load balancer virtual server %s", vsPath)
+		}
+
+		obj, errs := converter.ConvertToGolang(aggregate.Results[0], model.LBVirtualServerStatusBindingType())
+		if len(errs) > 0 {
+			return "", fmt.Errorf("Error converting LBVirtualServerStatus for %s: %s", vsPath, errs[0])
+		}
+		status := obj.(model.LBVirtualServerStatus)
+		d.Set("status", status.Status)
+
+		if status.Status == nil {
+			return model.LBVirtualServerStatus_STATUS_UNKNOWN, nil
+		}
+		return *status.Status, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	aggregate, err := statisticsClient.Get(serviceID, vsID, nil, nil)
+	if err != nil {
+		return handleDataSourceReadError(d, "LBVirtualServerStatistics", vsID, err)
+	}
+	if len(aggregate.Results) > 0 {
+		obj, errs := converter.ConvertToGolang(aggregate.Results[0], model.LBVirtualServerStatisticsBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting LBVirtualServerStatistics for %s: %s", vsPath, errs[0])
+		}
+		setPolicyLbVirtualServerStatisticsInSchema(d, obj.(model.LBVirtualServerStatistics))
+	}
+
+	d.SetId(vsID)
+	return nil
+}
+
+func setPolicyLbVirtualServerStatisticsInSchema(d *schema.ResourceData, stats model.LBVirtualServerStatistics) {
+	if stats.Statistics == nil {
+		return
+	}
+	statistics := stats.Statistics
+	d.Set("bytes_in", statistics.BytesIn)
+	d.Set("bytes_in_rate", statistics.BytesInRate)
+	d.Set("bytes_out", statistics.BytesOut)
+	d.Set("bytes_out_rate", statistics.BytesOutRate)
+	d.Set("current_session_rate", statistics.CurrentSessionRate)
+	d.Set("current_sessions", statistics.CurrentSessions)
+	d.Set("dropped_packets_by_access_list", statistics.DroppedPacketsByAccessList)
+	d.Set("dropped_sessions_by_lbrule_action", statistics.DroppedSessionsByLbruleAction)
+	d.Set("http_request_rate", statistics.HttpRequestRate)
+	d.Set("http_requests", statistics.HttpRequests)
+	d.Set("max_sessions", statistics.MaxSessions)
+	d.Set("packets_in", statistics.PacketsIn)
+	d.Set("packets_in_rate", statistics.PacketsInRate)
+	d.Set("packets_out", statistics.PacketsOut)
+	d.Set("packets_out_rate", statistics.PacketsOutRate)
+	d.Set("total_sessions", statistics.TotalSessions)
+}
@@ -0,0 +1,36 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceNsxtPolicyLBVirtualServerStatistics_basic(t *testing.T) {
+	testResourceName := "data.nsxt_policy_lb_virtual_server_statistics.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBStatusTemplate() + `
+data "nsxt_policy_lb_virtual_server_statistics" "test" {
+  lb_service_path     = nsxt_policy_lb_service.test.path
+  virtual_server_path = nsxt_policy_lb_virtual_server.test.path
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "status"),
+					resource.TestCheckResourceAttrSet(testResourceName, "bytes_in"),
+					resource.TestCheckResourceAttrSet(testResourceName, "current_sessions"),
+				),
+			},
+		},
+	})
+}
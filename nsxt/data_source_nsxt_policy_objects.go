@@ -0,0 +1,228 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+)
+
+func dataSourceNsxtPolicyObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtPolicyObjectsRead),
+
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:        schema.TypeString,
+				Description: "Resource type of objects, for example Segment or Group",
+				Optional:    true,
+			},
+			"display_name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regular expression to filter objects by display name",
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"search_query": {
+				Type:        schema.TypeString,
+				Description: "Additional search query in NSX search syntax",
+				Optional:    true,
+			},
+			"tag": {
+				Type:        schema.TypeSet,
+				Description: "Filter objects by tag. When multiple tags are specified, object should have all of them",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scope": {
+							Type:        schema.TypeString,
+							Description: "Tag scope, any scope matches if not specified",
+							Optional:    true,
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Description: "Tag value, any value matches if not specified",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Description: "List of objects matching the filters",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tag": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"scope": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"tag": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"context": getDataSourceContextSchema(),
+		},
+	}
+}
+
+type policyObjectsTagFilter struct {
+	scope string
+	tag   string
+}
+
+func (f policyObjectsTagFilter) matches(tags []model.Tag) bool {
+	for _, tag := range tags {
+		if f.scope != "" && (tag.Scope == nil || *tag.Scope != f.scope) {
+			continue
+		}
+		if f.tag != "" && (tag.Tag == nil || *tag.Tag != f.tag) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func getPolicyObjectsTagFilters(d *schema.ResourceData) []policyObjectsTagFilter {
+	var filters []policyObjectsTagFilter
+	for _, item := range d.Get("tag").(*schema.Set).List() {
+		data := item.(map[string]interface{})
+		filter := policyObjectsTagFilter{
+			scope: data["scope"].(string),
+			tag:   data["tag"].(string),
+		}
+		if filter.scope == "" && filter.tag == "" {
+			continue
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+func buildPolicyObjectsQuery(resourceType string, searchQuery string, tagFilters []policyObjectsTagFilter) string {
+	var terms []string
+	if resourceType != "" {
+		terms = append(terms, fmt.Sprintf("resource_type:%s", escapeSpecialCharacters(resourceType)))
+	}
+	// search narrows the results, exact scope and tag pairs are matched
+	// after the search since search does not correlate fields of same tag
+	for _, filter := range tagFilters {
+		if filter.scope != "" {
+			terms = append(terms, fmt.Sprintf("tags.scope:%s", escapeSpecialCharacters(filter.scope)))
+		}
+		if filter.tag != "" {
+			terms = append(terms, fmt.Sprintf("tags.tag:%s", escapeSpecialCharacters(filter.tag)))
+		}
+	}
+	if searchQuery != "" {
+		terms = append(terms, fmt.Sprintf("(%s)", searchQuery))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func dataSourceNsxtPolicyObjectsRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	resourceType := d.Get("resource_type").(string)
+	searchQuery := d.Get("search_query").(string)
+	tagFilters := getPolicyObjectsTagFilters(d)
+	if resourceType == "" && searchQuery == "" && len(tagFilters) == 0 {
+		return fmt.Errorf("At least one of resource_type, search_query or tag should be specified")
+	}
+
+	var nameRegex *regexp.Regexp
+	if regex := d.Get("display_name_regex").(string); regex != "" {
+		var err error
+		nameRegex, err = regexp.Compile(regex)
+		if err != nil {
+			return fmt.Errorf("Invalid display_name_regex: %v", err)
+		}
+	}
+
+	query := buildPolicyObjectsQuery(resourceType, searchQuery, tagFilters)
+	resultValues, err := listPolicyResourcesByQuery(connector, getSessionContext(d, m), query)
+	if err != nil {
+		return fmt.Errorf("Error searching policy objects: %v", err)
+	}
+
+	converter := bindings.NewTypeConverter()
+	var items []interface{}
+	for _, result := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(result, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if resourceType != "" && (obj.ResourceType == nil || *obj.ResourceType != resourceType) {
+			continue
+		}
+		if nameRegex != nil && (obj.DisplayName == nil || !nameRegex.MatchString(*obj.DisplayName)) {
+			continue
+		}
+		matched := true
+		for _, filter := range tagFilters {
+			if !filter.matches(obj.Tags) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		var tags []interface{}
+		for _, tag := range obj.Tags {
+			elem := make(map[string]interface{})
+			elem["scope"] = tag.Scope
+			elem["tag"] = tag.Tag
+			tags = append(tags, elem)
+		}
+		elem := make(map[string]interface{})
+		elem["id"] = obj.Id
+		elem["display_name"] = obj.DisplayName
+		elem["path"] = obj.Path
+		elem["resource_type"] = obj.ResourceType
+		elem["tag"] = tags
+		items = append(items, elem)
+	}
+
+	d.SetId(newUUID())
+	d.Set("items", items)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestAccDataSourceNsxtPolicyObjects_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicyObjectsBasic(t, false, func() {
+		testAccPreCheck(t)
+		testAccOnlyLocalManager(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicyObjects_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicyObjectsBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicyObjectsBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := getAccTestDataSourceName()
+	testResourceName := "data.nsxt_policy_objects.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyObjectsTemplate(name, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testResourceName, "id"),
+					resource.TestCheckResourceAttr(testResourceName, "items.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.resource_type", "Group"),
+					resource.TestCheckResourceAttr(testResourceName, "items.0.tag.#", "1"),
+					resource.TestCheckResourceAttr("data.nsxt_policy_objects.regex", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.nsxt_policy_objects.regex", "items.0.path", "nsxt_policy_group.test1", "path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyObjectsTemplate(name string, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_group" "test1" {
+%s
+  display_name = "%s-1"
+
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+}
+
+resource "nsxt_policy_group" "test2" {
+%s
+  display_name = "%s-2"
+
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+}
+
+data "nsxt_policy_objects" "test" {
+%s
+  resource_type = "Group"
+
+  tag {
+    scope = "env"
+    tag   = "%s"
+  }
+
+  depends_on = [nsxt_policy_group.test1, nsxt_policy_group.test2]
+}
+
+data "nsxt_policy_objects" "regex" {
+%s
+  resource_type      = "Group"
+  display_name_regex = "^%s-1$"
+
+  depends_on = [nsxt_policy_group.test1, nsxt_policy_group.test2]
+}`, context, name, name, context, name, name, context, name, context, name)
+}
+
+func TestPolicyObjectsDataSourceRead(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	tag := func(scope, value string) map[string]interface{} {
+		return map[string]interface{}{"scope": scope, "tag": value}
+	}
+	objects := []struct {
+		path         string
+		resourceType string
+		tags         []interface{}
+	}{
+		{"/infra/segments/web-prod", "Segment", []interface{}{tag("env", "prod"), tag("tier", "web")}},
+		{"/infra/segments/db-prod", "Segment", []interface{}{tag("env", "prod"), tag("tier", "db")}},
+		{"/infra/segments/web-dev", "Segment", []interface{}{tag("env", "dev"), tag("tier", "web")}},
+		{"/infra/segments/mixed", "Segment", []interface{}{tag("env", "dev"), tag("owner", "prod")}},
+		{"/infra/domains/default/groups/web-prod", "Group", []interface{}{tag("env", "prod")}},
+		{"/orgs/default/projects/proj1/infra/segments/web-prod", "Segment", []interface{}{tag("env", "prod")}},
+	}
+	for _, obj := range objects {
+		srv.Seed(obj.path, map[string]interface{}{
+			"display_name":  getPolicyIDFromPath(obj.path),
+			"resource_type": obj.resourceType,
+			"tags":          obj.tags,
+		})
+	}
+	// make sure paging is exercised
+	for i := 0; i < 1100; i++ {
+		srv.Seed(fmt.Sprintf("/infra/segments/bulk-%d", i), map[string]interface{}{
+			"display_name":  fmt.Sprintf("bulk-%d", i),
+			"resource_type": "Segment",
+		})
+	}
+
+	tests := []struct {
+		name          string
+		config        map[string]interface{}
+		expectedPaths []string
+	}{
+		{
+			name: "by tag",
+			config: map[string]interface{}{
+				"resource_type": "Segment",
+				"tag":           []interface{}{tag("env", "prod")},
+			},
+			expectedPaths: []string{objects[1].path, objects[0].path},
+		},
+		{
+			name: "by multiple tags",
+			config: map[string]interface{}{
+				"tag": []interface{}{tag("env", "prod"), tag("tier", "web")},
+			},
+			expectedPaths: []string{objects[0].path},
+		},
+		{
+			name: "by tag value only",
+			config: map[string]interface{}{
+				"resource_type": "Segment",
+				"tag":           []interface{}{tag("", "dev")},
+			},
+			expectedPaths: []string{objects[3].path, objects[2].path},
+		},
+		{
+			name: "by regex",
+			config: map[string]interface{}{
+				"resource_type":      "Segment",
+				"display_name_regex": "^web-",
+			},
+			expectedPaths: []string{objects[2].path, objects[0].path},
+		},
+		{
+			name: "by query",
+			config: map[string]interface{}{
+				"search_query": "display_name:web-prod",
+			},
+			expectedPaths: []string{objects[4].path, objects[0].path},
+		},
+		{
+			name: "all pages",
+			config: map[string]interface{}{
+				"resource_type":      "Segment",
+				"display_name_regex": "^bulk-",
+			},
+		},
+		{
+			name: "project",
+			config: map[string]interface{}{
+				"resource_type": "Segment",
+				"tag":           []interface{}{tag("env", "prod")},
+				"context":       []interface{}{map[string]interface{}{"project_id": "proj1"}},
+			},
+			expectedPaths: []string{objects[5].path},
+		},
+	}
+
+	ds := dataSourceNsxtPolicyObjects()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ds.Schema, test.config)
+			if diags := ds.ReadContext(context.Background(), d, m); diags.HasError() {
+				t.Fatalf("read failed: %v", diags)
+			}
+			items := d.Get("items").([]interface{})
+			if test.expectedPaths == nil {
+				if len(items) != 1100 {
+					t.Errorf("expected 1100 items, got %d", len(items))
+				}
+				return
+			}
+			var paths []string
+			for _, item := range items {
+				paths = append(paths, item.(map[string]interface{})["path"].(string))
+			}
+			if fmt.Sprint(paths) != fmt.Sprint(test.expectedPaths) {
+				t.Errorf("expected %v, got %v", test.expectedPaths, paths)
+			}
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+	if diags := ds.ReadContext(context.Background(), d, m); !diags.HasError() {
+		t.Errorf("expected read without filters to fail")
+	}
+}
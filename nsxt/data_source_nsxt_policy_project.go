@@ -16,7 +16,7 @@ const defaultOrgID = "default"
 
 func dataSourceNsxtPolicyProject() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyProjectRead,
+		ReadContext: withContext(dataSourceNsxtPolicyProjectRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -17,7 +17,7 @@ import (
 
 func dataSourceNsxtPolicyRealizationInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyRealizationInfoRead,
+		ReadContext: withContext(dataSourceNsxtPolicyRealizationInfoRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
@@ -149,7 +149,7 @@ func dataSourceNsxtPolicyRealizationInfoRead(d *schema.ResourceData, m interface
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
@@ -18,14 +18,14 @@ import (
 
 func dataSourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySecurityPolicyRead,
+		ReadContext: withContext(dataSourceNsxtPolicySecurityPolicyRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 			"domain":       getDataSourceDomainNameSchema(),
 			"is_default": {
 				Type:        schema.TypeBool,
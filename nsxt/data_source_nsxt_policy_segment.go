@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicySegment() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRead,
+		ReadContext: withContext(dataSourceNsxtPolicySegmentRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceExtendedDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
@@ -0,0 +1,62 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func dataSourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withContext(dataSourceNsxtPolicySegmentPortRead),
+
+		Schema: map[string]*schema.Schema{
+			"id":           getDataSourceIDSchema(),
+			"display_name": getDataSourceExtendedDisplayNameSchema(),
+			"description":  getDataSourceDescriptionSchema(),
+			"path":         getPathSchema(),
+			"context":      getDataSourceContextSchema(),
+			"segment_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of the parent segment",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"attachment_id": {
+				Type:        schema.TypeString,
+				Description: "VIF attachment ID of the port",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	query := make(map[string]string)
+	if segmentPath := d.Get("segment_path").(string); segmentPath != "" {
+		query["parent_path"] = segmentPath
+	}
+	obj, err := policyDataSourceResourceRead(d, connector, getSessionContext(d, m), "SegmentPort", query)
+	if err != nil {
+		return err
+	}
+
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToGolang(obj, model.SegmentPortBindingType())
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	port := dataValue.(model.SegmentPort)
+	d.Set("segment_path", port.ParentPath)
+	if port.Attachment != nil {
+		d.Set("attachment_id", port.Attachment.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDataSourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	testAccDataSourceNsxtPolicySegmentPortBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccDataSourceNsxtPolicySegmentPort_multitenancy(t *testing.T) {
+	testAccDataSourceNsxtPolicySegmentPortBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccDataSourceNsxtPolicySegmentPortBasic(t *testing.T, withContext bool, preCheck func()) {
+	name := accTestPolicySegmentPortUpdateAttributes["display_name"]
+	testResourceName := "data.nsxt_policy_segment_port.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortReadTemplate(withContext),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttrPair(testResourceName, "path", testAccPolicySegmentPortResourceName, "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "segment_path", testAccPolicySegmentPortResourceName, "segment_path"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortReadTemplate(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return testAccNsxtPolicySegmentPortMinimalistic(withContext) + fmt.Sprintf(`
+
+data "nsxt_policy_segment_port" "test" {
+%s
+  display_name = "%s"
+  segment_path = nsxt_policy_segment.test.path
+
+  depends_on = [nsxt_policy_segment_port.test]
+}`, context, accTestPolicySegmentPortUpdateAttributes["display_name"])
+}
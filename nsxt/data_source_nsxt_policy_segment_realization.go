@@ -18,7 +18,7 @@ import (
 
 func dataSourceNsxtPolicySegmentRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentRealizationRead,
+		ReadContext: withContext(dataSourceNsxtPolicySegmentRealizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":      getDataSourceIDSchema(),
@@ -94,10 +94,13 @@ func dataSourceNsxtPolicySegmentRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	stateObj, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to get realization information for %s: %v", path, err)
 	}
+	if state, ok := stateObj.(model.SegmentConfigurationState); ok && state.State != nil && *state.State == model.SegmentConfigurationState_STATE_PARTIAL_SUCCESS {
+		addProviderWarning(m, "Segment is partially realized", "Segment %s was not realized on all transport nodes", path)
+	}
 
 	// In some cases success state is returned a moment before VC actually sees the network
 	// Adding a short sleep here prevents vsphere provider from erroring out
@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySegmentSecurityProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicySegmentSecurityProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
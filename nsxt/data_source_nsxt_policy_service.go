@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicyService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyServiceRead,
+		ReadContext: withContext(dataSourceNsxtPolicyServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
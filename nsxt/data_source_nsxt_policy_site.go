@@ -9,7 +9,7 @@ import (
 
 func dataSourceNsxtPolicySite() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySiteRead,
+		ReadContext: withContext(dataSourceNsxtPolicySiteRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
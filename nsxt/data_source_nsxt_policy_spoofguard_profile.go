@@ -9,14 +9,14 @@ import (
 
 func dataSourceNsxtPolicySpoofGuardProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicySpoofGuardProfileRead,
+		ReadContext: withContext(dataSourceNsxtPolicySpoofGuardProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
 			"description":  getDataSourceDescriptionSchema(),
 			"path":         getPathSchema(),
-			"context":      getContextSchema(false, false, false),
+			"context":      getDataSourceContextSchema(),
 		},
 	}
 }
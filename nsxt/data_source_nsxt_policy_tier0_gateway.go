@@ -17,7 +17,7 @@ import (
 
 func dataSourceNsxtPolicyTier0Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier0GatewayRead,
+		ReadContext: withContext(dataSourceNsxtPolicyTier0GatewayRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTier1GatewayRead,
+		ReadContext: withContext(dataSourceNsxtPolicyTier1GatewayRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -25,7 +25,7 @@ var policyTransportZoneTransportTypes = []string{
 
 func dataSourceNsxtPolicyTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyTransportZoneRead,
+		ReadContext: withContext(dataSourceNsxtPolicyTransportZoneRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -12,7 +12,7 @@ import (
 
 func dataSourceNsxtUplinkHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtUplinkHostSwitchProfileRead,
+		ReadContext: withContext(dataSourceNsxtUplinkHostSwitchProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
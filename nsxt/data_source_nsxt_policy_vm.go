@@ -18,7 +18,7 @@ var nsxtPolicyVMIDSchemaKeys = []string{
 
 func dataSourceNsxtPolicyVM() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVMIDRead,
+		ReadContext: withContext(dataSourceNsxtPolicyVMIDRead),
 
 		Schema: map[string]*schema.Schema{
 			"display_name": getDataSourceDisplayNameSchema(),
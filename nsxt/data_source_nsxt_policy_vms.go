@@ -28,7 +28,7 @@ func dataSourceNsxtPolicyVMs() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVMsRead,
+		ReadContext: withContext(dataSourceNsxtPolicyVMsRead),
 
 		Schema: map[string]*schema.Schema{
 			// TODO: add option to filter by display name regex
@@ -14,7 +14,7 @@ import (
 
 func dataSourceNsxtPolicyVniPool() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVniPoolRead,
+		ReadContext: withContext(dataSourceNsxtPolicyVniPoolRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
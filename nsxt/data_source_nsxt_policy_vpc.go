@@ -11,7 +11,7 @@ import (
 
 func dataSourceNsxtPolicyVPC() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtPolicyVPCRead,
+		ReadContext: withContext(dataSourceNsxtPolicyVPCRead),
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
 			"display_name": getDataSourceDisplayNameSchema(),
@@ -12,7 +12,7 @@ import (
 
 func dataSourceNsxtVtepHAHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtVtepHAHostSwitchProfileRead,
+		ReadContext: withContext(dataSourceNsxtVtepHAHostSwitchProfileRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -13,7 +13,7 @@ var GitCommit string
 
 func dataSourceNsxtProviderInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtProviderInfoRead,
+		ReadContext: withContext(dataSourceNsxtProviderInfoRead),
 
 		Schema: map[string]*schema.Schema{
 			"commit": {
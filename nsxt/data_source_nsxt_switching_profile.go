@@ -13,7 +13,7 @@ import (
 
 func dataSourceNsxtSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtSwitchingProfileRead,
+		ReadContext:        withContext(dataSourceNsxtSwitchingProfileRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
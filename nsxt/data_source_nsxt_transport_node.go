@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtTransportNodeRead,
+		ReadContext: withContext(dataSourceNsxtTransportNodeRead),
 
 		Schema: map[string]*schema.Schema{
 			"id":           getDataSourceIDSchema(),
@@ -17,7 +17,7 @@ import (
 
 func dataSourceNsxtTransportNodeRealization() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtTransportNodeRealizationRead,
+		ReadContext: withContext(dataSourceNsxtTransportNodeRealizationRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": {
@@ -90,7 +90,7 @@ func dataSourceNsxtTransportNodeRealizationRead(d *schema.ResourceData, m interf
 		MinTimeout: 1 * time.Second,
 		Delay:      time.Duration(delay) * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return err
 	}
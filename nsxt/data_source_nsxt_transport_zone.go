@@ -15,7 +15,7 @@ import (
 
 func dataSourceNsxtTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Read:               dataSourceNsxtTransportZoneRead,
+		ReadContext:        withContext(dataSourceNsxtTransportZoneRead),
 		DeprecationMessage: mpObjectDataSourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"id": {
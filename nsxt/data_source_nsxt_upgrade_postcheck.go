@@ -23,7 +23,7 @@ var (
 
 func dataSourceNsxtUpgradePostCheck() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtUpgradePostCheckRead,
+		ReadContext: withContext(dataSourceNsxtUpgradePostCheckRead),
 
 		Schema: map[string]*schema.Schema{
 			"upgrade_run_id": {
@@ -121,7 +121,7 @@ func dataSourceNsxtUpgradePostCheckRead(d *schema.ResourceData, m interface{}) e
 		Delay:        time.Duration(delay) * time.Second,
 	}
 
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return err
 	}
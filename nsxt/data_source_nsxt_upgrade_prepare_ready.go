@@ -12,7 +12,7 @@ import (
 
 func dataSourceNsxtUpgradePrepareReady() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceNsxtUpgradePrepareReadyRead,
+		ReadContext: withContext(dataSourceNsxtUpgradePrepareReadyRead),
 
 		Schema: map[string]*schema.Schema{
 			"id": getDataSourceIDSchema(),
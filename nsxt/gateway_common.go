@@ -653,6 +653,15 @@ func parseGatewayInterfacePolicyPath(path string) (bool, string, string, string)
 	return isT0, gwID, localeServiceID, interfaceID
 }
 
+// getPolicyGatewayLocaleServiceWithEdgeCluster returns locale service that holds
+// edge cluster configuration for either Tier0 or Tier1 gateway
+func getPolicyGatewayLocaleServiceWithEdgeCluster(context utl.SessionContext, connector client.Connector, isT0 bool, gwID string) (*model.LocaleServices, error) {
+	if isT0 {
+		return getPolicyTier0GatewayLocaleServiceWithEdgeCluster(context, gwID, connector)
+	}
+	return getPolicyTier1GatewayLocaleServiceEntry(context, gwID, connector)
+}
+
 func getComputedLocaleServiceIDSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
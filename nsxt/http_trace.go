@@ -0,0 +1,241 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	redactedValue        = "<redacted>"
+	nsxRequestIDHeader   = "X-Nsx-Requestid"
+	traceTimestampFormat = time.RFC3339Nano
+)
+
+// Fields that are always redacted, regardless of provider schema
+var defaultSensitiveFields = []string{"password", "j_password", "psk", "secret", "token", "private_key", "passphrase"}
+
+var (
+	sensitiveFieldsOnce sync.Once
+	sensitiveFields     map[string]bool
+)
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+func collectSensitiveFields(schemas map[string]*schema.Schema, fields map[string]bool) {
+	for name, s := range schemas {
+		if s.Sensitive {
+			fields[normalizeFieldName(name)] = true
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok {
+			collectSensitiveFields(elem.Schema, fields)
+		}
+	}
+}
+
+// getSensitiveFields returns normalized names of attributes marked as Sensitive
+// in any resource or data source schema. NSX API field names match schema
+// attribute names either in snake or camel case, hence normalization.
+func getSensitiveFields() map[string]bool {
+	sensitiveFieldsOnce.Do(func() {
+		sensitiveFields = make(map[string]bool)
+		for _, name := range defaultSensitiveFields {
+			sensitiveFields[normalizeFieldName(name)] = true
+		}
+		provider := Provider()
+		for _, r := range provider.ResourcesMap {
+			collectSensitiveFields(r.Schema, sensitiveFields)
+		}
+		for _, r := range provider.DataSourcesMap {
+			collectSensitiveFields(r.Schema, sensitiveFields)
+		}
+	})
+	return sensitiveFields
+}
+
+func isSensitiveField(name string) bool {
+	normalized := normalizeFieldName(name)
+	// Any kind of password is redacted, even if not exposed in schema
+	return strings.HasSuffix(normalized, "password") || getSensitiveFields()[normalized]
+}
+
+func redactJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactJSONValue(elem)
+			}
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactJSONValue(elem)
+		}
+	}
+	return value
+}
+
+// redactBody returns body suitable for logging, with sensitive fields
+// redacted. Bodies that can not be parsed are omitted.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "application/x-www-form-urlencoded" {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			for key := range values {
+				if isSensitiveField(key) {
+					values.Set(key, redactedValue)
+				}
+			}
+			return values.Encode()
+		}
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("<omitted %d bytes>", len(body))
+	}
+	redacted, err := marshalTraceJSON(redactJSONValue(value))
+	if err != nil {
+		return fmt.Sprintf("<omitted %d bytes>", len(body))
+	}
+	return string(redacted)
+}
+
+// marshalTraceJSON encodes value without HTML escaping, to keep trace readable
+func marshalTraceJSON(value interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// readBody reads the body and replaces it with in-memory copy, so that it can
+// be consumed again
+func readBody(body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	if body == nil || body == http.NoBody {
+		return nil, body, nil
+	}
+	data, err := io.ReadAll(body)
+	body.Close()
+	return data, io.NopCloser(bytes.NewReader(data)), err
+}
+
+type retryAttemptKey struct{}
+
+// withRetryAttempt marks request context with retry attempt number, for tracing
+func withRetryAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, retryAttemptKey{}, attempt)
+}
+
+func getRetryAttempt(ctx context.Context) int {
+	attempt, _ := ctx.Value(retryAttemptKey{}).(int)
+	return attempt
+}
+
+type httpTraceRecord struct {
+	Time         string `json:"time"`
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Status       int    `json:"status,omitempty"`
+	LatencyMs    int64  `json:"latency_ms"`
+	Retry        int    `json:"retry"`
+	RequestID    string `json:"request_id,omitempty"`
+	RequestBody  string `json:"request_body,omitempty"`
+	ResponseBody string `json:"response_body,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// httpTraceTransport writes JSON record per NSX call into trace file
+type httpTraceTransport struct {
+	next http.RoundTripper
+	mu   sync.Mutex
+	out  io.Writer
+}
+
+func newHTTPTraceTransport(next http.RoundTripper, fileName string) (*httpTraceTransport, error) {
+	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open HTTP trace file: %v", err)
+	}
+	return &httpTraceTransport{
+		next: next,
+		out:  file,
+	}, nil
+}
+
+func (t *httpTraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	record := httpTraceRecord{
+		Time:   time.Now().UTC().Format(traceTimestampFormat),
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+		Retry:  getRetryAttempt(req.Context()),
+	}
+
+	requestBody, body, err := readBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	record.RequestBody = redactBody(req.Header.Get("Content-Type"), requestBody)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		record.LatencyMs = time.Since(start).Milliseconds()
+		record.Error = err.Error()
+		t.write(record)
+		return nil, err
+	}
+
+	responseBody, body, bodyErr := readBody(resp.Body)
+	resp.Body = body
+	record.LatencyMs = time.Since(start).Milliseconds()
+	record.Status = resp.StatusCode
+	record.RequestID = resp.Header.Get(nsxRequestIDHeader)
+	record.ResponseBody = redactBody(resp.Header.Get("Content-Type"), responseBody)
+	if bodyErr != nil {
+		record.Error = bodyErr.Error()
+		t.write(record)
+		return nil, bodyErr
+	}
+	t.write(record)
+	return resp, nil
+}
+
+func (t *httpTraceTransport) write(record httpTraceRecord) {
+	line, err := marshalTraceJSON(record)
+	if err != nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.out.Write(append(line, '\n'))
+}
@@ -0,0 +1,106 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		contentType string
+		body        string
+		expected    string
+	}{
+		{"application/json", `{"display_name":"test","psk":"secret1"}`, `{"display_name":"test","psk":"<redacted>"}`},
+		{"application/json", `{"ldap_servers":[{"password":"secret1","url":"ldap://1.1.1.1"}]}`, `{"ldap_servers":[{"password":"<redacted>","url":"ldap://1.1.1.1"}]}`},
+		{"application/json", `{"rootPassword":"secret1"}`, `{"rootPassword":"<redacted>"}`},
+		{"application/json;charset=utf-8", `[{"old_password":"secret1"}]`, `[{"old_password":"<redacted>"}]`},
+		{"application/x-www-form-urlencoded", "j_username=admin&j_password=secret1", "j_password=%3Credacted%3E&j_username=admin"},
+		{"text/plain", "not json", "<omitted 8 bytes>"},
+		{"application/json", "", ""},
+	}
+
+	for _, tc := range testCases {
+		if result := redactBody(tc.contentType, []byte(tc.body)); result != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, result)
+		}
+	}
+}
+
+type testTraceRoundTripper struct {
+	statuses []int
+}
+
+func (t *testTraceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	io.ReadAll(req.Body)
+	status := t.statuses[0]
+	t.statuses = t.statuses[1:]
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(nsxRequestIDHeader, "req-1")
+	return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(`{"id":"user","password":"secret2"}`))}, nil
+}
+
+func TestHTTPTraceTransport(t *testing.T) {
+	traceFile := filepath.Join(t.TempDir(), "trace.json")
+	trace, err := newHTTPTraceTransport(&testTraceRoundTripper{statuses: []int{503, 200}}, traceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := newRetryTransport(getTestRetryPolicy(retryStrategyUniform, 0, 0), trace)
+
+	req, _ := http.NewRequest(http.MethodPut, "https://nsx/api/v1/node/users/10?action=update", strings.NewReader(`{"password":"secret1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "secret2") {
+		t.Errorf("response body should not be modified for the caller")
+	}
+
+	file, err := os.Open(traceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	contents, _ := io.ReadAll(file)
+	if strings.Contains(string(contents), "secret") {
+		t.Errorf("trace contains sensitive data: %s", contents)
+	}
+
+	var records []httpTraceRecord
+	scanner := bufio.NewScanner(strings.NewReader(string(contents)))
+	for scanner.Scan() {
+		var record httpTraceRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid trace record %s: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 trace records, got %d", len(records))
+	}
+	for i, record := range records {
+		if record.Retry != i || record.Method != http.MethodPut || record.Path != "/api/v1/node/users/10?action=update" || record.RequestID != "req-1" {
+			t.Errorf("unexpected trace record %v", record)
+		}
+		if record.RequestBody != `{"password":"<redacted>"}` {
+			t.Errorf("unexpected request body in trace %s", record.RequestBody)
+		}
+	}
+	if records[0].Status != 503 || records[1].Status != 200 {
+		t.Errorf("unexpected statuses in trace: %d, %d", records[0].Status, records[1].Status)
+	}
+}
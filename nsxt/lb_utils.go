@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vmware-nsxt/loadbalancer"
@@ -300,6 +302,65 @@ func resourceNsxtPolicyLBMonitorProfileDelete(d *schema.ResourceData, m interfac
 	return nil
 }
 
+func resourceNsxtPolicyLBPersistenceProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+	msg := "Error retrieving resource LBPersistenceProfile"
+	return false, logAPIError(msg, err)
+}
+
+func resourceNsxtPolicyLBPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBPersistenceProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	forceParam := true
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	err := client.Delete(id, &forceParam)
+	if err != nil {
+		return handleDeleteError("LBPersistenceProfile", id, err)
+	}
+	return nil
+}
+
+// Helpers for common LB persistence profile schema settings
+func getPolicyLbPersistenceSharedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether persistence entries are shared among virtual servers referring this profile",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func getPolicyLbHaPersistenceMirroringSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether persistence entries are synchronized to the HA peer",
+		Optional:    true,
+		Default:     false,
+	}
+}
+
+func getPolicyLbPersistenceTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Persistence expiration time in seconds, counted from the time all the connections are completed",
+		Optional:     true,
+		Default:      300,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
 func getLbServerSslSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -388,3 +449,74 @@ func setLbServerSslInSchema(d *schema.ResourceData, lBServerSsl model.LBServerSs
 		log.Printf("[WARNING] Failed to set server_ssl in schema: %v", err)
 	}
 }
+
+func getPolicyLbStatusWaitSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"wait_until_healthy": {
+			Type:        schema.TypeBool,
+			Description: "Wait until status is UP before returning",
+			Optional:    true,
+			Default:     false,
+		},
+		"timeout": {
+			Type:         schema.TypeInt,
+			Description:  "Timeout for waiting until healthy in seconds",
+			Optional:     true,
+			Default:      600,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"delay": {
+			Type:         schema.TypeInt,
+			Description:  "Initial delay to start status checks in seconds",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+// waitForPolicyLbStatus reads runtime status once, or polls it until UP
+// if wait_until_healthy is set. Refresh function is expected to populate
+// the schema and return current status. While polling, errors such as status
+// not being available yet are retried until timeout.
+func waitForPolicyLbStatus(d *schema.ResourceData, m interface{}, path string, refresh func() (string, error)) error {
+	if !d.Get("wait_until_healthy").(bool) {
+		_, err := refresh()
+		return err
+	}
+
+	lastStatus := model.LBServiceStatus_SERVICE_STATUS_UNKNOWN
+	var lastErr error
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"HEALTHY"},
+		Refresh: func() (interface{}, string, error) {
+			status, err := refresh()
+			if err != nil {
+				// Status might not be available yet for newly created objects,
+				// keep polling until timeout
+				log.Printf("[DEBUG] Failed to read status for %s: %v", path, err)
+				lastErr = err
+				return lastStatus, "PENDING", nil
+			}
+			lastErr = nil
+			lastStatus = status
+			log.Printf("[DEBUG] Current status for %s is %s", path, status)
+			if status == model.LBServiceStatus_SERVICE_STATUS_UP {
+				return status, "HEALTHY", nil
+			}
+			return status, "PENDING", nil
+		},
+		Timeout:    time.Duration(d.Get("timeout").(int)) * time.Second,
+		MinTimeout: 1 * time.Second,
+		Delay:      time.Duration(d.Get("delay").(int)) * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("Failed to wait for %s to become healthy, last error: %v", path, lastErr)
+		}
+		return fmt.Errorf("Failed to wait for %s to become healthy, last status %s: %v", path, lastStatus, err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	vapiBindings_ "github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
 )
@@ -41,8 +42,13 @@ type Metadata struct {
 	// Only applicable to PolymorphicTypeNested schema
 	ResourceTypeMap map[string]string
 	// Type identifier name for both SDK and JSON (StructValue field name)
-	TypeIdentifier      TypeIdentifier
+	TypeIdentifier TypeIdentifier
+	// Attribute is only sent to NSX starting with this version. Configuring
+	// the attribute against older NSX results in an error.
 	IntroducedInVersion string
+	// SDK enum constants allowed for string attribute, or for string elements
+	// of list, set or map. Validation is added to the schema automatically.
+	EnumValues []string
 	// skip handling of this attribute - it will be done manually
 	Skip        bool
 	ReflectType reflect.Type
@@ -104,27 +110,36 @@ func GetSchemaFromExtendedSchema(ext map[string]*ExtendedSchema) map[string]*sch
 
 	for key, value := range ext {
 		logger.Printf("[TRACE] inspecting schema key %s, value %v", key, value)
-		shallowCopy := value.Schema
-		if (value.Schema.Type == schema.TypeList) || (value.Schema.Type == schema.TypeSet) {
-			elem, ok := shallowCopy.Elem.(*ExtendedSchema)
-			if ok {
-				shallowCopy.Elem = &elem.Schema
-			} else {
-				elem, ok := shallowCopy.Elem.(*ExtendedResource)
-				if ok {
-					shallowCopy.Elem = &schema.Resource{
-						Schema: GetSchemaFromExtendedSchema(elem.Schema),
-					}
-				}
-			}
-		}
-		// TODO: deepcopy needed?
-		result[key] = &shallowCopy
+		result[key] = getSchemaFromExtendedSchemaItem(value)
 	}
 
 	return result
 }
 
+func getSchemaFromExtendedSchemaItem(value *ExtendedSchema) *schema.Schema {
+	shallowCopy := value.Schema
+	if len(value.Metadata.EnumValues) > 0 && shallowCopy.ValidateFunc == nil && shallowCopy.ValidateDiagFunc == nil {
+		shallowCopy.ValidateFunc = validation.StringInSlice(value.Metadata.EnumValues, false)
+	}
+	switch shallowCopy.Type {
+	case schema.TypeList, schema.TypeSet, schema.TypeMap:
+		if elem, ok := shallowCopy.Elem.(*ExtendedSchema); ok {
+			shallowCopy.Elem = getSchemaFromExtendedSchemaItem(elem)
+		} else if elem, ok := shallowCopy.Elem.(*ExtendedResource); ok {
+			resource := &schema.Resource{
+				Schema: GetSchemaFromExtendedSchema(elem.Schema),
+			}
+			shallowCopy.Elem = resource
+			if shallowCopy.Type == schema.TypeSet && shallowCopy.Set == nil {
+				// set of structs is keyed by hash of all its attributes
+				shallowCopy.Set = schema.HashResource(resource)
+			}
+		}
+	}
+	// TODO: deepcopy needed?
+	return &shallowCopy
+}
+
 func getContextString(prefix, parent string, elemType reflect.Type) string {
 	ctx := elemType.String()
 	if len(parent) > 0 {
@@ -189,65 +204,102 @@ func StructToSchema(elem reflect.Value, d *schema.ResourceData, metadata map[str
 			logger.Printf("[TRACE] %s adding polymorphic slice %+v to key %s", ctx, nestedVal, key)
 			continue
 		}
-		if item.Metadata.SchemaType == "struct" {
-			nestedObj := elem.FieldByName(item.Metadata.SdkFieldName)
+		field := elem.FieldByName(item.Metadata.SdkFieldName)
+		var value interface{}
+		switch item.Metadata.SchemaType {
+		case "struct":
 			nestedSchema := make(map[string]interface{})
 			childElem := item.Schema.Elem.(*ExtendedResource)
-			if err = StructToSchema(nestedObj.Elem(), d, childElem.Schema, key, nestedSchema); err != nil {
+			if err = StructToSchema(field.Elem(), d, childElem.Schema, key, nestedSchema); err != nil {
 				return
 			}
-			logger.Printf("[TRACE] %s assigning struct %+v to %s", ctx, nestedObj, key)
-			var nestedSlice []map[string]interface{}
-			nestedSlice = append(nestedSlice, nestedSchema)
-			if len(parent) > 0 {
-				parentMap[key] = nestedSlice
-			} else {
-				d.Set(key, nestedSlice)
-			}
-		} else if item.Metadata.SchemaType == "list" || item.Metadata.SchemaType == "set" {
+			value = []interface{}{nestedSchema}
+		case "list", "set":
+			var nestedSlice []interface{}
 			if _, ok := item.Schema.Elem.(*ExtendedSchema); ok {
 				// List of string, bool, int
-				nestedSlice := elem.FieldByName(item.Metadata.SdkFieldName)
-				logger.Printf("[TRACE] %s assigning slice %v to %s", ctx, nestedSlice.Interface(), key)
-				if len(parent) > 0 {
-					parentMap[key] = nestedSlice.Interface()
-				} else {
-					d.Set(key, nestedSlice.Interface())
+				for i := 0; i < field.Len(); i++ {
+					nestedSlice = append(nestedSlice, getSchemaValueFromField(field.Index(i)))
 				}
 			} else if childElem, ok := item.Schema.Elem.(*ExtendedResource); ok {
 				// List of struct
-				sliceElem := elem.FieldByName(item.Metadata.SdkFieldName)
-				var nestedSlice []map[string]interface{}
-				for i := 0; i < sliceElem.Len(); i++ {
+				for i := 0; i < field.Len(); i++ {
 					nestedSchema := make(map[string]interface{})
-					if err = StructToSchema(sliceElem.Index(i), d, childElem.Schema, key, nestedSchema); err != nil {
+					if err = StructToSchema(field.Index(i), d, childElem.Schema, key, nestedSchema); err != nil {
 						return
 					}
 					nestedSlice = append(nestedSlice, nestedSchema)
-					logger.Printf("[TRACE] %s appending slice item %+v to %s", ctx, nestedSchema, key)
 				}
-				if len(parent) > 0 {
-					parentMap[key] = nestedSlice
-				} else {
-					d.Set(key, nestedSlice)
+			}
+			value = nestedSlice
+			if item.Metadata.SchemaType == "set" {
+				// hash elements same way terraform does, so that set value
+				// is consistent when nested in another list or set
+				set := getSchemaFromExtendedSchemaItem(item).ZeroValue().(*schema.Set)
+				for _, v := range nestedSlice {
+					set.Add(v)
 				}
+				value = set
 			}
-		} else {
-			if len(parent) > 0 {
-				logger.Printf("[TRACE] %s assigning nested value %+v to %s",
-					ctx, elem.FieldByName(item.Metadata.SdkFieldName).Interface(), key)
-				parentMap[key] = elem.FieldByName(item.Metadata.SdkFieldName).Interface()
-			} else {
-				logger.Printf("[TRACE] %s assigning value %+v to %s",
-					ctx, elem.FieldByName(item.Metadata.SdkFieldName).Interface(), key)
-				d.Set(key, elem.FieldByName(item.Metadata.SdkFieldName).Interface())
+		case "map":
+			nestedMap := make(map[string]interface{})
+			iter := field.MapRange()
+			for iter.Next() {
+				nestedMap[iter.Key().String()] = getSchemaValueFromField(iter.Value())
 			}
+			value = nestedMap
+		default:
+			value = getSchemaValueFromField(field)
+		}
+
+		logger.Printf("[TRACE] %s assigning %+v to %s", ctx, value, key)
+		if len(parent) > 0 {
+			parentMap[key] = value
+		} else {
+			d.Set(key, value)
 		}
 	}
 
 	return
 }
 
+// getSchemaValueFromField converts SDK scalar to the type terraform schema
+// holds, so that the value can be hashed when part of a set
+func getSchemaValueFromField(field reflect.Value) interface{} {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			return nil
+		}
+		field = field.Elem()
+	}
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(field.Int())
+	case reflect.Float32, reflect.Float64:
+		return field.Float()
+	}
+	return field.Interface()
+}
+
+// isSchemaValueConfigured returns false if value is empty or equals the
+// schema default
+func isSchemaValueConfigured(value interface{}, sch *schema.Schema) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case *schema.Set:
+		return v.Len() > 0
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	if sch.Default != nil && reflect.DeepEqual(value, sch.Default) {
+		return false
+	}
+	return !reflect.ValueOf(value).IsZero()
+}
+
 // SchemaToStruct converts terraform schema to NSX model struct
 // currently supports nested subtype and trivial types
 func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[string]*ExtendedSchema, parent string, parentMap map[string]interface{}) (err error) {
@@ -269,6 +321,17 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 			continue
 		}
 		if item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion) {
+			var value interface{}
+			if len(parent) > 0 {
+				value = parentMap[key]
+			} else {
+				value = d.Get(key)
+			}
+			if isSchemaValueConfigured(value, &item.Schema) {
+				err = fmt.Errorf("attribute %s is not supported in NSX versions lower than %s", key, item.Metadata.IntroducedInVersion)
+				logger.Printf("[ERROR] %s %v", ctx, err)
+				return
+			}
 			logger.Printf("[TRACE] %s skip key %s as NSX version is lower than %v", ctx, key, item.Metadata.IntroducedInVersion)
 			continue
 		}
@@ -332,6 +395,10 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 			} else {
 				value = int64(d.Get(key).(int))
 			}
+			if item.Metadata.OmitIfEmpty && value == 0 {
+				logger.Printf("[TRACE] %s skip key %s since its empty and OmitIfEmpty is true", ctx, key)
+				continue
+			}
 			logger.Printf("[TRACE] %s assigning int %v to %s", ctx, value, key)
 			elem.FieldByName(item.Metadata.SdkFieldName).Set(reflect.ValueOf(&value))
 		}
@@ -350,6 +417,20 @@ func SchemaToStruct(elem reflect.Value, d *schema.ResourceData, metadata map[str
 			logger.Printf("[TRACE] %s assigning struct %v to %s", ctx, nestedObj, key)
 			elem.FieldByName(item.Metadata.SdkFieldName).Set(nestedObj)
 		}
+		if item.Metadata.SchemaType == "map" {
+			var itemMap map[string]interface{}
+			if len(parent) > 0 {
+				itemMap = parentMap[key].(map[string]interface{})
+			} else {
+				itemMap = d.Get(key).(map[string]interface{})
+			}
+			mapElem := elem.FieldByName(item.Metadata.SdkFieldName)
+			mapElem.Set(reflect.MakeMapWithSize(mapElem.Type(), len(itemMap)))
+			for k, v := range itemMap {
+				mapElem.SetMapIndex(reflect.ValueOf(k), reflect.ValueOf(v).Convert(mapElem.Type().Elem()))
+				logger.Printf("[TRACE] %s assigning %v to %s[%s]", ctx, v, key, k)
+			}
+		}
 		if item.Metadata.SchemaType == "list" || item.Metadata.SchemaType == "set" {
 			itemList := getItemListForSchemaToStruct(d, item.Metadata.SchemaType, key, parent, parentMap)
 			// List of string, bool, int
@@ -407,10 +488,17 @@ func getItemListForSchemaToStruct(d *schema.ResourceData, schemaType, key, paren
 			itemList = d.Get(key).([]interface{})
 		}
 	} else if schemaType == "set" {
+		var value interface{}
 		if len(parent) > 0 {
-			itemList = parentMap[key].(*schema.Set).List()
+			value = parentMap[key]
 		} else {
-			itemList = d.Get(key).(*schema.Set).List()
+			value = d.Get(key)
+		}
+		switch v := value.(type) {
+		case *schema.Set:
+			itemList = v.List()
+		case []interface{}:
+			itemList = v
 		}
 	}
 	return itemList
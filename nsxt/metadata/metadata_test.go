@@ -7,6 +7,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 )
 
 type testStruct struct {
@@ -717,3 +719,349 @@ func TestSchemaToStructEmptySlice(t *testing.T) {
 		assert.Equal(t, 0, len(obj.StructList))
 	})
 }
+
+type testMapStruct struct {
+	StringMap  map[string]string
+	IntMap     map[string]int64
+	StructSet  []testSetStruct
+	EnumField  *string
+	NewField   *string
+	NewIntList []int64
+}
+
+type testSetStruct struct {
+	Name      *string
+	Port      *int64
+	NestedSet []testNestedStruct
+}
+
+func testMapExtendedSchema() map[string]*ExtendedSchema {
+	return map[string]*ExtendedSchema{
+		"string_map": {
+			Schema: schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     basicStringSchema("", false),
+			},
+			Metadata: Metadata{
+				SchemaType:   "map",
+				SdkFieldName: "StringMap",
+			},
+		},
+		"int_map": {
+			Schema: schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     basicIntSchema("", false),
+			},
+			Metadata: Metadata{
+				SchemaType:   "map",
+				SdkFieldName: "IntMap",
+			},
+		},
+		"struct_set": {
+			Schema: schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &ExtendedResource{
+					Schema: map[string]*ExtendedSchema{
+						"name": basicStringSchema("Name", true),
+						"port": basicIntSchema("Port", true),
+						"nested_set": {
+							Schema: basicStructSchema("set"),
+							Metadata: Metadata{
+								SchemaType:   "set",
+								SdkFieldName: "NestedSet",
+								ReflectType:  reflect.TypeOf(testNestedStruct{}),
+							},
+						},
+					},
+				},
+			},
+			Metadata: Metadata{
+				SchemaType:   "set",
+				SdkFieldName: "StructSet",
+				ReflectType:  reflect.TypeOf(testSetStruct{}),
+			},
+		},
+		"enum_field": {
+			Schema: schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "ALLOW",
+			},
+			Metadata: Metadata{
+				SchemaType:          "string",
+				SdkFieldName:        "EnumField",
+				EnumValues:          []string{"ALLOW", "DROP"},
+				IntroducedInVersion: "9.0.0",
+			},
+		},
+		"new_field": {
+			Schema: schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			Metadata: Metadata{
+				SchemaType:          "string",
+				SdkFieldName:        "NewField",
+				IntroducedInVersion: "9.0.0",
+			},
+		},
+		"new_int_list": {
+			Schema: schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     basicIntSchema("", false),
+			},
+			Metadata: Metadata{
+				SchemaType:          "list",
+				SdkFieldName:        "NewIntList",
+				IntroducedInVersion: "9.0.0",
+			},
+		},
+	}
+}
+
+func TestGetSchemaFromExtendedSchemaEnum(t *testing.T) {
+	enumElem := basicStringSchema("", false)
+	enumElem.Metadata.EnumValues = []string{"TCP", "UDP"}
+	sch := GetSchemaFromExtendedSchema(map[string]*ExtendedSchema{
+		"enum_field": testMapExtendedSchema()["enum_field"],
+		"enum_list": {
+			Schema: schema.Schema{
+				Type: schema.TypeList,
+				Elem: enumElem,
+			},
+			Metadata: Metadata{
+				SchemaType:   "list",
+				SdkFieldName: "EnumList",
+			},
+		},
+	})
+
+	testCases := []struct {
+		name    string
+		sch     *schema.Schema
+		value   string
+		isValid bool
+	}{
+		{"valid value", sch["enum_field"], "DROP", true},
+		{"invalid value", sch["enum_field"], "REJECT", false},
+		{"case sensitive", sch["enum_field"], "drop", false},
+		{"valid list element", sch["enum_list"].Elem.(*schema.Schema), "UDP", true},
+		{"invalid list element", sch["enum_list"].Elem.(*schema.Schema), "ICMP", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !assert.NotNil(t, tc.sch.ValidateFunc) {
+				return
+			}
+			_, errs := tc.sch.ValidateFunc(tc.value, "key")
+			assert.Equal(t, tc.isValid, len(errs) == 0)
+		})
+	}
+}
+
+func TestStructToSchemaMapAndSet(t *testing.T) {
+	name1, name2, nestStr := "http", "https", "nested"
+	port1, port2, nestInt := int64(80), int64(443), int64(1)
+	nestBool := true
+	obj := testMapStruct{
+		StringMap: map[string]string{"k1": "v1", "k2": "v2"},
+		IntMap:    map[string]int64{"k1": 1},
+		StructSet: []testSetStruct{
+			{Name: &name1, Port: &port1},
+			{Name: &name2, Port: &port2, NestedSet: []testNestedStruct{
+				{StringField: &nestStr, BoolField: &nestBool, IntField: &nestInt},
+			}},
+		},
+	}
+	extSchema := testMapExtendedSchema()
+	d := schema.TestResourceDataRaw(t, GetSchemaFromExtendedSchema(extSchema), map[string]interface{}{})
+	err := StructToSchema(reflect.ValueOf(&obj).Elem(), d, extSchema, "", nil)
+	assert.NoError(t, err, "unexpected error calling StructToSchema")
+
+	testCases := []struct {
+		name     string
+		key      string
+		expected interface{}
+	}{
+		{"string map", "string_map", map[string]interface{}{"k1": "v1", "k2": "v2"}},
+		{"int map", "int_map", map[string]interface{}{"k1": 1}},
+		{"set size", "struct_set.#", 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, d.Get(tc.key))
+		})
+	}
+
+	t.Run("set elements", func(t *testing.T) {
+		elems := make(map[string]map[string]interface{})
+		for _, elem := range d.Get("struct_set").(*schema.Set).List() {
+			elemMap := elem.(map[string]interface{})
+			elems[elemMap["name"].(string)] = elemMap
+		}
+		assert.Equal(t, 80, elems["http"]["port"])
+		assert.Equal(t, 0, elems["http"]["nested_set"].(*schema.Set).Len())
+		assert.Equal(t, 443, elems["https"]["port"])
+		nestedSet := elems["https"]["nested_set"].(*schema.Set).List()
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"string_field": nestStr,
+			"bool_field":   nestBool,
+			"int_field":    1,
+		}}, nestedSet)
+	})
+}
+
+func TestSchemaToStructMapAndSet(t *testing.T) {
+	extSchema := testMapExtendedSchema()
+	d := schema.TestResourceDataRaw(t, GetSchemaFromExtendedSchema(extSchema), map[string]interface{}{
+		"string_map": map[string]interface{}{"k1": "v1"},
+		"int_map":    map[string]interface{}{"k1": 1, "k2": 2},
+		"struct_set": []interface{}{
+			map[string]interface{}{
+				"name": "https",
+				"port": 443,
+				"nested_set": []interface{}{
+					map[string]interface{}{"string_field": "nested", "bool_field": true, "int_field": 1},
+				},
+			},
+		},
+	})
+	delete(extSchema, "enum_field")
+	delete(extSchema, "new_field")
+	delete(extSchema, "new_int_list")
+
+	obj := testMapStruct{}
+	err := SchemaToStruct(reflect.ValueOf(&obj).Elem(), d, extSchema, "", nil)
+	assert.NoError(t, err, "unexpected error calling SchemaToStruct")
+
+	name, nestStr := "https", "nested"
+	port, nestInt := int64(443), int64(1)
+	nestBool := true
+	testCases := []struct {
+		name     string
+		expected interface{}
+		actual   interface{}
+	}{
+		{"string map", map[string]string{"k1": "v1"}, obj.StringMap},
+		{"int map", map[string]int64{"k1": 1, "k2": 2}, obj.IntMap},
+		{"struct set", []testSetStruct{{
+			Name: &name,
+			Port: &port,
+			NestedSet: []testNestedStruct{
+				{StringField: &nestStr, BoolField: &nestBool, IntField: &nestInt},
+			},
+		}}, obj.StructSet},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualValues(t, tc.expected, tc.actual)
+		})
+	}
+}
+
+func TestSchemaToStructIntroducedInVersion(t *testing.T) {
+	savedVersion := util.NsxVersion
+	defer func() {
+		util.NsxVersion = savedVersion
+	}()
+
+	testCases := []struct {
+		name        string
+		nsxVersion  string
+		values      map[string]interface{}
+		expectError bool
+		expected    testMapStruct
+	}{
+		{
+			name:       "old NSX, attributes not configured",
+			nsxVersion: "4.1.0",
+			values:     map[string]interface{}{},
+		},
+		{
+			name:       "old NSX, default value",
+			nsxVersion: "4.1.0",
+			values:     map[string]interface{}{"enum_field": "ALLOW"},
+		},
+		{
+			name:        "old NSX, string configured",
+			nsxVersion:  "4.1.0",
+			values:      map[string]interface{}{"new_field": "value"},
+			expectError: true,
+		},
+		{
+			name:        "old NSX, non-default enum configured",
+			nsxVersion:  "4.1.0",
+			values:      map[string]interface{}{"enum_field": "DROP"},
+			expectError: true,
+		},
+		{
+			name:        "old NSX, list configured",
+			nsxVersion:  "4.1.0",
+			values:      map[string]interface{}{"new_int_list": []interface{}{1}},
+			expectError: true,
+		},
+		{
+			name:       "new NSX",
+			nsxVersion: "9.0.0",
+			values:     map[string]interface{}{"new_field": "value", "new_int_list": []interface{}{1}},
+			expected: testMapStruct{
+				EnumField:  strPtr("ALLOW"),
+				NewField:   strPtr("value"),
+				NewIntList: []int64{1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			util.NsxVersion = tc.nsxVersion
+			extSchema := map[string]*ExtendedSchema{}
+			for _, key := range []string{"enum_field", "new_field", "new_int_list"} {
+				extSchema[key] = testMapExtendedSchema()[key]
+			}
+			d := schema.TestResourceDataRaw(t, GetSchemaFromExtendedSchema(extSchema), tc.values)
+
+			obj := testMapStruct{}
+			err := SchemaToStruct(reflect.ValueOf(&obj).Elem(), d, extSchema, "", nil)
+			if tc.expectError {
+				assert.ErrorContains(t, err, "is not supported in NSX versions lower than 9.0.0")
+				return
+			}
+			assert.NoError(t, err)
+			assert.EqualValues(t, tc.expected, obj)
+		})
+	}
+}
+
+func TestSchemaToStructOmitIfEmpty(t *testing.T) {
+	extSchema := map[string]*ExtendedSchema{
+		"string_field": basicStringSchema("StringField", true),
+		"int_field":    basicIntSchema("IntField", true),
+	}
+	extSchema["string_field"].Metadata.OmitIfEmpty = true
+	extSchema["int_field"].Metadata.OmitIfEmpty = true
+
+	d := schema.TestResourceDataRaw(t, GetSchemaFromExtendedSchema(extSchema), map[string]interface{}{})
+	obj := testNestedStruct{}
+	err := SchemaToStruct(reflect.ValueOf(&obj).Elem(), d, extSchema, "", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, obj.StringField)
+	assert.Nil(t, obj.IntField)
+
+	d = schema.TestResourceDataRaw(t, GetSchemaFromExtendedSchema(extSchema), map[string]interface{}{
+		"string_field": "value",
+		"int_field":    10,
+	})
+	err = SchemaToStruct(reflect.ValueOf(&obj).Elem(), d, extSchema, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "value", *obj.StringField)
+	assert.Equal(t, int64(10), *obj.IntField)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
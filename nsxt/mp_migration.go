@@ -0,0 +1,422 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	mp_search "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/search"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// mpMigrationType maps deprecated Manager resource to Policy resource its
+// objects are promoted to. When same Manager type is backed by several
+// terraform resources, match decides by Manager object
+type mpMigrationType struct {
+	mpTFType     string
+	mpType       string
+	policyTFType string
+	policyType   string
+	match        func(obj *data.StructValue) bool
+}
+
+func isMPVlanLogicalSwitch(obj *data.StructValue) bool {
+	return obj.HasField("vlan")
+}
+
+func isMPOverlayLogicalSwitch(obj *data.StructValue) bool {
+	return !isMPVlanLogicalSwitch(obj)
+}
+
+func isMPTier0Router(obj *data.StructValue) bool {
+	routerType, _ := obj.String("router_type")
+	return routerType == "TIER0"
+}
+
+func isMPTier1Router(obj *data.StructValue) bool {
+	routerType, _ := obj.String("router_type")
+	return routerType == "TIER1"
+}
+
+// Manager resources not listed here, such as router ports, are either
+// promoted as part of other objects or have no Policy counterpart
+var mpMigrationTypes = []mpMigrationType{
+	{mpTFType: "nsxt_algorithm_type_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_dhcp_relay_service", mpType: "DhcpRelayService", policyTFType: "nsxt_policy_dhcp_relay", policyType: "DhcpRelayConfig"},
+	{mpTFType: "nsxt_ether_type_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_firewall_section", mpType: "FirewallSection", policyTFType: "nsxt_policy_security_policy", policyType: "SecurityPolicy"},
+	{mpTFType: "nsxt_icmp_type_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_igmp_type_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_ip_block", mpType: "IpBlock", policyTFType: "nsxt_policy_ip_block", policyType: "IpAddressBlock"},
+	{mpTFType: "nsxt_ip_pool", mpType: "IpPool", policyTFType: "nsxt_policy_ip_pool", policyType: "IpAddressPool"},
+	{mpTFType: "nsxt_ip_protocol_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_ip_set", mpType: "IPSet", policyTFType: "nsxt_policy_group", policyType: "Group"},
+	{mpTFType: "nsxt_l4_port_set_ns_service", mpType: "NSService", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_lb_client_ssl_profile", mpType: "LbClientSslProfile", policyTFType: "nsxt_policy_lb_client_ssl_profile", policyType: "LBClientSslProfile"},
+	{mpTFType: "nsxt_lb_cookie_persistence_profile", mpType: "LbCookiePersistenceProfile", policyTFType: "nsxt_policy_lb_cookie_persistence_profile", policyType: "LBCookiePersistenceProfile"},
+	{mpTFType: "nsxt_lb_fast_tcp_application_profile", mpType: "LbFastTcpProfile", policyTFType: "nsxt_policy_lb_fast_tcp_application_profile", policyType: "LBFastTcpProfile"},
+	{mpTFType: "nsxt_lb_fast_udp_application_profile", mpType: "LbFastUdpProfile", policyTFType: "nsxt_policy_lb_fast_udp_application_profile", policyType: "LBFastUdpProfile"},
+	{mpTFType: "nsxt_lb_http_application_profile", mpType: "LbHttpProfile", policyTFType: "nsxt_policy_lb_http_application_profile", policyType: "LBHttpProfile"},
+	{mpTFType: "nsxt_lb_http_monitor", mpType: "LbHttpMonitor", policyTFType: "nsxt_policy_lb_http_monitor_profile", policyType: "LBHttpMonitorProfile"},
+	{mpTFType: "nsxt_lb_http_virtual_server", mpType: "LbVirtualServer", policyTFType: "nsxt_policy_lb_virtual_server", policyType: "LBVirtualServer"},
+	{mpTFType: "nsxt_lb_https_monitor", mpType: "LbHttpsMonitor", policyTFType: "nsxt_policy_lb_https_monitor_profile", policyType: "LBHttpsMonitorProfile"},
+	{mpTFType: "nsxt_lb_icmp_monitor", mpType: "LbIcmpMonitor", policyTFType: "nsxt_policy_lb_icmp_monitor_profile", policyType: "LBIcmpMonitorProfile"},
+	{mpTFType: "nsxt_lb_passive_monitor", mpType: "LbPassiveMonitor", policyTFType: "nsxt_policy_lb_passive_monitor_profile", policyType: "LBPassiveMonitorProfile"},
+	{mpTFType: "nsxt_lb_pool", mpType: "LbPool", policyTFType: "nsxt_policy_lb_pool", policyType: "LBPool"},
+	{mpTFType: "nsxt_lb_server_ssl_profile", mpType: "LbServerSslProfile", policyTFType: "nsxt_policy_lb_server_ssl_profile", policyType: "LBServerSslProfile"},
+	{mpTFType: "nsxt_lb_service", mpType: "LbService", policyTFType: "nsxt_policy_lb_service", policyType: "LBService"},
+	{mpTFType: "nsxt_lb_source_ip_persistence_profile", mpType: "LbSourceIpPersistenceProfile", policyTFType: "nsxt_policy_lb_source_ip_persistence_profile", policyType: "LBSourceIpPersistenceProfile"},
+	{mpTFType: "nsxt_lb_tcp_monitor", mpType: "LbTcpMonitor", policyTFType: "nsxt_policy_lb_tcp_monitor_profile", policyType: "LBTcpMonitorProfile"},
+	{mpTFType: "nsxt_lb_tcp_virtual_server", mpType: "LbVirtualServer", policyTFType: "nsxt_policy_lb_virtual_server", policyType: "LBVirtualServer"},
+	{mpTFType: "nsxt_lb_udp_monitor", mpType: "LbUdpMonitor", policyTFType: "nsxt_policy_lb_udp_monitor_profile", policyType: "LBUdpMonitorProfile"},
+	{mpTFType: "nsxt_lb_udp_virtual_server", mpType: "LbVirtualServer", policyTFType: "nsxt_policy_lb_virtual_server", policyType: "LBVirtualServer"},
+	{mpTFType: "nsxt_logical_dhcp_server", mpType: "LogicalDhcpServer", policyTFType: "nsxt_policy_dhcp_server", policyType: "DhcpServerConfig"},
+	{mpTFType: "nsxt_logical_port", mpType: "LogicalPort", policyTFType: "nsxt_policy_segment_port", policyType: "SegmentPort"},
+	{mpTFType: "nsxt_logical_switch", mpType: "LogicalSwitch", policyTFType: "nsxt_policy_segment", policyType: "Segment", match: isMPOverlayLogicalSwitch},
+	{mpTFType: "nsxt_logical_tier0_router", mpType: "LogicalRouter", policyTFType: "nsxt_policy_tier0_gateway", policyType: "Tier0", match: isMPTier0Router},
+	{mpTFType: "nsxt_logical_tier1_router", mpType: "LogicalRouter", policyTFType: "nsxt_policy_tier1_gateway", policyType: "Tier1", match: isMPTier1Router},
+	{mpTFType: "nsxt_mac_management_switching_profile", mpType: "MacManagementSwitchingProfile", policyTFType: "nsxt_policy_mac_discovery_profile", policyType: "MacDiscoveryProfile"},
+	{mpTFType: "nsxt_nat_rule", mpType: "NatRule", policyTFType: "nsxt_policy_nat_rule", policyType: "PolicyNatRule"},
+	{mpTFType: "nsxt_ns_group", mpType: "NSGroup", policyTFType: "nsxt_policy_group", policyType: "Group"},
+	{mpTFType: "nsxt_ns_service_group", mpType: "NSServiceGroup", policyTFType: "nsxt_policy_service", policyType: "Service"},
+	{mpTFType: "nsxt_qos_switching_profile", mpType: "QosSwitchingProfile", policyTFType: "nsxt_policy_qos_profile", policyType: "QoSProfile"},
+	{mpTFType: "nsxt_spoofguard_switching_profile", mpType: "SpoofGuardSwitchingProfile", policyTFType: "nsxt_policy_spoof_guard_profile", policyType: "SpoofGuardProfile"},
+	{mpTFType: "nsxt_static_route", mpType: "StaticRoute", policyTFType: "nsxt_policy_static_route", policyType: "StaticRoutes"},
+	{mpTFType: "nsxt_switch_security_switching_profile", mpType: "SwitchSecuritySwitchingProfile", policyTFType: "nsxt_policy_segment_security_profile", policyType: "SegmentSecurityProfile"},
+	{mpTFType: "nsxt_vlan_logical_switch", mpType: "LogicalSwitch", policyTFType: "nsxt_policy_vlan_segment", policyType: "Segment", match: isMPVlanLogicalSwitch},
+}
+
+func getMPMigrationTypeByTFType(tfType string) *mpMigrationType {
+	for i := range mpMigrationTypes {
+		if mpMigrationTypes[i].mpTFType == tfType {
+			return &mpMigrationTypes[i]
+		}
+	}
+	return nil
+}
+
+func getMPMigrationTypeByObject(mpType string, obj *data.StructValue) *mpMigrationType {
+	for i := range mpMigrationTypes {
+		t := &mpMigrationTypes[i]
+		if t.mpType == mpType && (t.match == nil || t.match(obj)) {
+			return t
+		}
+	}
+	return nil
+}
+
+// mpMigrationSource is Manager object to be migrated. Address is empty for
+// objects that are not managed by terraform
+type mpMigrationSource struct {
+	mpType          *mpMigrationType
+	id              string
+	address         string
+	resourceAddress string
+	name            string
+}
+
+func (s *mpMigrationSource) description() string {
+	if s.address != "" {
+		return s.address
+	}
+	return fmt.Sprintf("%s %s", s.mpType.mpType, s.id)
+}
+
+// MPMigrationMapping describes Manager object and Policy object it was
+// promoted to
+type MPMigrationMapping struct {
+	// Terraform address of Manager resource, or Manager type and ID for
+	// objects that are not in terraform state
+	Source     string
+	ID         string
+	PolicyPath string
+	// Terraform address of generated Policy resource
+	Target string
+}
+
+// MPMigrationResult holds mapping of Manager objects to Policy objects, and
+// terraform configuration for the Policy objects keyed by file name
+type MPMigrationResult struct {
+	Mappings []MPMigrationMapping
+	Files    map[string]string
+	Warnings []string
+}
+
+// GenerateMPMigration maps Manager objects promoted to Policy to their Policy
+// paths, and generates Policy configuration with import blocks for them.
+// When state is specified, Manager resources are taken from terraform state
+// and removed blocks are generated for them. Otherwise, all Manager objects
+// of supported types are looked up on NSX.
+func GenerateMPMigration(ctx context.Context, provider *schema.Provider, state []byte) (*MPMigrationResult, error) {
+	meta := provider.Meta()
+	if meta == nil {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+	if isPolicyGlobalManager(meta) {
+		return nil, fmt.Errorf("Manager to Policy migration is not supported on Global Manager")
+	}
+	return generateMPMigration(ctx, provider.ResourcesMap, meta, state)
+}
+
+func generateMPMigration(ctx context.Context, resources map[string]*schema.Resource, m interface{}, state []byte) (*MPMigrationResult, error) {
+	connector := getPolicyConnector(m)
+	result := &MPMigrationResult{Files: make(map[string]string)}
+
+	var sources []*mpMigrationSource
+	var err error
+	if state != nil {
+		sources, result.Warnings, err = getMPMigrationSourcesFromState(resources, state)
+	} else {
+		sources, err = getMPMigrationSourcesFromNSX(connector)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	usedNames := make(map[string]bool)
+	usedPaths := make(map[string]string)
+	// removed block applies to all instances of the resource, hence it is
+	// only generated when each instance of the resource was mapped
+	instances := make(map[string]int)
+	var resourceAddresses []string
+	for _, source := range sources {
+		if source.resourceAddress == "" {
+			continue
+		}
+		if instances[source.resourceAddress] == 0 {
+			resourceAddresses = append(resourceAddresses, source.resourceAddress)
+		}
+		instances[source.resourceAddress]++
+	}
+	mappedInstances := make(map[string]int)
+	var objects []*policyConfigGeneratorObject
+	for _, source := range sources {
+		policyObj, err := findMPMigrationPolicyObject(connector, source.mpType, source.id)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: %v", source.description(), err))
+			continue
+		}
+		if policyObj == nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: object was not promoted to Policy", source.description()))
+			continue
+		}
+		path := *policyObj.Path
+		if previous, ok := usedPaths[path]; ok {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: Policy object %s is already mapped to %s", source.description(), path, previous))
+			continue
+		}
+
+		tfType := source.mpType.policyTFType
+		d, importID, err := readPolicyConfigGeneratorObject(ctx, resources[tfType], m, path, getPolicyConfigGeneratorImportIDs(path, nil))
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: failed to read %s: %v", source.description(), path, err))
+			continue
+		}
+		usedPaths[path] = source.description()
+
+		obj := &policyConfigGeneratorObject{
+			tfType:   tfType,
+			name:     getPolicyConfigGeneratorUniqueName(usedNames, tfType, source.name),
+			path:     path,
+			importID: importID,
+			data:     d,
+		}
+		objects = append(objects, obj)
+		result.Mappings = append(result.Mappings, MPMigrationMapping{
+			Source:     source.description(),
+			ID:         source.id,
+			PolicyPath: path,
+			Target:     obj.address(),
+		})
+		if source.resourceAddress != "" {
+			mappedInstances[source.resourceAddress]++
+		}
+	}
+
+	var removed []string
+	for _, address := range resourceAddresses {
+		mapped := mappedInstances[address]
+		if mapped == instances[address] {
+			removed = append(removed, address)
+		} else if mapped > 0 {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("keeping %s: %d of %d instances were not mapped, remove mapped instances from state with terraform state rm",
+				address, instances[address]-mapped, instances[address]))
+		}
+	}
+
+	result.Warnings = append(result.Warnings, writePolicyConfigGeneratorFiles(result.Files, objects, resources)...)
+	if len(removed) > 0 {
+		var b strings.Builder
+		for i, address := range removed {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "removed {\n  from = %s\n\n  lifecycle {\n    destroy = false\n  }\n}\n", address)
+		}
+		result.Files["removed.tf"] = b.String()
+	}
+	return result, nil
+}
+
+// mpMigrationState is the part of terraform state file (format version 4)
+// needed to locate Manager resources
+type mpMigrationState struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func getMPMigrationSourcesFromState(resources map[string]*schema.Resource, state []byte) ([]*mpMigrationSource, []string, error) {
+	var parsed mpMigrationState
+	if err := json.Unmarshal(state, &parsed); err != nil {
+		return nil, nil, fmt.Errorf("Failed to parse terraform state: %v", err)
+	}
+	if parsed.Version != 4 {
+		return nil, nil, fmt.Errorf("Unsupported terraform state version %d", parsed.Version)
+	}
+
+	var sources []*mpMigrationSource
+	var warnings []string
+	for _, r := range parsed.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+		resourceAddress := r.Type + "." + r.Name
+		if r.Module != "" {
+			resourceAddress = r.Module + "." + resourceAddress
+		}
+		mpType := getMPMigrationTypeByTFType(r.Type)
+		if mpType == nil {
+			if schemaResource, ok := resources[r.Type]; ok && schemaResource.DeprecationMessage == mpObjectResourceDeprecationMessage {
+				warnings = append(warnings, fmt.Sprintf("skipping %s: migration is not supported for %s", resourceAddress, r.Type))
+			}
+			continue
+		}
+		for _, instance := range r.Instances {
+			id, _ := instance.Attributes["id"].(string)
+			if id == "" {
+				continue
+			}
+			address := resourceAddress
+			name := r.Name
+			switch key := instance.IndexKey.(type) {
+			case string:
+				address = fmt.Sprintf("%s[%q]", resourceAddress, key)
+				name = fmt.Sprintf("%s_%s", r.Name, key)
+			case float64:
+				address = fmt.Sprintf("%s[%d]", resourceAddress, int(key))
+				name = fmt.Sprintf("%s_%d", r.Name, int(key))
+			}
+			sources = append(sources, &mpMigrationSource{
+				mpType:          mpType,
+				id:              id,
+				address:         address,
+				resourceAddress: resourceAddress,
+				name:            name,
+			})
+		}
+	}
+	return sources, warnings, nil
+}
+
+func getMPMigrationSourcesFromNSX(connector client.Connector) ([]*mpMigrationSource, error) {
+	var mpTypes []string
+	seen := make(map[string]bool)
+	for _, t := range mpMigrationTypes {
+		if !seen[t.mpType] {
+			seen[t.mpType] = true
+			mpTypes = append(mpTypes, t.mpType)
+		}
+	}
+	query := fmt.Sprintf("resource_type:(%s)", strings.Join(mpTypes, " OR "))
+
+	searchClient := mp_search.NewQueryClient(connector)
+	var results []*data.StructValue
+	var cursor *string
+	for {
+		searchResponse, err := searchClient.List(query, cursor, nil, nil, nil, nil)
+		if err != nil {
+			return nil, logAPIError("Error searching Manager objects", err)
+		}
+		results = append(results, searchResponse.Results...)
+		cursor = searchResponse.Cursor
+		if cursor == nil || *cursor == "" || searchResponse.ResultCount == nil || len(results) >= int(*searchResponse.ResultCount) {
+			break
+		}
+	}
+
+	var sources []*mpMigrationSource
+	for _, obj := range results {
+		id, _ := obj.String("id")
+		resourceType, _ := obj.String("resource_type")
+		createUser, _ := obj.String("_create_user")
+		if id == "" || createUser == "nsx_policy" || createUser == "system" {
+			// objects realized from Policy, or created by NSX
+			continue
+		}
+		mpType := getMPMigrationTypeByObject(resourceType, obj)
+		if mpType == nil {
+			continue
+		}
+		displayName, _ := obj.String("display_name")
+		if displayName == "" {
+			displayName = id
+		}
+		sources = append(sources, &mpMigrationSource{
+			mpType: mpType,
+			id:     id,
+			name:   displayName,
+		})
+	}
+	return sources, nil
+}
+
+// findMPMigrationPolicyObject finds Policy object promoted from Manager
+// object. Realization ID of promoted object is the Manager object ID.
+func findMPMigrationPolicyObject(connector client.Connector, mpType *mpMigrationType, id string) (*model.PolicyResource, error) {
+	query := fmt.Sprintf("resource_type:%s AND realization_id:%s", mpType.policyType, escapeSpecialCharacters(id))
+	resultValues, err := listPolicyResourcesByQuery(connector, utl.SessionContext{ClientType: utl.Local}, query)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := bindings.NewTypeConverter()
+	var found []model.PolicyResource
+	for _, resultValue := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(resultValue, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.Path != nil {
+			found = append(found, obj)
+		}
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf("found %d %s objects with realization ID %s", len(found), mpType.policyType, id)
+	}
+	if len(found) == 0 {
+		return nil, nil
+	}
+	log.Printf("[DEBUG] Manager object %s was promoted to %s", id, *found[0].Path)
+	return &found[0], nil
+}
@@ -0,0 +1,239 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestMPMigrationTypes(t *testing.T) {
+	resources := Provider().ResourcesMap
+	for _, mpType := range mpMigrationTypes {
+		r, ok := resources[mpType.mpTFType]
+		if !ok {
+			t.Errorf("unknown Manager resource %s", mpType.mpTFType)
+		} else if r.DeprecationMessage != mpObjectResourceDeprecationMessage {
+			t.Errorf("resource %s is not a deprecated Manager resource", mpType.mpTFType)
+		}
+		if _, ok := resources[mpType.policyTFType]; !ok {
+			t.Errorf("unknown Policy resource %s for %s", mpType.policyTFType, mpType.mpTFType)
+		}
+	}
+}
+
+const testMPMigrationState = `{
+  "version": 4,
+  "terraform_version": "1.7.0",
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "nsxt_logical_tier1_router",
+      "name": "t1",
+      "instances": [{"attributes": {"id": "mp-router-1", "display_name": "t1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "nsxt_logical_switch",
+      "name": "ls",
+      "instances": [
+        {"index_key": 0, "attributes": {"id": "mp-ls-1"}},
+        {"index_key": 1, "attributes": {"id": "mp-ls-2"}}
+      ]
+    },
+    {
+      "module": "module.security",
+      "mode": "managed",
+      "type": "nsxt_ns_group",
+      "name": "web",
+      "instances": [{"attributes": {"id": "mp-nsgroup-1"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "nsxt_logical_router_downlink_port",
+      "name": "port",
+      "instances": [{"attributes": {"id": "mp-port-1"}}]
+    },
+    {
+      "mode": "data",
+      "type": "nsxt_logical_tier0_router",
+      "name": "t0",
+      "instances": [{"attributes": {"id": "mp-router-0"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "nsxt_policy_group",
+      "name": "policy",
+      "instances": [{"attributes": {"id": "policy"}}]
+    }
+  ]
+}`
+
+func seedMPMigrationPolicyObjects(srv *simulator.Server) {
+	srv.Seed("/infra/tier-1s/mp-router-1", map[string]interface{}{
+		"display_name":              "t1",
+		"resource_type":             "Tier1",
+		"realization_id":            "mp-router-1",
+		"failover_mode":             "NON_PREEMPTIVE",
+		"disable_firewall":          false,
+		"default_rule_logging":      false,
+		"enable_standby_relocation": false,
+		"force_whitelisting":        false,
+		"pool_allocation":           "ROUTING",
+	})
+	srv.Seed("/infra/segments/web-ls", map[string]interface{}{
+		"display_name":      "web",
+		"resource_type":     "Segment",
+		"realization_id":    "mp-ls-1",
+		"connectivity_path": "/infra/tier-1s/mp-router-1",
+	})
+	srv.Seed("/infra/segments/vlan-ls", map[string]interface{}{
+		"display_name":   "vlan",
+		"resource_type":  "Segment",
+		"realization_id": "mp-ls-3",
+		"vlan_ids":       []interface{}{"12"},
+	})
+	srv.Seed("/infra/domains/default/groups/web-group", map[string]interface{}{
+		"display_name":   "web",
+		"resource_type":  "Group",
+		"realization_id": "mp-nsgroup-1",
+	})
+}
+
+func TestGenerateMPMigrationFromState(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	seedMPMigrationPolicyObjects(srv)
+
+	result, err := generateMPMigration(context.Background(), Provider().ResourcesMap, m, []byte(testMPMigrationState))
+	if err != nil {
+		t.Fatalf("failed to generate migration: %v", err)
+	}
+
+	expectedMappings := map[string]MPMigrationMapping{
+		"nsxt_logical_tier1_router.t1":      {ID: "mp-router-1", PolicyPath: "/infra/tier-1s/mp-router-1", Target: "nsxt_policy_tier1_gateway.t1"},
+		"nsxt_logical_switch.ls[0]":         {ID: "mp-ls-1", PolicyPath: "/infra/segments/web-ls", Target: "nsxt_policy_segment.ls_0"},
+		"module.security.nsxt_ns_group.web": {ID: "mp-nsgroup-1", PolicyPath: "/infra/domains/default/groups/web-group", Target: "nsxt_policy_group.web"},
+	}
+	if len(result.Mappings) != len(expectedMappings) {
+		t.Errorf("expected %d mappings, got %v", len(expectedMappings), result.Mappings)
+	}
+	for _, mapping := range result.Mappings {
+		expected, ok := expectedMappings[mapping.Source]
+		if !ok {
+			t.Errorf("unexpected mapping %v", mapping)
+			continue
+		}
+		expected.Source = mapping.Source
+		if mapping != expected {
+			t.Errorf("expected mapping %v, got %v", expected, mapping)
+		}
+	}
+
+	expectedWarnings := []string{
+		"skipping nsxt_logical_switch.ls[1]: object was not promoted to Policy",
+		"skipping nsxt_logical_router_downlink_port.port: migration is not supported for nsxt_logical_router_downlink_port",
+		"keeping nsxt_logical_switch.ls: 1 of 2 instances were not mapped",
+	}
+	warnings := strings.Join(result.Warnings, "\n")
+	for _, warning := range expectedWarnings {
+		if !strings.Contains(warnings, warning) {
+			t.Errorf("expected warning %q, got:\n%s", warning, warnings)
+		}
+	}
+	if len(result.Warnings) != len(expectedWarnings) {
+		t.Errorf("unexpected warnings:\n%s", warnings)
+	}
+
+	expectedFiles := map[string][]string{
+		"nsxt_policy_segment.tf": {
+			`resource "nsxt_policy_segment" "ls_0" {`,
+			`connectivity_path = nsxt_policy_tier1_gateway.t1.path`,
+		},
+		"imports.tf": {
+			"import {\n  to = nsxt_policy_segment.ls_0\n  id = \"/infra/segments/web-ls\"\n}",
+			"import {\n  to = nsxt_policy_group.web\n  id = \"/infra/domains/default/groups/web-group\"\n}",
+		},
+		"removed.tf": {
+			"removed {\n  from = nsxt_logical_tier1_router.t1\n\n  lifecycle {\n    destroy = false\n  }\n}",
+			"from = module.security.nsxt_ns_group.web\n",
+		},
+	}
+	for file, snippets := range expectedFiles {
+		content, ok := result.Files[file]
+		if !ok {
+			t.Errorf("file %s was not generated", file)
+			continue
+		}
+		for _, snippet := range snippets {
+			if !strings.Contains(content, snippet) {
+				t.Errorf("file %s does not contain %q:\n%s", file, snippet, content)
+			}
+		}
+	}
+	// resource with instance that was not mapped is kept in configuration
+	if strings.Contains(result.Files["removed.tf"], "downlink_port") || strings.Contains(result.Files["removed.tf"], "tier0") ||
+		strings.Contains(result.Files["removed.tf"], "nsxt_logical_switch") {
+		t.Errorf("unexpected removed block:\n%s", result.Files["removed.tf"])
+	}
+
+	if _, err := generateMPMigration(context.Background(), Provider().ResourcesMap, m, []byte(`{"version": 3}`)); err == nil {
+		t.Errorf("expected unsupported state version to fail")
+	}
+}
+
+func TestGenerateMPMigrationFromNSX(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	seedMPMigrationPolicyObjects(srv)
+
+	srv.SeedManager(map[string]interface{}{
+		"id":            "mp-ls-3",
+		"display_name":  "VLAN switch",
+		"resource_type": "LogicalSwitch",
+		"vlan":          12,
+		"_create_user":  "admin",
+	})
+	srv.SeedManager(map[string]interface{}{
+		"id":            "mp-router-1",
+		"display_name":  "t1",
+		"resource_type": "LogicalRouter",
+		"router_type":   "TIER1",
+		"_create_user":  "admin",
+	})
+	// realized from Policy segment, not a Manager object
+	srv.SeedManager(map[string]interface{}{
+		"id":            "policy-ls",
+		"display_name":  "policy",
+		"resource_type": "LogicalSwitch",
+		"_create_user":  "nsx_policy",
+	})
+
+	result, err := generateMPMigration(context.Background(), Provider().ResourcesMap, m, nil)
+	if err != nil {
+		t.Fatalf("failed to generate migration: %v", err)
+	}
+	if len(result.Warnings) > 0 {
+		t.Errorf("unexpected warnings: %v", result.Warnings)
+	}
+	expected := []MPMigrationMapping{
+		{Source: "LogicalSwitch mp-ls-3", ID: "mp-ls-3", PolicyPath: "/infra/segments/vlan-ls", Target: "nsxt_policy_vlan_segment.vlan_switch"},
+		{Source: "LogicalRouter mp-router-1", ID: "mp-router-1", PolicyPath: "/infra/tier-1s/mp-router-1", Target: "nsxt_policy_tier1_gateway.t1"},
+	}
+	if len(result.Mappings) != len(expected) {
+		t.Fatalf("expected mappings %v, got %v", expected, result.Mappings)
+	}
+	for i := range expected {
+		if result.Mappings[i] != expected[i] {
+			t.Errorf("expected mapping %v, got %v", expected[i], result.Mappings[i])
+		}
+	}
+	if _, ok := result.Files["removed.tf"]; ok {
+		t.Errorf("removed blocks should not be generated for objects not in state")
+	}
+	if !strings.Contains(result.Files["nsxt_policy_vlan_segment.tf"], `vlan_ids         = ["12"]`) {
+		t.Errorf("unexpected vlan segment configuration:\n%s", result.Files["nsxt_policy_vlan_segment.tf"])
+	}
+}
@@ -0,0 +1,643 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// policyConfigGeneratorType maps NSX resource type to terraform resource type.
+// When same NSX type is backed by several terraform resources, match decides
+// by object path. Objects are imported by path or ID, unless importID derives
+// different import ID from object path.
+type policyConfigGeneratorType struct {
+	nsxType  string
+	tfType   string
+	match    func(path string) bool
+	importID func(path string) string
+}
+
+func isVPCPath(path string) bool {
+	return strings.Contains(path, "/vpcs/")
+}
+
+func isNotVPCPath(path string) bool {
+	return !isVPCPath(path)
+}
+
+func isFixedSegmentPath(path string) bool {
+	return strings.Contains(path, "/tier-1s/")
+}
+
+func isNotFixedSegmentPath(path string) bool {
+	return !isFixedSegmentPath(path)
+}
+
+// getPolicyConfigGeneratorParentPath is used for gateway singletons, such as
+// DNS forwarder, that are imported by gateway path
+func getPolicyConfigGeneratorParentPath(path string) string {
+	return path[:strings.LastIndex(path, "/")]
+}
+
+// getPolicyConfigGeneratorGatewayPath is used for locale service singletons,
+// such as multicast config, that are imported by gateway path
+func getPolicyConfigGeneratorGatewayPath(path string) string {
+	if i := strings.Index(path, "/locale-services/"); i > 0 {
+		return path[:i]
+	}
+	return getPolicyConfigGeneratorParentPath(path)
+}
+
+// Objects not listed here are configured within parent resource (such as
+// security policy rules), see also policyConfigGeneratorUnsupportedTypes
+var policyConfigGeneratorTypes = []policyConfigGeneratorType{
+	{nsxType: "ActiveDirectoryIdentitySource", tfType: "nsxt_policy_ldap_identity_source"},
+	{nsxType: "BgpNeighborConfig", tfType: "nsxt_policy_bgp_neighbor"},
+	{nsxType: "CaBundle", tfType: "nsxt_policy_ca_bundle"},
+	{nsxType: "CommunityList", tfType: "nsxt_policy_gateway_community_list"},
+	{nsxType: "DhcpRelayConfig", tfType: "nsxt_policy_dhcp_relay"},
+	{nsxType: "DhcpServerConfig", tfType: "nsxt_policy_dhcp_server"},
+	{nsxType: "DhcpV4StaticBindingConfig", tfType: "nsxt_policy_dhcp_v4_static_binding"},
+	{nsxType: "DhcpV6StaticBindingConfig", tfType: "nsxt_policy_dhcp_v6_static_binding"},
+	{nsxType: "DistributedFloodProtectionProfile", tfType: "nsxt_policy_distributed_flood_protection_profile"},
+	{nsxType: "Domain", tfType: "nsxt_policy_domain"},
+	{nsxType: "EvpnConfig", tfType: "nsxt_policy_evpn_config", importID: getPolicyConfigGeneratorParentPath},
+	{nsxType: "EvpnTenantConfig", tfType: "nsxt_policy_evpn_tenant"},
+	{nsxType: "FloodProtectionProfileBindingMap", tfType: "nsxt_policy_gateway_flood_protection_profile_binding"},
+	{nsxType: "GatewayFloodProtectionProfile", tfType: "nsxt_policy_gateway_flood_protection_profile"},
+	{nsxType: "GatewayPolicy", tfType: "nsxt_policy_gateway_policy", match: isNotVPCPath},
+	{nsxType: "GatewayPolicy", tfType: "nsxt_vpc_gateway_policy", match: isVPCPath},
+	{nsxType: "GatewayQosProfile", tfType: "nsxt_policy_gateway_qos_profile"},
+	{nsxType: "GlobalManager", tfType: "nsxt_policy_global_manager"},
+	{nsxType: "GreTunnel", tfType: "nsxt_policy_tier0_gateway_gre_tunnel"},
+	{nsxType: "Group", tfType: "nsxt_policy_group", match: isNotVPCPath},
+	{nsxType: "Group", tfType: "nsxt_vpc_group", match: isVPCPath},
+	{nsxType: "HostTransportNode", tfType: "nsxt_policy_host_transport_node"},
+	{nsxType: "HostTransportNodeCollection", tfType: "nsxt_policy_host_transport_node_collection"},
+	{nsxType: "IPDiscoveryProfile", tfType: "nsxt_policy_ip_discovery_profile"},
+	{nsxType: "IPFIXDFWCollectorProfile", tfType: "nsxt_policy_ipfix_collector_profile"},
+	{nsxType: "IPFIXDFWProfile", tfType: "nsxt_policy_ipfix_dfw_profile"},
+	{nsxType: "IPSecVpnDpdProfile", tfType: "nsxt_policy_ipsec_vpn_dpd_profile"},
+	{nsxType: "IPSecVpnIkeProfile", tfType: "nsxt_policy_ipsec_vpn_ike_profile"},
+	{nsxType: "IPSecVpnLocalEndpoint", tfType: "nsxt_policy_ipsec_vpn_local_endpoint"},
+	{nsxType: "IPSecVpnService", tfType: "nsxt_policy_ipsec_vpn_service"},
+	{nsxType: "IPSecVpnTunnelProfile", tfType: "nsxt_policy_ipsec_vpn_tunnel_profile"},
+	{nsxType: "IdsProfile", tfType: "nsxt_policy_intrusion_service_profile"},
+	{nsxType: "IdsSecurityPolicy", tfType: "nsxt_policy_intrusion_service_policy"},
+	{nsxType: "IpAddressAllocation", tfType: "nsxt_policy_ip_address_allocation"},
+	{nsxType: "IpAddressBlock", tfType: "nsxt_policy_ip_block"},
+	{nsxType: "IpAddressPool", tfType: "nsxt_policy_ip_pool"},
+	{nsxType: "IpAddressPoolBlockSubnet", tfType: "nsxt_policy_ip_pool_block_subnet"},
+	{nsxType: "IpAddressPoolStaticSubnet", tfType: "nsxt_policy_ip_pool_static_subnet"},
+	{nsxType: "L2VPNService", tfType: "nsxt_policy_l2_vpn_service"},
+	{nsxType: "L2VPNSession", tfType: "nsxt_policy_l2_vpn_session"},
+	{nsxType: "LBClientSslProfile", tfType: "nsxt_policy_lb_client_ssl_profile"},
+	{nsxType: "LBCookiePersistenceProfile", tfType: "nsxt_policy_lb_cookie_persistence_profile"},
+	{nsxType: "LBFastTcpProfile", tfType: "nsxt_policy_lb_fast_tcp_application_profile"},
+	{nsxType: "LBFastUdpProfile", tfType: "nsxt_policy_lb_fast_udp_application_profile"},
+	{nsxType: "LBGenericPersistenceProfile", tfType: "nsxt_policy_lb_generic_persistence_profile"},
+	{nsxType: "LBHttpMonitorProfile", tfType: "nsxt_policy_lb_http_monitor_profile"},
+	{nsxType: "LBHttpProfile", tfType: "nsxt_policy_lb_http_application_profile"},
+	{nsxType: "LBHttpsMonitorProfile", tfType: "nsxt_policy_lb_https_monitor_profile"},
+	{nsxType: "LBIcmpMonitorProfile", tfType: "nsxt_policy_lb_icmp_monitor_profile"},
+	{nsxType: "LBPassiveMonitorProfile", tfType: "nsxt_policy_lb_passive_monitor_profile"},
+	{nsxType: "LBPool", tfType: "nsxt_policy_lb_pool"},
+	{nsxType: "LBServerSslProfile", tfType: "nsxt_policy_lb_server_ssl_profile"},
+	{nsxType: "LBService", tfType: "nsxt_policy_lb_service"},
+	{nsxType: "LBSourceIpPersistenceProfile", tfType: "nsxt_policy_lb_source_ip_persistence_profile"},
+	{nsxType: "LBTcpMonitorProfile", tfType: "nsxt_policy_lb_tcp_monitor_profile"},
+	{nsxType: "LBUdpMonitorProfile", tfType: "nsxt_policy_lb_udp_monitor_profile"},
+	{nsxType: "LBVirtualServer", tfType: "nsxt_policy_lb_virtual_server"},
+	{nsxType: "MacDiscoveryProfile", tfType: "nsxt_policy_mac_discovery_profile"},
+	{nsxType: "MetadataProxyConfig", tfType: "nsxt_policy_metadata_proxy"},
+	{nsxType: "OpenLdapIdentitySource", tfType: "nsxt_policy_ldap_identity_source"},
+	{nsxType: "OspfAreaConfig", tfType: "nsxt_policy_ospf_area"},
+	{nsxType: "PolicyBasedIPSecVpnSession", tfType: "nsxt_policy_ipsec_vpn_session"},
+	{nsxType: "PolicyContextProfile", tfType: "nsxt_policy_context_profile"},
+	{nsxType: "PolicyDnsForwarder", tfType: "nsxt_policy_gateway_dns_forwarder", importID: getPolicyConfigGeneratorParentPath},
+	{nsxType: "PolicyDnsForwarderZone", tfType: "nsxt_policy_dns_forwarder_zone"},
+	{nsxType: "PolicyFirewallFloodProtectionProfileBindingMap", tfType: "nsxt_policy_distributed_flood_protection_profile_binding"},
+	{nsxType: "PolicyFirewallSessionTimerProfile", tfType: "nsxt_policy_session_timer_profile"},
+	{nsxType: "PolicyFirewallSessionTimerProfileBindingMap", tfType: "nsxt_policy_session_timer_profile_binding"},
+	{nsxType: "PolicyHostTransportNodeProfile", tfType: "nsxt_policy_host_transport_node_profile"},
+	{nsxType: "PolicyIgmpProfile", tfType: "nsxt_policy_igmp_profile"},
+	{nsxType: "PolicyInterVrfRoutingConfig", tfType: "nsxt_policy_tier0_inter_vrf_routing"},
+	{nsxType: "PolicyMulticastConfig", tfType: "nsxt_policy_gateway_multicast_config", importID: getPolicyConfigGeneratorGatewayPath},
+	{nsxType: "PolicyNatRule", tfType: "nsxt_policy_nat_rule"},
+	{nsxType: "PolicyPimProfile", tfType: "nsxt_policy_pim_profile"},
+	{nsxType: "PolicyTransportZone", tfType: "nsxt_policy_transport_zone"},
+	{nsxType: "PolicyUplinkHostSwitchProfile", tfType: "nsxt_policy_uplink_host_switch_profile"},
+	{nsxType: "PolicyVpcNatRule", tfType: "nsxt_vpc_nat_rule"},
+	{nsxType: "PolicyVtepHAHostSwitchProfile", tfType: "nsxt_policy_vtep_ha_host_switch_profile"},
+	{nsxType: "PrefixList", tfType: "nsxt_policy_gateway_prefix_list"},
+	{nsxType: "Project", tfType: "nsxt_policy_project"},
+	{nsxType: "QoSProfile", tfType: "nsxt_policy_qos_profile"},
+	{nsxType: "RoleBinding", tfType: "nsxt_policy_user_management_role_binding"},
+	{nsxType: "RoleWithFeatures", tfType: "nsxt_policy_user_management_role"},
+	{nsxType: "RouteBasedIPSecVpnSession", tfType: "nsxt_policy_ipsec_vpn_session"},
+	{nsxType: "SecurityPolicy", tfType: "nsxt_policy_security_policy", match: isNotVPCPath},
+	{nsxType: "SecurityPolicy", tfType: "nsxt_vpc_security_policy", match: isVPCPath},
+	{nsxType: "Segment", tfType: "nsxt_policy_segment", match: isNotFixedSegmentPath},
+	{nsxType: "Segment", tfType: "nsxt_policy_fixed_segment", match: isFixedSegmentPath},
+	{nsxType: "SegmentPort", tfType: "nsxt_policy_segment_port"},
+	{nsxType: "SegmentSecurityProfile", tfType: "nsxt_policy_segment_security_profile"},
+	{nsxType: "Service", tfType: "nsxt_policy_service"},
+	{nsxType: "Share", tfType: "nsxt_policy_share"},
+	{nsxType: "SharedResource", tfType: "nsxt_policy_shared_resource"},
+	{nsxType: "Site", tfType: "nsxt_policy_site"},
+	{nsxType: "SpoofGuardProfile", tfType: "nsxt_policy_spoof_guard_profile"},
+	{nsxType: "StaticRouteBfdPeer", tfType: "nsxt_policy_static_route_bfd_peer"},
+	{nsxType: "StaticRoutes", tfType: "nsxt_policy_static_route", match: isNotVPCPath},
+	{nsxType: "StaticRoutes", tfType: "nsxt_vpc_static_route", match: isVPCPath},
+	{nsxType: "SubCluster", tfType: "nsxt_policy_compute_sub_cluster"},
+	{nsxType: "Tier0", tfType: "nsxt_policy_tier0_gateway"},
+	{nsxType: "Tier0Interface", tfType: "nsxt_policy_tier0_gateway_interface"},
+	{nsxType: "Tier0RouteMap", tfType: "nsxt_policy_gateway_route_map"},
+	{nsxType: "Tier1", tfType: "nsxt_policy_tier1_gateway"},
+	{nsxType: "Tier1Interface", tfType: "nsxt_policy_tier1_gateway_interface"},
+	{nsxType: "TlsCertificate", tfType: "nsxt_policy_certificate"},
+	{nsxType: "TlsCrl", tfType: "nsxt_policy_crl"},
+	{nsxType: "TlsInspectionExternalProfile", tfType: "nsxt_policy_tls_decryption_profile"},
+	{nsxType: "TlsInspectionInternalProfile", tfType: "nsxt_policy_tls_decryption_profile"},
+	{nsxType: "TlsPolicy", tfType: "nsxt_policy_tls_inspection_policy"},
+	{nsxType: "VniPoolConfig", tfType: "nsxt_policy_vni_pool"},
+	{nsxType: "Vpc", tfType: "nsxt_vpc"},
+	{nsxType: "VpcIpAddressAllocation", tfType: "nsxt_vpc_ip_address_allocation"},
+	{nsxType: "VpcSubnet", tfType: "nsxt_vpc_subnet"},
+}
+
+// policyConfigGeneratorUnsupportedTypes lists NSX types that are backed by
+// terraform resource, but can not be generated with import block. Objects of
+// those types are reported as warnings.
+var policyConfigGeneratorUnsupportedTypes = map[string]struct {
+	tfType string
+	reason string
+}{
+	"BgpRoutingConfig":         {tfType: "nsxt_policy_bgp_config", reason: "does not support import"},
+	"EvpnTunnelEndpointConfig": {tfType: "nsxt_policy_evpn_tunnel_endpoint", reason: "import ID can not be derived from policy path"},
+	"OspfRoutingConfig":        {tfType: "nsxt_policy_ospf_config", reason: "does not support import"},
+	"PolicyCustomAttributes":   {tfType: "nsxt_policy_context_profile_custom_attribute", reason: "is imported per attribute value"},
+}
+
+// policyConfigGeneratorEmbeddedResources lists policy resources that are not
+// generated on their own, with the resource or owner that covers their objects
+var policyConfigGeneratorEmbeddedResources = map[string]string{
+	"nsxt_policy_firewall_exclude_list_member":  "system owned exclude list",
+	"nsxt_policy_gateway_redistribution_config": "nsxt_policy_tier0_gateway",
+	"nsxt_policy_parent_security_policy":        "nsxt_policy_security_policy",
+	"nsxt_policy_predefined_gateway_policy":     "system owned gateway policy",
+	"nsxt_policy_predefined_security_policy":    "system owned security policy",
+	"nsxt_policy_security_policy_rule":          "nsxt_policy_security_policy",
+	"nsxt_policy_tier0_gateway_ha_vip_config":   "nsxt_policy_tier0_gateway",
+	"nsxt_policy_vlan_segment":                  "nsxt_policy_segment",
+	"nsxt_policy_vm_tags":                       "virtual machine",
+}
+
+func getPolicyConfigGeneratorType(nsxType string, path string) *policyConfigGeneratorType {
+	for i, t := range policyConfigGeneratorTypes {
+		if t.nsxType == nsxType && (t.match == nil || t.match(path)) {
+			return &policyConfigGeneratorTypes[i]
+		}
+	}
+	return nil
+}
+
+// getPolicyConfigGeneratorImportIDs returns candidate import IDs for object:
+// derived ID if importID is specified, or path and ID otherwise
+func getPolicyConfigGeneratorImportIDs(path string, importID func(path string) string) []string {
+	if importID != nil {
+		return []string{importID(path)}
+	}
+	return []string{path, getPolicyIDFromPath(path)}
+}
+
+func getPolicyConfigGeneratorQuery() string {
+	var nsxTypes []string
+	seen := make(map[string]bool)
+	for _, t := range policyConfigGeneratorTypes {
+		if !seen[t.nsxType] {
+			seen[t.nsxType] = true
+			nsxTypes = append(nsxTypes, t.nsxType)
+		}
+	}
+	// unsupported types are searched in order to report them
+	var unsupportedTypes []string
+	for nsxType := range policyConfigGeneratorUnsupportedTypes {
+		unsupportedTypes = append(unsupportedTypes, nsxType)
+	}
+	sort.Strings(unsupportedTypes)
+	nsxTypes = append(nsxTypes, unsupportedTypes...)
+	return fmt.Sprintf("resource_type:(%s)", strings.Join(nsxTypes, " OR "))
+}
+
+type policyConfigGeneratorObject struct {
+	tfType   string
+	name     string
+	path     string
+	importID string
+	data     *schema.ResourceData
+}
+
+func (o *policyConfigGeneratorObject) address() string {
+	return o.tfType + "." + o.name
+}
+
+// GeneratedPolicyConfiguration holds terraform configuration for existing NSX
+// objects, keyed by file name, and objects that could not be generated
+type GeneratedPolicyConfiguration struct {
+	Files    map[string]string
+	Warnings []string
+}
+
+// GeneratePolicyConfiguration walks policy objects under /infra, or under
+// project if projectID is specified, and generates terraform configuration
+// with import blocks for them
+func GeneratePolicyConfiguration(ctx context.Context, provider *schema.Provider, projectID string) (*GeneratedPolicyConfiguration, error) {
+	meta := provider.Meta()
+	if meta == nil {
+		return nil, fmt.Errorf("provider is not configured")
+	}
+	sessionContext := utl.SessionContext{ClientType: utl.Local}
+	if projectID != "" {
+		sessionContext = utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: projectID}
+	} else if isPolicyGlobalManager(meta) {
+		sessionContext = utl.SessionContext{ClientType: utl.Global}
+	}
+	return generatePolicyConfiguration(ctx, provider.ResourcesMap, meta, sessionContext)
+}
+
+func generatePolicyConfiguration(ctx context.Context, resources map[string]*schema.Resource, m interface{}, sessionContext utl.SessionContext) (*GeneratedPolicyConfiguration, error) {
+	connector := getPolicyConnector(m)
+	resultValues, err := listPolicyConfigGeneratorObjects(connector, sessionContext, getPolicyConfigGeneratorQuery())
+	if err != nil {
+		return nil, fmt.Errorf("Error searching policy objects: %v", err)
+	}
+
+	result := &GeneratedPolicyConfiguration{Files: make(map[string]string)}
+	converter := bindings.NewTypeConverter()
+	usedNames := make(map[string]bool)
+	var objects []*policyConfigGeneratorObject
+	for _, resultValue := range resultValues {
+		dataValue, errs := converter.ConvertToGolang(resultValue, model.PolicyResourceBindingType())
+		if len(errs) > 0 {
+			return nil, errs[0]
+		}
+		obj := dataValue.(model.PolicyResource)
+		if obj.Path == nil || obj.ResourceType == nil {
+			continue
+		}
+		if (obj.SystemOwned != nil && *obj.SystemOwned) || (obj.CreateUser != nil && *obj.CreateUser == "system") {
+			// default objects created by NSX
+			continue
+		}
+		if unsupported, ok := policyConfigGeneratorUnsupportedTypes[*obj.ResourceType]; ok {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: %s %s", *obj.Path, unsupported.tfType, unsupported.reason))
+			continue
+		}
+		t := getPolicyConfigGeneratorType(*obj.ResourceType, *obj.Path)
+		if t == nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: no resource for NSX type %s", *obj.Path, *obj.ResourceType))
+			continue
+		}
+		tfType := t.tfType
+		r, ok := resources[tfType]
+		if !ok {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s: resource %s is not available", *obj.Path, tfType))
+			continue
+		}
+
+		d, importID, err := readPolicyConfigGeneratorObject(ctx, r, m, *obj.Path, getPolicyConfigGeneratorImportIDs(*obj.Path, t.importID))
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("skipping %s %s: %v", tfType, *obj.Path, err))
+			continue
+		}
+
+		displayName := getPolicyIDFromPath(*obj.Path)
+		if obj.DisplayName != nil {
+			displayName = *obj.DisplayName
+		}
+		objects = append(objects, &policyConfigGeneratorObject{
+			tfType:   tfType,
+			name:     getPolicyConfigGeneratorUniqueName(usedNames, tfType, displayName),
+			path:     *obj.Path,
+			importID: importID,
+			data:     d,
+		})
+	}
+
+	result.Warnings = append(result.Warnings, writePolicyConfigGeneratorFiles(result.Files, objects, resources)...)
+	return result, nil
+}
+
+// listPolicyConfigGeneratorObjects searches objects for configuration
+// generation. Objects shared with the project are owned by provider, hence
+// only objects under the project itself are generated in project context.
+func listPolicyConfigGeneratorObjects(connector client.Connector, sessionContext utl.SessionContext, query string) ([]*data.StructValue, error) {
+	if sessionContext.ClientType == utl.Multitenancy {
+		query = query + fmt.Sprintf(" AND marked_for_delete:false AND path:\\/orgs\\/%s\\/projects\\/%s\\/*", utl.DefaultOrgID, sessionContext.ProjectID)
+		return searchLM(connector, query)
+	}
+	return listPolicyResourcesByQuery(connector, sessionContext, query)
+}
+
+// getPolicyConfigGeneratorUniqueName derives resource name from display name,
+// adding numeric suffix if name is already used for this resource type
+func getPolicyConfigGeneratorUniqueName(usedNames map[string]bool, tfType string, displayName string) string {
+	name := getPolicyConfigGeneratorName(displayName)
+	for i := 2; usedNames[tfType+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", getPolicyConfigGeneratorName(displayName), i)
+	}
+	usedNames[tfType+"."+name] = true
+	return name
+}
+
+// policyConfigGeneratorSecrets collects sensitive attributes, which are not
+// returned by NSX and need to be configured by the user
+type policyConfigGeneratorSecrets struct {
+	address   string
+	variables []string
+	warnings  []string
+}
+
+// writePolicyConfigGeneratorFiles writes one file per resource type, and
+// imports.tf with import block per object. Paths of generated objects are
+// replaced with references. Sensitive attributes are written as variables
+// when required, or as comments otherwise, and are reported as warnings.
+func writePolicyConfigGeneratorFiles(files map[string]string, objects []*policyConfigGeneratorObject, resources map[string]*schema.Resource) []string {
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].path < objects[j].path
+	})
+	references := make(map[string]string)
+	for _, obj := range objects {
+		references[obj.path] = obj.address() + ".path"
+	}
+
+	resourceBlocks := make(map[string]*strings.Builder)
+	var imports strings.Builder
+	secrets := &policyConfigGeneratorSecrets{}
+	for _, obj := range objects {
+		b, ok := resourceBlocks[obj.tfType]
+		if !ok {
+			b = &strings.Builder{}
+			resourceBlocks[obj.tfType] = b
+		} else {
+			b.WriteString("\n")
+		}
+		// object should not reference itself
+		delete(references, obj.path)
+		fmt.Fprintf(b, "resource %q %q {\n", obj.tfType, obj.name)
+		secrets.address = obj.address()
+		writePolicyConfigGeneratorBlock(b, resources[obj.tfType].Schema, obj.data.Get, references, secrets, "", "  ")
+		b.WriteString("}\n")
+		references[obj.path] = obj.address() + ".path"
+
+		if imports.Len() > 0 {
+			imports.WriteString("\n")
+		}
+		fmt.Fprintf(&imports, "import {\n  to = %s\n  id = %s\n}\n", obj.address(), quotePolicyConfigGeneratorString(obj.importID))
+	}
+
+	for tfType, b := range resourceBlocks {
+		files[tfType+".tf"] = b.String()
+	}
+	if imports.Len() > 0 {
+		files["imports.tf"] = imports.String()
+	}
+	if len(secrets.variables) > 0 {
+		var variables strings.Builder
+		for i, name := range secrets.variables {
+			if i > 0 {
+				variables.WriteString("\n")
+			}
+			fmt.Fprintf(&variables, "variable %q {\n  type      = string\n  sensitive = true\n}\n", name)
+		}
+		files["variables.tf"] = variables.String()
+	}
+	return secrets.warnings
+}
+
+// getPolicyConfigGeneratorVariableName derives variable name from resource
+// address and attribute path within the resource
+func getPolicyConfigGeneratorVariableName(address string, attrPath string) string {
+	name := strings.TrimPrefix(address, "nsxt_") + "_" + attrPath
+	return policyConfigGeneratorNameRegex.ReplaceAllString(strings.ToLower(name), "_")
+}
+
+// readPolicyConfigGeneratorObject imports object with first of importIDs that
+// succeeds and reads it, verifying that object with given path was read
+func readPolicyConfigGeneratorObject(ctx context.Context, r *schema.Resource, m interface{}, path string, importIDs []string) (*schema.ResourceData, string, error) {
+	var lastErr error
+	for _, importID := range importIDs {
+		d := r.Data(nil)
+		d.SetId(importID)
+		if r.Importer != nil {
+			var rd []*schema.ResourceData
+			var err error
+			if r.Importer.StateContext != nil {
+				rd, err = r.Importer.StateContext(ctx, d, m)
+			} else if r.Importer.State != nil {
+				rd, err = r.Importer.State(d, m)
+			}
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if len(rd) > 0 {
+				d = rd[0]
+			}
+		}
+		read := r.ReadContext
+		if read == nil {
+			read = r.ReadWithoutTimeout
+		}
+		if read == nil {
+			return nil, "", fmt.Errorf("resource does not support read")
+		}
+		if diags := read(ctx, d, m); diags.HasError() {
+			lastErr = fmt.Errorf("%s", diags[0].Summary)
+			continue
+		}
+		if d.Id() == "" {
+			lastErr = fmt.Errorf("object was not found")
+			continue
+		}
+		if pathValue, ok := d.Get("path").(string); ok && pathValue != "" && pathValue != path {
+			lastErr = fmt.Errorf("object was found with different path %s", pathValue)
+			continue
+		}
+		log.Printf("[DEBUG] Read %s for configuration generation", path)
+		return d, importID, nil
+	}
+	return nil, "", lastErr
+}
+
+var policyConfigGeneratorNameRegex = regexp.MustCompile("[^a-z0-9_]+")
+
+func getPolicyConfigGeneratorName(displayName string) string {
+	name := strings.Trim(policyConfigGeneratorNameRegex.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "obj_" + name
+	}
+	return name
+}
+
+func quotePolicyConfigGeneratorString(value string) string {
+	// escape template sequences in addition to go escaping
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func isPolicyConfigGeneratorAttrSkipped(key string, s *schema.Schema) bool {
+	if key == "id" || s.Deprecated != "" {
+		return true
+	}
+	// computed-only attributes can not be configured
+	return s.Computed && !s.Optional && !s.Required
+}
+
+func isPolicyConfigGeneratorValueDefault(s *schema.Schema, value interface{}) bool {
+	if s.Required {
+		return false
+	}
+	if set, ok := value.(*schema.Set); ok {
+		return set.Len() == 0
+	}
+	if s.Default != nil {
+		return reflect.DeepEqual(s.Default, value)
+	}
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+func writePolicyConfigGeneratorBlock(b *strings.Builder, schemaMap map[string]*schema.Schema, get func(string) interface{}, references map[string]string, secrets *policyConfigGeneratorSecrets, attrPath string, indent string) {
+	var keys []string
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// attributes are written before nested blocks, aligned same way as
+	// terraform fmt does
+	var attrKeys []string
+	var attrValues []string
+	var blockKeys []string
+	var secretComments []string
+	width := 0
+	for _, key := range keys {
+		s := schemaMap[key]
+		if isPolicyConfigGeneratorAttrSkipped(key, s) {
+			continue
+		}
+		if s.Sensitive {
+			// sensitive values are not returned by NSX
+			if s.Required {
+				variable := getPolicyConfigGeneratorVariableName(secrets.address, attrPath+key)
+				secrets.variables = append(secrets.variables, variable)
+				secrets.warnings = append(secrets.warnings, fmt.Sprintf("%s: sensitive attribute %s%s is not returned by NSX, set variable %s", secrets.address, attrPath, key, variable))
+				attrKeys = append(attrKeys, key)
+				attrValues = append(attrValues, "var."+variable)
+				if len(key) > width {
+					width = len(key)
+				}
+			} else {
+				secrets.warnings = append(secrets.warnings, fmt.Sprintf("%s: sensitive attribute %s%s is not returned by NSX, configure it if used", secrets.address, attrPath, key))
+				secretComments = append(secretComments, key)
+			}
+			continue
+		}
+		value := get(key)
+		if isPolicyConfigGeneratorValueDefault(s, value) {
+			continue
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			blockKeys = append(blockKeys, key)
+			continue
+		}
+		attrKeys = append(attrKeys, key)
+		attrValues = append(attrValues, getPolicyConfigGeneratorValue(value, references))
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+	for i, key := range attrKeys {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, key, attrValues[i])
+	}
+	for _, key := range secretComments {
+		fmt.Fprintf(b, "%s# TODO: %s is sensitive and is not returned by NSX\n", indent, key)
+	}
+
+	for _, key := range blockKeys {
+		elem := schemaMap[key].Elem.(*schema.Resource)
+		var items []interface{}
+		switch value := get(key).(type) {
+		case *schema.Set:
+			items = value.List()
+		case []interface{}:
+			items = value
+		}
+		for i, item := range items {
+			itemMap, ok := item.(map[string]interface{})
+			if !ok {
+				itemMap = make(map[string]interface{})
+			}
+			fmt.Fprintf(b, "\n%s%s {\n", indent, key)
+			itemPath := fmt.Sprintf("%s%s.%d.", attrPath, key, i)
+			writePolicyConfigGeneratorBlock(b, elem.Schema, func(k string) interface{} { return itemMap[k] }, references, secrets, itemPath, indent+"  ")
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+}
+
+func getPolicyConfigGeneratorValue(value interface{}, references map[string]string) string {
+	switch v := value.(type) {
+	case string:
+		if reference, ok := references[v]; ok {
+			return reference
+		}
+		return quotePolicyConfigGeneratorString(v)
+	case *schema.Set:
+		return getPolicyConfigGeneratorValue(v.List(), references)
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, getPolicyConfigGeneratorValue(item, references))
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var values []string
+		for _, key := range keys {
+			values = append(values, fmt.Sprintf("%s = %s", quotePolicyConfigGeneratorString(key), getPolicyConfigGeneratorValue(v[key], references)))
+		}
+		return "{ " + strings.Join(values, ", ") + " }"
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,242 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestGeneratePolicyConfiguration(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	srv.Seed("/infra/tier-1s/t1", map[string]interface{}{
+		"display_name":              "Web Gateway",
+		"resource_type":             "Tier1",
+		"failover_mode":             "NON_PREEMPTIVE",
+		"disable_firewall":          false,
+		"default_rule_logging":      false,
+		"enable_standby_relocation": false,
+		"force_whitelisting":        false,
+		"pool_allocation":           "ROUTING",
+	})
+	srv.Seed("/infra/segments/web", map[string]interface{}{
+		"display_name":      "web",
+		"resource_type":     "Segment",
+		"connectivity_path": "/infra/tier-1s/t1",
+		"subnets":           []interface{}{map[string]interface{}{"gateway_address": "12.12.2.1/24"}},
+	})
+	srv.Seed("/infra/domains/default", map[string]interface{}{
+		"resource_type": "Domain",
+		"_create_user":  "system",
+	})
+	srv.Seed("/infra/domains/default/groups/web", map[string]interface{}{
+		"display_name":  "web",
+		"description":   "web \"servers\"",
+		"resource_type": "Group",
+		"tags":          []interface{}{map[string]interface{}{"scope": "env", "tag": "prod"}},
+	})
+	srv.Seed("/infra/domains/default/groups/web2", map[string]interface{}{
+		"display_name":  "web",
+		"resource_type": "Group",
+	})
+	srv.Seed("/infra/ip-discovery-profiles/default", map[string]interface{}{
+		"display_name":  "default",
+		"resource_type": "IPDiscoveryProfile",
+		"_system_owned": true,
+	})
+	srv.Seed("/orgs/default/projects/proj1/infra/domains/default/groups/project-group", map[string]interface{}{
+		"display_name":  "project group",
+		"resource_type": "Group",
+	})
+
+	result, err := generatePolicyConfiguration(context.Background(), Provider().ResourcesMap, m, utl.SessionContext{ClientType: utl.Local})
+	if err != nil {
+		t.Fatalf("failed to generate configuration: %v", err)
+	}
+	for _, warning := range result.Warnings {
+		t.Errorf("unexpected warning: %s", warning)
+	}
+
+	expected := map[string][]string{
+		"nsxt_policy_tier1_gateway.tf": {
+			`resource "nsxt_policy_tier1_gateway" "web_gateway" {`,
+			`display_name  = "Web Gateway"`,
+		},
+		"nsxt_policy_segment.tf": {
+			`resource "nsxt_policy_segment" "web" {`,
+			`connectivity_path = nsxt_policy_tier1_gateway.web_gateway.path`,
+			"  subnet {\n    cidr = \"12.12.2.1/24\"\n  }",
+		},
+		"nsxt_policy_group.tf": {
+			`resource "nsxt_policy_group" "web" {`,
+			`resource "nsxt_policy_group" "web_2" {`,
+			`description  = "web \"servers\""`,
+			"  tag {\n    scope = \"env\"\n    tag   = \"prod\"\n  }",
+		},
+		"imports.tf": {
+			"import {\n  to = nsxt_policy_group.web\n  id = \"/infra/domains/default/groups/web\"\n}",
+			"import {\n  to = nsxt_policy_tier1_gateway.web_gateway\n  id = \"/infra/tier-1s/t1\"\n}",
+		},
+	}
+	for file, snippets := range expected {
+		content, ok := result.Files[file]
+		if !ok {
+			t.Errorf("file %s was not generated", file)
+			continue
+		}
+		for _, snippet := range snippets {
+			if !strings.Contains(content, snippet) {
+				t.Errorf("file %s does not contain %q:\n%s", file, snippet, content)
+			}
+		}
+	}
+	for file, content := range result.Files {
+		if strings.Contains(content, "project") || strings.Contains(content, "ip_discovery") {
+			t.Errorf("unexpected object in %s:\n%s", file, content)
+		}
+	}
+
+	// objects shared with project are owned by provider, and are not generated
+	srv.Seed("/infra/shares/share1", map[string]interface{}{
+		"resource_type": "Share",
+		"sharedWith":    []interface{}{"/orgs/default/projects/proj1"},
+	})
+	srv.Seed("/infra/shares/share1/resources/segments", map[string]interface{}{
+		"resource_type":    "SharedResource",
+		"resource_objects": []interface{}{map[string]interface{}{"resource_path": "/infra/segments/web"}},
+	})
+
+	// project objects are generated with context
+	result, err = generatePolicyConfiguration(context.Background(), Provider().ResourcesMap, m, utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: "proj1"})
+	if err != nil {
+		t.Fatalf("failed to generate project configuration: %v", err)
+	}
+	content := result.Files["nsxt_policy_group.tf"]
+	if !strings.Contains(content, "  context {\n    project_id = \"proj1\"\n  }") || len(result.Files) != 2 {
+		t.Errorf("unexpected project configuration: %v", result.Files)
+	}
+}
+
+func TestPolicyConfigGeneratorTypesCoverage(t *testing.T) {
+	covered := make(map[string]bool)
+	for _, generatorType := range policyConfigGeneratorTypes {
+		covered[generatorType.tfType] = true
+	}
+	for _, unsupported := range policyConfigGeneratorUnsupportedTypes {
+		covered[unsupported.tfType] = true
+	}
+	for tfType := range policyConfigGeneratorEmbeddedResources {
+		covered[tfType] = true
+	}
+
+	resources := Provider().ResourcesMap
+	for tfType := range covered {
+		if _, ok := resources[tfType]; !ok {
+			t.Errorf("unknown resource %s in configuration generator", tfType)
+		}
+	}
+	for tfType := range resources {
+		if (strings.HasPrefix(tfType, "nsxt_policy_") || strings.HasPrefix(tfType, "nsxt_vpc_")) && !covered[tfType] {
+			t.Errorf("resource %s is not covered by configuration generator", tfType)
+		}
+	}
+}
+
+func TestGeneratePolicyConfigurationWarnings(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	srv.Seed("/infra/domains/default/groups/web", map[string]interface{}{
+		"display_name":  "web",
+		"resource_type": "Group",
+	})
+	srv.Seed("/infra/tier-0s/t0/locale-services/default/bgp", map[string]interface{}{
+		"resource_type": "BgpRoutingConfig",
+		"enabled":       true,
+	})
+
+	result, err := generatePolicyConfiguration(context.Background(), Provider().ResourcesMap, m, utl.SessionContext{ClientType: utl.Local})
+	if err != nil {
+		t.Fatalf("failed to generate configuration: %v", err)
+	}
+	expected := "skipping /infra/tier-0s/t0/locale-services/default/bgp: nsxt_policy_bgp_config does not support import"
+	if len(result.Warnings) != 1 || result.Warnings[0] != expected {
+		t.Errorf("expected warning %q, got %v", expected, result.Warnings)
+	}
+	if _, ok := result.Files["nsxt_policy_group.tf"]; !ok {
+		t.Errorf("group was not generated: %v", result.Files)
+	}
+}
+
+func TestGetPolicyConfigGeneratorImportIDs(t *testing.T) {
+	cases := []struct {
+		nsxType  string
+		path     string
+		expected []string
+	}{
+		{"Group", "/infra/domains/default/groups/g1", []string{"/infra/domains/default/groups/g1", "g1"}},
+		{"PolicyDnsForwarder", "/infra/tier-1s/t1/dns-forwarder", []string{"/infra/tier-1s/t1"}},
+		{"EvpnConfig", "/infra/tier-0s/t0/evpn", []string{"/infra/tier-0s/t0"}},
+		{"PolicyMulticastConfig", "/infra/tier-0s/t0/locale-services/default/multicast", []string{"/infra/tier-0s/t0"}},
+	}
+	for _, c := range cases {
+		generatorType := getPolicyConfigGeneratorType(c.nsxType, c.path)
+		if generatorType == nil {
+			t.Errorf("no generator type for %s", c.nsxType)
+			continue
+		}
+		importIDs := getPolicyConfigGeneratorImportIDs(c.path, generatorType.importID)
+		if strings.Join(importIDs, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("unexpected import IDs for %s: %v", c.path, importIDs)
+		}
+	}
+}
+
+func TestGeneratePolicyConfigurationSecrets(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	srv.Seed("/infra/metadata-proxies/mp1", map[string]interface{}{
+		"display_name":      "mp1",
+		"resource_type":     "MetadataProxyConfig",
+		"edge_cluster_path": "/infra/sites/default/enforcement-points/default/edge-clusters/ec1",
+		"server_address":    "http://10.0.0.1:3000",
+	})
+
+	result, err := generatePolicyConfiguration(context.Background(), Provider().ResourcesMap, m, utl.SessionContext{ClientType: utl.Local})
+	if err != nil {
+		t.Fatalf("failed to generate configuration: %v", err)
+	}
+
+	// required secret is configured with variable, optional one is commented
+	content := result.Files["nsxt_policy_metadata_proxy.tf"]
+	for _, snippet := range []string{
+		"secret            = var.policy_metadata_proxy_mp1_secret",
+		"# TODO: server_certificates is sensitive and is not returned by NSX",
+	} {
+		if !strings.Contains(content, snippet) {
+			t.Errorf("metadata proxy configuration does not contain %q:\n%s", snippet, content)
+		}
+	}
+	expectedVariable := "variable \"policy_metadata_proxy_mp1_secret\" {\n  type      = string\n  sensitive = true\n}\n"
+	if result.Files["variables.tf"] != expectedVariable {
+		t.Errorf("unexpected variables:\n%s", result.Files["variables.tf"])
+	}
+	expectedWarnings := []string{
+		"nsxt_policy_metadata_proxy.mp1: sensitive attribute secret is not returned by NSX, set variable policy_metadata_proxy_mp1_secret",
+		"nsxt_policy_metadata_proxy.mp1: sensitive attribute server_certificates is not returned by NSX, configure it if used",
+	}
+	if strings.Join(result.Warnings, "\n") != strings.Join(expectedWarnings, "\n") {
+		t.Errorf("unexpected warnings %v", result.Warnings)
+	}
+}
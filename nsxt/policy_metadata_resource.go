@@ -0,0 +1,256 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+// policyResourceClient is implemented by api/infra client wrappers of policy
+// objects that are identified by ID under infra or project infra
+type policyResourceClient[T any] interface {
+	Get(id string) (T, error)
+	Patch(id string, obj T, override *bool) error
+	Delete(id string, override *bool) error
+}
+
+// policyResourceUpdateClient is implemented by clients that support replacing
+// the object with PUT, which is rejected by NSX if revision is outdated
+type policyResourceUpdateClient[T any] interface {
+	Update(id string, obj T, override *bool) (T, error)
+}
+
+// policyOverrideFalse is passed as override flag by resources that explicitly
+// disable override of objects created on global manager
+var policyOverrideFalse = false
+
+type policyResourceClientFactory[T any] func(sessionContext utl.SessionContext, connector client.Connector) policyResourceClient[T]
+
+// newPolicyResourceClientFactory adapts api/infra client constructor, such as
+// infra.NewSpoofguardProfilesClient, for use in metadata driven resource. The
+// constructor returns nil when object is not supported in session context.
+func newPolicyResourceClientFactory[T any, C policyResourceClient[T]](newClient func(utl.SessionContext, client.Connector) *C) policyResourceClientFactory[T] {
+	return func(sessionContext utl.SessionContext, connector client.Connector) policyResourceClient[T] {
+		c := newClient(sessionContext, connector)
+		if c == nil {
+			return nil
+		}
+		return *c
+	}
+}
+
+// policyMetadataResource generates CRUD for policy resource from its extended
+// schema. Standard attributes (nsx_id, path, display_name, description,
+// revision, tag, context) are handled by the generator, the rest is converted
+// with metadata package. Attributes marked with Skip in metadata can be
+// handled in optional hooks.
+type policyMetadataResource[T any] struct {
+	// Object name for log and error messages
+	name      string
+	schema    map[string]*metadata.ExtendedSchema
+	newClient policyResourceClientFactory[T]
+	// Override flag passed to NSX on create, update and delete. If not set,
+	// NSX default applies
+	override *bool
+	// Update with PUT carrying object revision instead of PATCH, so that
+	// concurrent changes are detected. Client has to implement
+	// policyResourceUpdateClient
+	updateWithRevision bool
+	// Optional hook to fill model attributes that are skipped in metadata
+	schemaToModel func(d *schema.ResourceData, obj *T) error
+	// Optional hook to set schema attributes that are skipped in metadata
+	modelToSchema func(d *schema.ResourceData, obj *T) error
+}
+
+func (r *policyMetadataResource[T]) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(r.create),
+		ReadContext:   withContext(r.read),
+		UpdateContext: withContext(r.update),
+		DeleteContext: withContext(r.delete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: metadata.GetSchemaFromExtendedSchema(r.schema),
+	}
+}
+
+func (r *policyMetadataResource[T]) getClient(d *schema.ResourceData, m interface{}) (policyResourceClient[T], error) {
+	client := r.newClient(getSessionContext(d, m), getPolicyConnector(m))
+	if client == nil {
+		return nil, policyResourceNotSupportedError()
+	}
+	return client, nil
+}
+
+func (r *policyMetadataResource[T]) exists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := r.newClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func (r *policyMetadataResource[T]) getModel(d *schema.ResourceData) (T, error) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	var obj T
+	elem := reflect.ValueOf(&obj).Elem()
+	elem.FieldByName("DisplayName").Set(reflect.ValueOf(&displayName))
+	elem.FieldByName("Description").Set(reflect.ValueOf(&description))
+	elem.FieldByName("Tags").Set(reflect.ValueOf(tags))
+
+	if err := metadata.SchemaToStruct(elem, d, r.schema, "", nil); err != nil {
+		return obj, err
+	}
+	if r.schemaToModel != nil {
+		if err := r.schemaToModel(d, &obj); err != nil {
+			return obj, err
+		}
+	}
+	return obj, nil
+}
+
+func (r *policyMetadataResource[T]) patch(d *schema.ResourceData, m interface{}, id string) error {
+	obj, err := r.getModel(d)
+	if err != nil {
+		return err
+	}
+
+	client, err := r.getClient(d, m)
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Patching %s with ID %s", r.name, id)
+	return client.Patch(id, obj, r.override)
+}
+
+func (r *policyMetadataResource[T]) put(d *schema.ResourceData, m interface{}, id string) error {
+	obj, err := r.getModel(d)
+	if err != nil {
+		return err
+	}
+	revision := int64(d.Get("revision").(int))
+	reflect.ValueOf(&obj).Elem().FieldByName("Revision").Set(reflect.ValueOf(&revision))
+
+	client, err := r.getClient(d, m)
+	if err != nil {
+		return err
+	}
+	updateClient, ok := client.(policyResourceUpdateClient[T])
+	if !ok {
+		return fmt.Errorf("%s does not support update", r.name)
+	}
+	log.Printf("[INFO] Updating %s with ID %s", r.name, id)
+	_, err = updateClient.Update(id, obj, r.override)
+	return err
+}
+
+func (r *policyMetadataResource[T]) create(d *schema.ResourceData, m interface{}) error {
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, r.exists)
+	if err != nil {
+		return err
+	}
+
+	err = r.patch(d, m, id)
+	if err != nil {
+		return handleCreateError(r.name, id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return r.read(d, m)
+}
+
+func (r *policyMetadataResource[T]) read(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	client, err := r.getClient(d, m)
+	if err != nil {
+		return err
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, r.name, id, err)
+	}
+
+	elem := reflect.ValueOf(&obj).Elem()
+	d.Set("display_name", elem.FieldByName("DisplayName").Interface())
+	d.Set("description", elem.FieldByName("Description").Interface())
+	setPolicyTagsInSchema(d, elem.FieldByName("Tags").Interface().([]model.Tag))
+	d.Set("nsx_id", id)
+	d.Set("path", elem.FieldByName("Path").Interface())
+	d.Set("revision", elem.FieldByName("Revision").Interface())
+
+	if err := metadata.StructToSchema(elem, d, r.schema, "", nil); err != nil {
+		return err
+	}
+	if r.modelToSchema != nil {
+		return r.modelToSchema(d, &obj)
+	}
+	return nil
+}
+
+func (r *policyMetadataResource[T]) update(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	var err error
+	if r.updateWithRevision {
+		err = r.put(d, m, id)
+	} else {
+		err = r.patch(d, m, id)
+	}
+	if err != nil {
+		return handleUpdateError(r.name, id, err)
+	}
+
+	return r.read(d, m)
+}
+
+func (r *policyMetadataResource[T]) delete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining %s ID", r.name)
+	}
+
+	client, err := r.getClient(d, m)
+	if err != nil {
+		return err
+	}
+	err = client.Delete(id, r.override)
+	if err != nil {
+		return handleDeleteError(r.name, id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,493 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
+)
+
+// testMetadataResource describes resource for tests generated from metadata
+// TestData. Only attributes with test data are covered.
+type testMetadataResource struct {
+	resourceType string
+	schema       map[string]*metadata.ExtendedSchema
+	exists       func(utl.SessionContext, string, client.Connector) (bool, error)
+	minVersion   string
+	// reason to skip generic acceptance test, when resource requires
+	// dedicated configuration
+	skipAcceptance string
+	// converts test configuration to model and back
+	roundTrip func(d *schema.ResourceData, target *schema.ResourceData) error
+}
+
+func newTestMetadataResource[T any](resourceType string, r *policyMetadataResource[T], minVersion string) testMetadataResource {
+	return testMetadataResource{
+		resourceType: resourceType,
+		schema:       r.schema,
+		exists:       r.exists,
+		minVersion:   minVersion,
+		roundTrip: func(d *schema.ResourceData, target *schema.ResourceData) error {
+			var obj T
+			elem := reflect.ValueOf(&obj).Elem()
+			if err := metadata.SchemaToStruct(elem, d, r.schema, "", nil); err != nil {
+				return err
+			}
+			return metadata.StructToSchema(elem, target, r.schema, "", nil)
+		},
+	}
+}
+
+func (r testMetadataResource) withSkipAcceptance(reason string) testMetadataResource {
+	r.skipAcceptance = reason
+	return r
+}
+
+// isTestMetadataContextRequired returns true for resources that can only be
+// created in project or VPC
+func isTestMetadataContextRequired(r testMetadataResource) bool {
+	item, ok := r.schema["context"]
+	return ok && item.Schema.Required
+}
+
+// isTestMetadataVpcScope returns true for resources that can only be created
+// in VPC
+func isTestMetadataVpcScope(r testMetadataResource) bool {
+	if !isTestMetadataContextRequired(r) {
+		return false
+	}
+	_, ok := r.schema["context"].Schema.Elem.(*schema.Resource).Schema["vpc_id"]
+	return ok
+}
+
+// Resources described by metadata get acceptance and round trip tests below
+var testMetadataResources = []testMetadataResource{
+	newTestMetadataResource("nsxt_policy_gateway_qos_profile", &gatewayQosProfileResource, ""),
+	newTestMetadataResource("nsxt_policy_ip_discovery_profile", &ipDiscoveryProfileResource, ""),
+	newTestMetadataResource("nsxt_policy_mac_discovery_profile", &macDiscoveryProfileResource, "3.0.0"),
+	newTestMetadataResource("nsxt_policy_segment_security_profile", &segmentSecurityProfileResource, ""),
+	newTestMetadataResource("nsxt_policy_spoof_guard_profile", &spoofGuardProfileResource, ""),
+	newTestMetadataResource("nsxt_vpc", &vpcResource, "4.1.2").withSkipAcceptance("VPC resource is created in VPC project, see TestAccResourceNsxtVPC_basic"),
+	newTestMetadataResource("nsxt_vpc_subnet", &vpcSubnetResource, "4.1.2"),
+	newTestMetadataResource("nsxt_vpc_static_route", &vpcStaticRouteResource, "4.1.2"),
+	newTestMetadataResource("nsxt_vpc_nat_rule", &vpcNatRuleResource, "4.1.2").withSkipAcceptance("NAT rule requires IP address allocation, see TestAccResourceNsxtVPCNatRule_basic"),
+	newTestMetadataResource("nsxt_vpc_ip_address_allocation", &vpcIPAddressAllocationResource, "4.1.2"),
+}
+
+// isTestMetadataCovered returns false for attributes that can not be
+// configured in test
+func isTestMetadataCovered(item *metadata.ExtendedSchema) bool {
+	if item.Metadata.Skip || item.Metadata.ReadOnly || item.Metadata.PolymorphicType != "" {
+		return false
+	}
+	if item.Schema.Computed && !item.Schema.Optional {
+		return false
+	}
+	if item.Metadata.IntroducedInVersion != "" && util.NsxVersionLower(item.Metadata.IntroducedInVersion) {
+		return false
+	}
+	if childElem, ok := item.Schema.Elem.(*metadata.ExtendedResource); ok {
+		// nested block is only rendered when some of its attributes have test data
+		return len(getSortedTestMetadataKeys(childElem.Schema)) > 0
+	}
+	return item.Metadata.TestData.CreateValue != nil && item.Metadata.TestData.UpdateValue != nil
+}
+
+func getTestMetadataValue(item *metadata.ExtendedSchema, create bool) interface{} {
+	if create {
+		return item.Metadata.TestData.CreateValue
+	}
+	return item.Metadata.TestData.UpdateValue
+}
+
+func getSortedTestMetadataKeys(extSchema map[string]*metadata.ExtendedSchema) []string {
+	var keys []string
+	for key, item := range extSchema {
+		if isTestMetadataCovered(item) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getTestMetadataStringSlice(value interface{}) []string {
+	if slice, ok := value.([]string); ok {
+		return slice
+	}
+	return []string{value.(string)}
+}
+
+func getTestMetadataElemType(item *metadata.ExtendedSchema) schema.ValueType {
+	if elem, ok := item.Schema.Elem.(*metadata.ExtendedSchema); ok {
+		return elem.Schema.Type
+	}
+	return schema.TypeString
+}
+
+func getTestMetadataHCLScalar(valueType schema.ValueType, value string) string {
+	if valueType == schema.TypeString {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+// getTestMetadataHCL renders HCL attributes and blocks for test data
+func getTestMetadataHCL(extSchema map[string]*metadata.ExtendedSchema, create bool, indent string) string {
+	var buf strings.Builder
+	for _, key := range getSortedTestMetadataKeys(extSchema) {
+		item := extSchema[key]
+		if childElem, ok := item.Schema.Elem.(*metadata.ExtendedResource); ok {
+			// single entry of struct, or list or set of structs
+			fmt.Fprintf(&buf, "%s%s {\n%s%s}\n", indent, key, getTestMetadataHCL(childElem.Schema, create, indent+"  "), indent)
+			continue
+		}
+
+		value := getTestMetadataValue(item, create)
+		switch item.Schema.Type {
+		case schema.TypeList, schema.TypeSet:
+			var elems []string
+			for _, v := range getTestMetadataStringSlice(value) {
+				elems = append(elems, getTestMetadataHCLScalar(getTestMetadataElemType(item), v))
+			}
+			fmt.Fprintf(&buf, "%s%s = [%s]\n", indent, key, strings.Join(elems, ", "))
+		case schema.TypeMap:
+			var elems []string
+			for k, v := range value.(map[string]string) {
+				elems = append(elems, fmt.Sprintf("%s = %s", strconv.Quote(k), getTestMetadataHCLScalar(getTestMetadataElemType(item), v)))
+			}
+			sort.Strings(elems)
+			fmt.Fprintf(&buf, "%s%s = {%s}\n", indent, key, strings.Join(elems, ", "))
+		default:
+			fmt.Fprintf(&buf, "%s%s = %s\n", indent, key, getTestMetadataHCLScalar(item.Schema.Type, value.(string)))
+		}
+	}
+	return buf.String()
+}
+
+// getTestMetadataStateAttrs returns flatmap state attributes expected for
+// test data, relative to given prefix. Elements of sets are returned in
+// setAttrs, keyed by set attribute.
+func getTestMetadataStateAttrs(extSchema map[string]*metadata.ExtendedSchema, create bool, prefix string, attrs map[string]string, setAttrs map[string]map[string]string) {
+	for _, key := range getSortedTestMetadataKeys(extSchema) {
+		item := extSchema[key]
+		if childElem, ok := item.Schema.Elem.(*metadata.ExtendedResource); ok {
+			attrs[prefix+key+".#"] = "1"
+			if item.Schema.Type == schema.TypeSet {
+				elemAttrs := make(map[string]string)
+				getTestMetadataStateAttrs(childElem.Schema, create, "", elemAttrs, nil)
+				setAttrs[prefix+key+".*"] = elemAttrs
+			} else {
+				getTestMetadataStateAttrs(childElem.Schema, create, prefix+key+".0.", attrs, setAttrs)
+			}
+			continue
+		}
+
+		value := getTestMetadataValue(item, create)
+		switch item.Schema.Type {
+		case schema.TypeList:
+			values := getTestMetadataStringSlice(value)
+			attrs[prefix+key+".#"] = strconv.Itoa(len(values))
+			for i, v := range values {
+				attrs[fmt.Sprintf("%s%s.%d", prefix, key, i)] = v
+			}
+		case schema.TypeSet:
+			values := getTestMetadataStringSlice(value)
+			attrs[prefix+key+".#"] = strconv.Itoa(len(values))
+		case schema.TypeMap:
+			values := value.(map[string]string)
+			attrs[prefix+key+".%"] = strconv.Itoa(len(values))
+			for k, v := range values {
+				attrs[prefix+key+"."+k] = v
+			}
+		default:
+			attrs[prefix+key] = value.(string)
+		}
+	}
+}
+
+func getTestMetadataCheckFuncs(resourceName string, extSchema map[string]*metadata.ExtendedSchema, create bool) []resource.TestCheckFunc {
+	attrs := make(map[string]string)
+	setAttrs := make(map[string]map[string]string)
+	getTestMetadataStateAttrs(extSchema, create, "", attrs, setAttrs)
+
+	var result []resource.TestCheckFunc
+	for key, value := range attrs {
+		result = append(result, resource.TestCheckResourceAttr(resourceName, key, value))
+	}
+	for key, elemAttrs := range setAttrs {
+		result = append(result, resource.TestCheckTypeSetElemNestedAttrs(resourceName, key, elemAttrs))
+	}
+	for _, key := range getSortedTestMetadataKeys(extSchema) {
+		item := extSchema[key]
+		if _, ok := item.Schema.Elem.(*metadata.ExtendedSchema); ok && item.Schema.Type == schema.TypeSet {
+			for _, v := range getTestMetadataStringSlice(getTestMetadataValue(item, create)) {
+				result = append(result, resource.TestCheckTypeSetElemAttr(resourceName, key+".*", v))
+			}
+		}
+	}
+	return result
+}
+
+// getTestMetadataRawConfig returns test data in the form accepted by
+// schema.TestResourceDataRaw
+func getTestMetadataRawConfig(extSchema map[string]*metadata.ExtendedSchema, create bool) (map[string]interface{}, error) {
+	raw := make(map[string]interface{})
+	for _, key := range getSortedTestMetadataKeys(extSchema) {
+		item := extSchema[key]
+		if childElem, ok := item.Schema.Elem.(*metadata.ExtendedResource); ok {
+			childRaw, err := getTestMetadataRawConfig(childElem.Schema, create)
+			if err != nil {
+				return nil, err
+			}
+			raw[key] = []interface{}{childRaw}
+			continue
+		}
+
+		value := getTestMetadataValue(item, create)
+		var err error
+		switch item.Schema.Type {
+		case schema.TypeList, schema.TypeSet:
+			var values []interface{}
+			for _, v := range getTestMetadataStringSlice(value) {
+				rawValue, convErr := getTestMetadataRawScalar(getTestMetadataElemType(item), v)
+				if convErr != nil {
+					err = convErr
+				}
+				values = append(values, rawValue)
+			}
+			raw[key] = values
+		case schema.TypeMap:
+			values := make(map[string]interface{})
+			for k, v := range value.(map[string]string) {
+				values[k], err = getTestMetadataRawScalar(getTestMetadataElemType(item), v)
+			}
+			raw[key] = values
+		default:
+			raw[key], err = getTestMetadataRawScalar(item.Schema.Type, value.(string))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid test data for %s: %v", key, err)
+		}
+	}
+	return raw, nil
+}
+
+func getTestMetadataRawScalar(valueType schema.ValueType, value string) (interface{}, error) {
+	switch valueType {
+	case schema.TypeBool:
+		return strconv.ParseBool(value)
+	case schema.TypeInt:
+		return strconv.Atoi(value)
+	case schema.TypeFloat:
+		return strconv.ParseFloat(value, 64)
+	}
+	return value, nil
+}
+
+func testAccNsxtPolicyMetadataResourceTemplate(r testMetadataResource, displayName string, create bool, withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "%s" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+%s
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, r.resourceType, context, displayName, getTestMetadataHCL(r.schema, create, "  "))
+}
+
+func testAccNsxtPolicyMetadataResourceExists(r testMetadataResource, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy resource %s ID not set in resources", resourceName)
+		}
+
+		exists, err := r.exists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy resource %s %s does not exist", r.resourceType, resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyMetadataResourceCheckDestroy(r testMetadataResource, state *terraform.State) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+		if rs.Type != r.resourceType {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := r.exists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy resource %s %s still exists", r.resourceType, resourceID)
+		}
+	}
+	return nil
+}
+
+// testAccNsxtPolicyMetadataResourceBasic runs create, update, import and
+// destroy cycle for resource, with configuration generated from metadata
+func testAccNsxtPolicyMetadataResourceBasic(t *testing.T, r testMetadataResource, withContext bool) {
+	if r.skipAcceptance != "" {
+		t.Skip(r.skipAcceptance)
+	}
+	testResourceName := r.resourceType + ".test"
+	createName := getAccTestResourceName()
+	updateName := getAccTestResourceName()
+
+	getChecks := func(displayName string, create bool) resource.TestCheckFunc {
+		checks := []resource.TestCheckFunc{
+			testAccNsxtPolicyMetadataResourceExists(r, testResourceName),
+			resource.TestCheckResourceAttr(testResourceName, "display_name", displayName),
+			resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+			resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+			resource.TestCheckResourceAttrSet(testResourceName, "path"),
+			resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+			resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+		}
+		return resource.ComposeTestCheckFunc(append(checks, getTestMetadataCheckFuncs(testResourceName, r.schema, create)...)...)
+	}
+
+	importStep := resource.TestStep{
+		ResourceName:      testResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+	if withContext {
+		importStep.ImportStateIdFunc = testAccResourceNsxtPolicyImportIDRetriever(testResourceName)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if r.minVersion != "" {
+				testAccNSXVersion(t, r.minVersion)
+			}
+			if isTestMetadataVpcScope(r) {
+				testAccOnlyVPC(t)
+			} else if withContext {
+				testAccOnlyMultitenancy(t)
+			}
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataResourceCheckDestroy(r, state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyMetadataResourceTemplate(r, createName, true, withContext),
+				Check:  getChecks(createName, true),
+			},
+			{
+				Config: testAccNsxtPolicyMetadataResourceTemplate(r, updateName, false, withContext),
+				Check:  getChecks(updateName, false),
+			},
+			importStep,
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyMetadataResources_basic(t *testing.T) {
+	for _, r := range testMetadataResources {
+		if isTestMetadataContextRequired(r) {
+			continue
+		}
+		r := r
+		t.Run(r.resourceType, func(t *testing.T) {
+			testAccNsxtPolicyMetadataResourceBasic(t, r, false)
+		})
+	}
+}
+
+func TestAccResourceNsxtPolicyMetadataResources_multitenancy(t *testing.T) {
+	for _, r := range testMetadataResources {
+		if _, ok := r.schema["context"]; !ok {
+			continue
+		}
+		r := r
+		t.Run(r.resourceType, func(t *testing.T) {
+			testAccNsxtPolicyMetadataResourceBasic(t, r, true)
+		})
+	}
+}
+
+// TestPolicyMetadataResourcesRoundTrip verifies that every attribute with test
+// data is converted to NSX model and back without loss
+func TestPolicyMetadataResourcesRoundTrip(t *testing.T) {
+	for _, r := range testMetadataResources {
+		for _, create := range []bool{true, false} {
+			r := r
+			create := create
+			t.Run(fmt.Sprintf("%s/create=%v", r.resourceType, create), func(t *testing.T) {
+				resourceSchema := metadata.GetSchemaFromExtendedSchema(r.schema)
+				raw, err := getTestMetadataRawConfig(r.schema, create)
+				if err != nil {
+					t.Fatal(err)
+				}
+				d := schema.TestResourceDataRaw(t, resourceSchema, raw)
+				target := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+				target.SetId("test")
+				if err := r.roundTrip(d, target); err != nil {
+					t.Fatal(err)
+				}
+
+				expected := make(map[string]string)
+				setAttrs := make(map[string]map[string]string)
+				getTestMetadataStateAttrs(r.schema, create, "", expected, setAttrs)
+				state := target.State().Attributes
+				for key, value := range expected {
+					if state[key] != value {
+						t.Errorf("attribute %s: expected %q, got %q", key, value, state[key])
+					}
+				}
+				for key := range raw {
+					if !reflect.DeepEqual(d.Get(key), target.Get(key)) {
+						if set, ok := d.Get(key).(*schema.Set); ok && set.Equal(target.Get(key)) {
+							continue
+						}
+						t.Errorf("attribute %s does not round trip: %v != %v", key, d.Get(key), target.Get(key))
+					}
+				}
+			})
+		}
+	}
+}
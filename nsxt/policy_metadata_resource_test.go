@@ -0,0 +1,150 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestPolicyMetadataResourceCrud(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	r := resourceNsxtPolicySegmentSecurityProfile()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"nsx_id":             "test",
+		"display_name":       "test",
+		"bpdu_filter_allow":  []interface{}{"01:80:c2:00:00:00"},
+		"bpdu_filter_enable": false,
+		"rate_limit":         []interface{}{map[string]interface{}{"rx_broadcast": 100}},
+		"tag":                []interface{}{map[string]interface{}{"scope": "scope1", "tag": "tag1"}},
+	})
+	if diags := r.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+	if d.Id() != "test" || d.Get("path").(string) != "/infra/segment-security-profiles/test" {
+		t.Errorf("unexpected id %s or path %s", d.Id(), d.Get("path"))
+	}
+
+	obj, ok := srv.Get("/infra/segment-security-profiles/test")
+	if !ok {
+		t.Fatal("object was not created on NSX")
+	}
+	if obj["display_name"] != "test" || obj["bpdu_filter_enable"] != false || len(obj["bpdu_filter_allow"].([]interface{})) != 1 || len(obj["tags"].([]interface{})) != 1 {
+		t.Errorf("unexpected object on NSX: %v", obj)
+	}
+	if fmt.Sprint(obj["rate_limits"].(map[string]interface{})["rx_broadcast"]) != "100" {
+		t.Errorf("nested struct was not sent to NSX: %v", obj["rate_limits"])
+	}
+
+	if diags := r.ReadContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+	if d.Get("bpdu_filter_enable").(bool) || d.Get("bpdu_filter_allow").(*schema.Set).Len() != 1 || d.Get("rate_limit.0.rx_broadcast").(int) != 100 {
+		t.Errorf("unexpected state after read: %v", d.State())
+	}
+
+	d.Set("display_name", "test2")
+	d.Set("bpdu_filter_enable", true)
+	if diags := r.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+	obj, _ = srv.Get("/infra/segment-security-profiles/test")
+	if obj["display_name"] != "test2" || obj["bpdu_filter_enable"] != true {
+		t.Errorf("object was not updated on NSX: %v", obj)
+	}
+
+	// same ID can not be used again
+	d2 := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"nsx_id": "test", "display_name": "test"})
+	if diags := r.CreateContext(context.Background(), d2, m); !diags.HasError() {
+		t.Errorf("expected create with existing ID to fail")
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("delete failed: %v", diags)
+	}
+	if _, ok := srv.Get("/infra/segment-security-profiles/test"); ok {
+		t.Errorf("object was not deleted on NSX")
+	}
+
+	// read of deleted object clears the state
+	if diags := r.ReadContext(context.Background(), d, m); diags.HasError() || d.Id() != "" {
+		t.Errorf("expected object to be removed from state, got %v", diags)
+	}
+}
+
+func TestPolicyMetadataResourceHooks(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	r := resourceNsxtPolicyIPDiscoveryProfile()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"display_name":      "test",
+		"arp_binding_limit": 20,
+		"tofu_enabled":      false,
+	})
+	if diags := r.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	obj, ok := srv.Get("/infra/ip-discovery-profiles/" + d.Id())
+	if !ok {
+		t.Fatal("object was not created on NSX")
+	}
+	arpConfig := obj["ip_v4_discovery_options"].(map[string]interface{})["arp_snooping_config"].(map[string]interface{})
+	if fmt.Sprint(arpConfig["arp_binding_limit"]) != "20" || obj["tofu_enabled"] != false {
+		t.Errorf("unexpected object on NSX: %v", obj)
+	}
+	if d.Get("arp_binding_limit").(int) != 20 || d.Get("tofu_enabled").(bool) || d.Get("nd_snooping_limit").(int) != 3 {
+		t.Errorf("unexpected state after create: %v", d.State())
+	}
+}
+
+func TestPolicyMetadataResourceUpdateWithRevision(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	r := resourceNsxtPolicyMacDiscoveryProfile()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"nsx_id":                   "test",
+		"display_name":             "test",
+		"mac_change_enabled":       true,
+		"remote_overlay_mac_limit": 2048,
+	})
+	if diags := r.CreateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("create failed: %v", diags)
+	}
+
+	d.Set("display_name", "test2")
+	if diags := r.UpdateContext(context.Background(), d, m); diags.HasError() {
+		t.Fatalf("update failed: %v", diags)
+	}
+	obj, _ := srv.Get("/infra/mac-discovery-profiles/test")
+	if obj["display_name"] != "test2" || fmt.Sprint(obj["_revision"]) != "1" {
+		t.Errorf("object was not updated on NSX: %v", obj)
+	}
+	if d.Get("revision").(int) != 1 {
+		t.Errorf("expected revision 1 after update, got %v", d.Get("revision"))
+	}
+
+	// update based on outdated revision is rejected
+	d.Set("revision", 0)
+	d.Set("display_name", "test3")
+	if diags := r.UpdateContext(context.Background(), d, m); !diags.HasError() {
+		t.Errorf("expected update with outdated revision to fail")
+	}
+	obj, _ = srv.Get("/infra/mac-discovery-profiles/test")
+	if obj["display_name"] != "test2" {
+		t.Errorf("object was updated with outdated revision: %v", obj)
+	}
+}
@@ -6,7 +6,9 @@ package nsxt
 import (
 	"errors"
 	"fmt"
+	"log"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -14,6 +16,9 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/search"
+	lm_infra "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	lm_shares "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/shares"
+	lm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
 	lm_search "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
 
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
@@ -54,6 +59,9 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 		}
 	}
 
+	// Objects of the project take precedence over objects shared with it
+	perfectMatch = preferPolicyProjectMatches(perfectMatch)
+	prefixMatch = preferPolicyProjectMatches(prefixMatch)
 	if len(perfectMatch) > 0 {
 		if len(perfectMatch) > 1 {
 			if objID != "" {
@@ -82,6 +90,21 @@ func policyDataSourceResourceFilterAndSet(d *schema.ResourceData, resultValues [
 	return obj.StructValue, nil
 }
 
+// preferPolicyProjectMatches drops shared infra objects from matches, if
+// matches contain objects under project as well
+func preferPolicyProjectMatches(matches []policySearchDataValue) []policySearchDataValue {
+	var projectMatches []policySearchDataValue
+	for _, match := range matches {
+		if match.Resource.Path != nil && strings.HasPrefix(*match.Resource.Path, "/orgs/") {
+			projectMatches = append(projectMatches, match)
+		}
+	}
+	if len(projectMatches) == 0 {
+		return matches
+	}
+	return projectMatches
+}
+
 func policyDataSourceResourceRead(d *schema.ResourceData, connector client.Connector, context utl.SessionContext, resourceType string, additionalQuery map[string]string) (*data.StructValue, error) {
 	return policyDataSourceResourceReadWithValidation(d, connector, context, resourceType, additionalQuery, true)
 }
@@ -122,6 +145,8 @@ func listPolicyResourcesByNameAndType(connector client.Connector, context utl.Se
 		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
 	case utl.Multitenancy:
 		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.VPC:
+		return searchVPCPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, context.VPCID, *buildPolicyResourcesQuery(&query, additionalQuery))
 	}
 
 	return nil, errors.New("invalid ClientType %d")
@@ -160,6 +185,8 @@ func listPolicyResourcesByID(connector client.Connector, context utl.SessionCont
 		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
 	case utl.Multitenancy:
 		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.VPC:
+		return searchVPCPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, context.VPCID, *buildPolicyResourcesQuery(&query, additionalQuery))
 	}
 
 	return nil, errors.New("invalid ClientType %d")
@@ -174,6 +201,8 @@ func listPolicyResourcesByNsxID(connector client.Connector, context utl.SessionC
 		return searchGMPolicyResources(connector, *buildPolicyResourcesQuery(&query, additionalQuery))
 	case utl.Multitenancy:
 		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, *buildPolicyResourcesQuery(&query, additionalQuery))
+	case utl.VPC:
+		return searchVPCPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, context.VPCID, *buildPolicyResourcesQuery(&query, additionalQuery))
 	}
 	return nil, errors.New("invalid ClientType %d")
 }
@@ -242,6 +271,179 @@ func searchLMPolicyResources(connector client.Connector, query string) ([]*data.
 }
 
 func searchMultitenancyPolicyResources(connector client.Connector, org string, project string, query string) ([]*data.StructValue, error) {
-	query = query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project)
+	projectQuery := query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s*", org, project)
+	results, err := searchLM(connector, projectQuery)
+	if err != nil {
+		return results, err
+	}
+
+	// Objects shared with the project by provider reside under infra
+	sharedObjects := getPolicyObjectsSharedWithProject(connector, org, project)
+	if len(sharedObjects) == 0 {
+		return results, nil
+	}
+	infraResults, err := searchLMPolicyResources(connector, query)
+	if err != nil {
+		return results, err
+	}
+	seen := make(map[string]bool)
+	for _, result := range results {
+		if path, err := result.String("path"); err == nil {
+			seen[path] = true
+		}
+	}
+	for _, result := range infraResults {
+		path, err := result.String("path")
+		if err == nil && !seen[path] && isPolicyPathShared(path, sharedObjects) {
+			seen[path] = true
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// policySharedObjectsCache keeps objects shared with each project, so that
+// infra shares are not listed on every project lookup. The cache is kept per
+// provider, and is reset when shares are modified by the provider.
+type policySharedObjectsCache struct {
+	mu      sync.Mutex
+	objects map[string][]lm_model.ResourceObject
+}
+
+func newPolicySharedObjectsCache() *policySharedObjectsCache {
+	return &policySharedObjectsCache{objects: make(map[string][]lm_model.ResourceObject)}
+}
+
+func (c *policySharedObjectsCache) reset() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects = make(map[string][]lm_model.ResourceObject)
+}
+
+// getPolicySharedObjectsCache returns cache of the provider connector belongs
+// to, or nil for connectors created outside of provider configuration
+func getPolicySharedObjectsCache(connector client.Connector) *policySharedObjectsCache {
+	switch c := connector.(type) {
+	case *operationConnector:
+		return getPolicySharedObjectsCache(c.Connector)
+	case *sharedPolicyConnector:
+		return c.sharedObjects
+	}
+	return nil
+}
+
+// getPolicyObjectsSharedWithProject returns objects shared with the project,
+// cached per provider. Failed share listing is not cached, since it might be
+// temporary.
+func getPolicyObjectsSharedWithProject(connector client.Connector, org string, project string) []lm_model.ResourceObject {
+	cache := getPolicySharedObjectsCache(connector)
+	if cache != nil {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+	}
+	projectPath := fmt.Sprintf("/orgs/%s/projects/%s", org, project)
+	if cache != nil {
+		if sharedObjects, ok := cache.objects[projectPath]; ok {
+			return sharedObjects
+		}
+	}
+	sharedObjects, err := listPolicyObjectsSharedWithProject(connector, projectPath)
+	if err != nil {
+		// Project users might not be allowed to list infra shares
+		log.Printf("[DEBUG] Failed to list shares, shared objects might not be looked up: %v", err)
+		return sharedObjects
+	}
+	if cache != nil {
+		cache.objects[projectPath] = sharedObjects
+	}
+	return sharedObjects
+}
+
+// listPolicyObjectsSharedWithProject returns resource objects of infra shares that
+// are shared with given project path, along with error if any of the shares could
+// not be listed
+func listPolicyObjectsSharedWithProject(connector client.Connector, projectPath string) ([]lm_model.ResourceObject, error) {
+	var sharedObjects []lm_model.ResourceObject
+	sharesClient := lm_infra.NewSharesClient(connector)
+	resourcesClient := lm_shares.NewResourcesClient(connector)
+	var cursor *string
+	for {
+		sharesList, err := sharesClient.List(cursor, nil, nil, nil, nil, nil)
+		if err != nil {
+			return sharedObjects, err
+		}
+		for _, share := range sharesList.Results {
+			if !isShareSharedWithPath(share, projectPath) {
+				continue
+			}
+			resources, err := resourcesClient.List(*share.Id, nil)
+			if err != nil {
+				return sharedObjects, fmt.Errorf("failed to list resources for share %s: %v", *share.Id, err)
+			}
+			for _, resource := range resources.Results {
+				for _, object := range resource.ResourceObjects {
+					includeChildren := object.IncludeChildren != nil && *object.IncludeChildren
+					sharedObjects = append(sharedObjects, lm_model.ResourceObject{
+						ResourcePath:    object.ResourcePath,
+						IncludeChildren: &includeChildren,
+					})
+				}
+			}
+		}
+		cursor = sharesList.Cursor
+		if cursor == nil || *cursor == "" || len(sharesList.Results) == 0 {
+			return sharedObjects, nil
+		}
+	}
+}
+
+func isShareSharedWithPath(share lm_model.Share, path string) bool {
+	allDescendants := share.SharingStrategy != nil && *share.SharingStrategy == lm_model.Share_SHARING_STRATEGY_ALL_DESCENDANTS
+	for _, sharedWith := range share.SharedWith {
+		if sharedWith == path {
+			return true
+		}
+		if allDescendants && strings.HasPrefix(path, sharedWith+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isPolicyPathShared(path string, sharedObjects []lm_model.ResourceObject) bool {
+	for _, object := range sharedObjects {
+		if object.ResourcePath == nil {
+			continue
+		}
+		if *object.ResourcePath == path {
+			return true
+		}
+		if *object.IncludeChildren && strings.HasPrefix(path, *object.ResourcePath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func searchVPCPolicyResources(connector client.Connector, org string, project string, vpc string, query string) ([]*data.StructValue, error) {
+	query = query + fmt.Sprintf(" AND path:\\/orgs\\/%s\\/projects\\/%s\\/vpcs\\/%s\\/*", org, project, vpc)
 	return searchLM(connector, query)
 }
+
+func listPolicyResourcesByQuery(connector client.Connector, context utl.SessionContext, query string) ([]*data.StructValue, error) {
+	query = query + " AND marked_for_delete:false"
+	switch context.ClientType {
+	case utl.Local:
+		return searchLMPolicyResources(connector, query)
+	case utl.Global:
+		return searchGMPolicyResources(connector, query)
+	case utl.Multitenancy:
+		return searchMultitenancyPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, query)
+	case utl.VPC:
+		return searchVPCPolicyResources(connector, utl.DefaultOrgID, context.ProjectID, context.VPCID, query)
+	}
+	return nil, errors.New("invalid ClientType %d")
+}
@@ -0,0 +1,89 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestPolicyDataSourceVPCSearch(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	groups := []string{
+		"/infra/domains/default/groups/infra-group",
+		"/orgs/default/projects/proj1/domains/default/groups/project-group",
+		"/orgs/default/projects/proj1/vpcs/vpc1/groups/vpc-group",
+		"/orgs/default/projects/proj1/vpcs/vpc10/groups/other-vpc-group",
+	}
+	for _, path := range groups {
+		srv.Seed(path, map[string]interface{}{
+			"display_name":  "test-group",
+			"resource_type": "Group",
+		})
+	}
+
+	tests := []struct {
+		name         string
+		context      []interface{}
+		id           string
+		expectedPath string
+	}{
+		{
+			name:         "local",
+			expectedPath: groups[0],
+		},
+		{
+			name:         "project",
+			context:      []interface{}{map[string]interface{}{"project_id": "proj1"}},
+			expectedPath: groups[1],
+		},
+		{
+			name:         "vpc",
+			context:      []interface{}{map[string]interface{}{"project_id": "proj1", "vpc_id": "vpc1"}},
+			expectedPath: groups[2],
+		},
+		{
+			name:         "vpc by id",
+			context:      []interface{}{map[string]interface{}{"project_id": "proj1", "vpc_id": "vpc1"}},
+			id:           "vpc-group",
+			expectedPath: groups[2],
+		},
+	}
+
+	ds := dataSourceNsxtPolicyGroup()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			raw := map[string]interface{}{"display_name": "test-group"}
+			if test.id != "" {
+				raw = map[string]interface{}{"id": test.id}
+			}
+			if test.context != nil {
+				raw["context"] = test.context
+			}
+			d := schema.TestResourceDataRaw(t, ds.Schema, raw)
+			if diags := ds.ReadContext(context.Background(), d, m); diags.HasError() {
+				t.Fatalf("read failed: %v", diags)
+			}
+			if d.Get("path").(string) != test.expectedPath {
+				t.Errorf("expected path %s, got %s", test.expectedPath, d.Get("path"))
+			}
+		})
+	}
+
+	// object from other VPC is not found
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"id":      "other-vpc-group",
+		"context": []interface{}{map[string]interface{}{"project_id": "proj1", "vpc_id": "vpc1"}},
+	})
+	if diags := ds.ReadContext(context.Background(), d, m); !diags.HasError() {
+		t.Errorf("expected group from other VPC not to be found, got %s", d.Get("path"))
+	}
+}
@@ -396,7 +396,8 @@ func nsxtPolicyPathResourceImporterHelper(d *schema.ResourceData, m interface{})
 			// assigned into the context as well
 			ctxMap := make(map[string]interface{})
 			ctxMap["project_id"] = pathSegs[4]
-			if pathSegs[5] == "vpcs" {
+			if len(pathSegs) > 7 && pathSegs[5] == "vpcs" {
+				// object within VPC, as opposed to VPC itself
 				ctxMap["vpc_id"] = pathSegs[6]
 			}
 			d.Set("context", []interface{}{ctxMap})
@@ -473,7 +474,12 @@ func commaSeparatedStringToStringList(commaString string) []string {
 	return strList
 }
 
-func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema.ResourceData, realizedEntityPath string, timeout int) *resource.StateChangeConf {
+// Realization polling starts after short delay, and backs off from the
+// minimal interval for long-running realization
+const realizationPollDelay = 1 * time.Second
+const realizationPollMinInterval = 1 * time.Second
+
+func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema.ResourceData, realizedEntityPath string, timeout time.Duration) *resource.StateChangeConf {
 	client := realized_state.NewRealizedEntitiesClient(connector)
 	pendingStates := []string{"UNKNOWN", "UNREALIZED"}
 	targetStates := []string{"REALIZED", "ERROR"}
@@ -495,9 +501,9 @@ func nsxtPolicyWaitForRealizationStateConf(connector client.Connector, d *schema
 			}
 			return nil, "", realizationError
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
-		MinTimeout: 1 * time.Second,
-		Delay:      1 * time.Second,
+		Timeout:    timeout,
+		MinTimeout: realizationPollMinInterval,
+		Delay:      realizationPollDelay,
 	}
 
 	return stateConf
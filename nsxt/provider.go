@@ -4,30 +4,29 @@
 package nsxt
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"math/rand"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"os"
 	"regexp"
 	"strings"
-	"time"
 
 	tf_api "github.com/vmware/terraform-provider-nsxt/api/utl"
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	api "github.com/vmware/go-vmware-nsxt"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
-	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client/middleware/retry"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx"
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
@@ -45,6 +44,7 @@ type commonProviderConfig struct {
 	MinRetryInterval       int
 	MaxRetryInterval       int
 	RetryStatusCodes       []int
+	RetryStrategy          string
 	Username               string
 	Password               string
 	LicenseKeys            []string
@@ -57,22 +57,23 @@ type nsxtClients struct {
 	// Config for the above client
 	NsxtClientConfig *api.Configuration
 	// Data for NSX Policy client - based on vsphere-automation-sdk-go SDK
-	// First offering of Policy SDK does not support concurrent
-	// operations in single connector. In order to avoid heavy locks,
-	// we are allocating connector per provider operation.
-	// TODO: when concurrency support is introduced policy client,
-	// change this code to allocate single connector for all provider
-	// operations.
-	PolicySecurityContext  *core.SecurityContextImpl
-	PolicyHTTPClient       *http.Client
+	PolicySecurityContext *core.SecurityContextImpl
+	PolicyHTTPClient      *http.Client
+	// Policy connector shared by all provider operations. Operation-specific
+	// settings, such as context and retries, are layered on top of it per call
+	PolicyConnector        client.Connector
 	Host                   string
 	PolicyEnforcementPoint string
 	PolicyGlobalManager    bool
+	// Context of current provider operation, populated per CRUD call
+	Context context.Context
+	// Warning diagnostics collected during current provider operation
+	Warnings *providerWarnings
 }
 
 // Provider for VMWare NSX-T
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 
 		Schema: map[string]*schema.Schema{
 			"allow_unverified_ssl": {
@@ -141,6 +142,26 @@ func Provider() *schema.Provider {
 				Description: "Maximum delay in milliseconds between retries of a request",
 				DefaultFunc: schema.EnvDefaultFunc("NSXT_RETRY_MAX_DELAY", 500),
 			},
+			"retry_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Strategy for computing delay between retries of a request",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_RETRY_STRATEGY", retryStrategyUniform),
+				ValidateFunc: validation.StringInSlice(retryStrategyValues, false),
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of concurrent HTTP requests towards NSX. Unlimited if not set",
+				DefaultFunc:  schema.EnvDefaultFunc("NSXT_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"http_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "File to write JSON record per NSX API call to, with sensitive fields redacted",
+				DefaultFunc: schema.EnvDefaultFunc("NSXT_HTTP_TRACE_FILE", nil),
+			},
 			"retry_on_status_codes": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -277,6 +298,7 @@ func Provider() *schema.Provider {
 			"nsxt_policy_mac_discovery_profile":                      dataSourceNsxtPolicyMacDiscoveryProfile(),
 			"nsxt_policy_vm":                                         dataSourceNsxtPolicyVM(),
 			"nsxt_policy_vms":                                        dataSourceNsxtPolicyVMs(),
+			"nsxt_policy_objects":                                    dataSourceNsxtPolicyObjects(),
 			"nsxt_policy_lb_app_profile":                             dataSourceNsxtPolicyLBAppProfile(),
 			"nsxt_policy_lb_client_ssl_profile":                      dataSourceNsxtPolicyLBClientSslProfile(),
 			"nsxt_policy_lb_server_ssl_profile":                      dataSourceNsxtPolicyLBServerSslProfile(),
@@ -295,12 +317,16 @@ func Provider() *schema.Provider {
 			"nsxt_policy_bfd_profile":                                dataSourceNsxtPolicyBfdProfile(),
 			"nsxt_policy_intrusion_service_profile":                  dataSourceNsxtPolicyIntrusionServiceProfile(),
 			"nsxt_policy_lb_service":                                 dataSourceNsxtPolicyLbService(),
+			"nsxt_policy_lb_service_status":                          dataSourceNsxtPolicyLbServiceStatus(),
+			"nsxt_policy_lb_pool_status":                             dataSourceNsxtPolicyLbPoolStatus(),
+			"nsxt_policy_lb_virtual_server_statistics":               dataSourceNsxtPolicyLbVirtualServerStatistics(),
 			"nsxt_policy_gateway_locale_service":                     dataSourceNsxtPolicyGatewayLocaleService(),
 			"nsxt_policy_bridge_profile":                             dataSourceNsxtPolicyBridgeProfile(),
 			"nsxt_policy_ipsec_vpn_local_endpoint":                   dataSourceNsxtPolicyIPSecVpnLocalEndpoint(),
 			"nsxt_policy_ipsec_vpn_service":                          dataSourceNsxtPolicyIPSecVpnService(),
 			"nsxt_policy_l2_vpn_service":                             dataSourceNsxtPolicyL2VpnService(),
 			"nsxt_policy_segment":                                    dataSourceNsxtPolicySegment(),
+			"nsxt_policy_segment_port":                               dataSourceNsxtPolicySegmentPort(),
 			"nsxt_policy_project":                                    dataSourceNsxtPolicyProject(),
 			"nsxt_policy_gateway_dns_forwarder":                      dataSourceNsxtPolicyGatewayDNSForwarder(),
 			"nsxt_policy_gateway_prefix_list":                        dataSourceNsxtPolicyGatewayPrefixList(),
@@ -315,6 +341,7 @@ func Provider() *schema.Provider {
 			"nsxt_compute_manager_realization":                       dataSourceNsxtComputeManagerRealization(),
 			"nsxt_policy_host_transport_node":                        dataSourceNsxtPolicyHostTransportNode(),
 			"nsxt_manager_cluster_node":                              dataSourceNsxtManagerClusterNode(),
+			"nsxt_backup_history":                                    dataSourceNsxtBackupHistory(),
 			"nsxt_policy_host_transport_node_profile":                dataSourceNsxtPolicyHostTransportNodeProfile(),
 			"nsxt_transport_node":                                    dataSourceNsxtTransportNode(),
 			"nsxt_discovered_node":                                   dataSourceNsxtDiscoveredNode(),
@@ -402,6 +429,7 @@ func Provider() *schema.Provider {
 			"nsxt_policy_predefined_gateway_policy":                    resourceNsxtPolicyPredefinedGatewayPolicy(),
 			"nsxt_policy_predefined_security_policy":                   resourceNsxtPolicyPredefinedSecurityPolicy(),
 			"nsxt_policy_segment":                                      resourceNsxtPolicySegment(),
+			"nsxt_policy_segment_port":                                 resourceNsxtPolicySegmentPort(),
 			"nsxt_policy_vlan_segment":                                 resourceNsxtPolicyVlanSegment(),
 			"nsxt_policy_fixed_segment":                                resourceNsxtPolicyFixedSegment(),
 			"nsxt_policy_static_route":                                 resourceNsxtPolicyStaticRoute(),
@@ -466,12 +494,19 @@ func Provider() *schema.Provider {
 			"nsxt_edge_transport_node":                                 resourceNsxtEdgeTransportNode(),
 			"nsxt_failure_domain":                                      resourceNsxtFailureDomain(),
 			"nsxt_cluster_virtual_ip":                                  resourceNsxtClusterVirualIP(),
+			"nsxt_backup_configuration":                                resourceNsxtBackupConfiguration(),
 			"nsxt_policy_host_transport_node_profile":                  resourceNsxtPolicyHostTransportNodeProfile(),
 			"nsxt_policy_host_transport_node":                          resourceNsxtPolicyHostTransportNode(),
 			"nsxt_edge_high_availability_profile":                      resourceNsxtEdgeHighAvailabilityProfile(),
 			"nsxt_policy_host_transport_node_collection":               resourceNsxtPolicyHostTransportNodeCollection(),
 			"nsxt_policy_lb_client_ssl_profile":                        resourceNsxtPolicyLBClientSslProfile(),
+			"nsxt_policy_lb_server_ssl_profile":                        resourceNsxtPolicyLBServerSslProfile(),
 			"nsxt_policy_lb_http_application_profile":                  resourceNsxtPolicyLBHttpApplicationProfile(),
+			"nsxt_policy_lb_fast_tcp_application_profile":              resourceNsxtPolicyLBFastTcpApplicationProfile(),
+			"nsxt_policy_lb_fast_udp_application_profile":              resourceNsxtPolicyLBFastUdpApplicationProfile(),
+			"nsxt_policy_lb_cookie_persistence_profile":                resourceNsxtPolicyLBCookiePersistenceProfile(),
+			"nsxt_policy_lb_source_ip_persistence_profile":             resourceNsxtPolicyLBSourceIPPersistenceProfile(),
+			"nsxt_policy_lb_generic_persistence_profile":               resourceNsxtPolicyLBGenericPersistenceProfile(),
 			"nsxt_policy_security_policy_rule":                         resourceNsxtPolicySecurityPolicyRule(),
 			"nsxt_policy_parent_security_policy":                       resourceNsxtPolicyParentSecurityPolicy(),
 			"nsxt_policy_firewall_exclude_list_member":                 resourceNsxtPolicyFirewallExcludeListMember(),
@@ -499,10 +534,37 @@ func Provider() *schema.Provider {
 			"nsxt_vpc_security_policy":                                 resourceNsxtVPCSecurityPolicy(),
 			"nsxt_vpc_group":                                           resourceNsxtVPCGroup(),
 			"nsxt_vpc_gateway_policy":                                  resourceNsxtVPCGatewayPolicy(),
+			"nsxt_vpc":                                                 resourceNsxtVPC(),
+			"nsxt_vpc_subnet":                                          resourceNsxtVPCSubnet(),
+			"nsxt_vpc_static_route":                                    resourceNsxtVPCStaticRoute(),
+			"nsxt_vpc_nat_rule":                                        resourceNsxtVPCNatRule(),
+			"nsxt_vpc_ip_address_allocation":                           resourceNsxtVPCIPAddressAllocation(),
+			"nsxt_policy_certificate":                                  resourceNsxtPolicyCertificate(),
+			"nsxt_policy_ca_bundle":                                    resourceNsxtPolicyCaBundle(),
+			"nsxt_policy_crl":                                          resourceNsxtPolicyCrl(),
+			"nsxt_policy_pim_profile":                                  resourceNsxtPolicyPimProfile(),
+			"nsxt_policy_igmp_profile":                                 resourceNsxtPolicyIgmpProfile(),
+			"nsxt_policy_gateway_multicast_config":                     resourceNsxtPolicyGatewayMulticastConfig(),
+			"nsxt_policy_session_timer_profile":                        resourceNsxtPolicySessionTimerProfile(),
+			"nsxt_policy_session_timer_profile_binding":                resourceNsxtPolicySessionTimerProfileBinding(),
+			"nsxt_policy_ipfix_collector_profile":                      resourceNsxtPolicyIpfixCollectorProfile(),
+			"nsxt_policy_ipfix_dfw_profile":                            resourceNsxtPolicyIpfixDfwProfile(),
+			"nsxt_policy_tls_decryption_profile":                       resourceNsxtPolicyTlsDecryptionProfile(),
+			"nsxt_policy_tls_inspection_policy":                        resourceNsxtPolicyTlsInspectionPolicy(),
+			"nsxt_policy_share":                                        resourceNsxtPolicyShare(),
+			"nsxt_policy_shared_resource":                              resourceNsxtPolicySharedResource(),
 		},
 
-		ConfigureFunc: providerConfigure,
+		ConfigureContextFunc: providerConfigure,
+	}
+
+	for _, r := range provider.ResourcesMap {
+		withoutOperationTimeout(r)
+	}
+	for _, r := range provider.DataSourcesMap {
+		withoutOperationTimeout(r)
 	}
+	return provider
 }
 
 func isVMCCredentialSet(d *schema.ResourceData) bool {
@@ -522,7 +584,7 @@ func isVMCCredentialSet(d *schema.ResourceData) bool {
 	return false
 }
 
-func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
+func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients, transport http.RoundTripper) error {
 	onDemandConn := d.Get("on_demand_connection").(bool)
 	clientAuthCertFile := d.Get("client_auth_cert_file").(string)
 	clientAuthKeyFile := d.Get("client_auth_key_file").(string)
@@ -587,15 +649,13 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 
 	caFile := d.Get("ca_file").(string)
 	caString := d.Get("ca").(string)
-	sessionAuth := d.Get("session_auth").(bool)
-	skipSessionAuth := !sessionAuth
 
+	// Retries are performed by provider retry policy, shared with policy client.
+	// Note that the SDK still repeats once a request that failed with no response.
 	retriesConfig := api.ClientRetriesConfiguration{
-		MaxRetries:      clients.CommonConfig.MaxRetries,
-		RetryMinDelay:   clients.CommonConfig.MinRetryInterval,
-		RetryMaxDelay:   clients.CommonConfig.MaxRetryInterval,
-		RetryOnStatuses: clients.CommonConfig.RetryStatusCodes,
+		MaxRetries: 0,
 	}
+	retryTransport := newRetryTransport(newRetryPolicy(clients.CommonConfig), transport)
 
 	clients.NsxtClientConfig = &api.Configuration{
 		BasePath:             "/api/v1",
@@ -613,7 +673,9 @@ func configureNsxtClient(d *schema.ResourceData, clients *nsxtClients) error {
 		CAString:             caString,
 		Insecure:             insecure,
 		RetriesConfiguration: retriesConfig,
-		SkipSessionAuth:      skipSessionAuth,
+		// Session is managed by provider session manager, shared with policy client
+		SkipSessionAuth: true,
+		HTTPClient:      &http.Client{Transport: retryTransport},
 	}
 
 	nsxClient, err := api.NewAPIClient(clients.NsxtClientConfig)
@@ -775,7 +837,7 @@ func getConnectorTLSConfig(d *schema.ResourceData) (*tls.Config, error) {
 	return &tlsConfig, nil
 }
 
-func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients) error {
+func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients, transport http.RoundTripper) error {
 	onDemandConn := d.Get("on_demand_connection").(bool)
 	host := d.Get("host").(string)
 	username := d.Get("username").(string)
@@ -815,21 +877,32 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 		clients.PolicySecurityContext = securityCtx
 	}
 
-	tlsConfig, err := getConnectorTLSConfig(d)
-	if err != nil {
-		return err
-	}
-
-	tr := &http.Transport{
-		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: tlsConfig,
+	httpClient := http.Client{Transport: transport}
+	sessionAuth := d.Get("session_auth").(bool)
+	if sessionAuth && !isVMC && securityContextNeeded {
+		// Session is shared between policy and MP clients, and re-created on expiry
+		manager := newSessionManager(host, username, password, clients.CommonConfig.RemoteAuth, &http.Client{Transport: transport})
+		httpClient.Transport = newSessionTransport(manager, transport)
+		if clients.NsxtClientConfig != nil && clients.NsxtClientConfig.HTTPClient != nil {
+			mpClient := clients.NsxtClientConfig.HTTPClient
+			mpClient.Transport = newSessionTransport(manager, mpClient.Transport)
+			if clients.CommonConfig.RemoteAuth {
+				if clients.NsxtClientConfig.DefaultHeader == nil {
+					clients.NsxtClientConfig.DefaultHeader = make(map[string]string)
+				}
+				clients.NsxtClientConfig.DefaultHeader["Authorization"] = getRemoteAuthHeader(username, password)
+			}
+		}
+		log.Printf("[INFO]: Session authentication configured for NSX clients")
 	}
-
-	httpClient := http.Client{Transport: tr}
 	clients.PolicyHTTPClient = &httpClient
 	clients.Host = host
 	clients.PolicyEnforcementPoint = policyEnforcementPoint
 	clients.PolicyGlobalManager = policyGlobalManager
+	clients.PolicyConnector = &sharedPolicyConnector{
+		Connector:     newPolicyConnector(*clients, nil),
+		sharedObjects: newPolicySharedObjectsCache(),
+	}
 
 	if onDemandConn {
 		// version init will happen on demand
@@ -837,13 +910,13 @@ func configurePolicyConnectorData(d *schema.ResourceData, clients *nsxtClients)
 	}
 
 	if !isVMC {
-		err = configureLicenses(getStandalonePolicyConnector(*clients, true), clients.CommonConfig.LicenseKeys)
+		err := configureLicenses(getStandalonePolicyConnector(*clients, true), clients.CommonConfig.LicenseKeys)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = initNSXVersion(getStandalonePolicyConnector(*clients, true))
+	err := initNSXVersion(getStandalonePolicyConnector(*clients, true))
 	if err != nil && isVMC {
 		// In case version API does not work for VMC, we workaround by testing version-specific APIs
 		// TODO - remove this when /node/version API works for all auth methods on VMC
@@ -921,10 +994,15 @@ func newLogRequestProcessor() *logRequestProcessor {
 }
 
 func (processor logRequestProcessor) Process(req *http.Request) error {
-	reqDump, err := httputil.DumpRequestOut(req, true)
+	reqDump, err := httputil.DumpRequestOut(req, false)
+	if err != nil {
+		log.Fatal(err)
+	}
+	body, reqBody, err := readBody(req.Body)
 	if err != nil {
 		log.Fatal(err)
 	}
+	req.Body = reqBody
 
 	// Replace sensitive information in HTTP headers
 	authHeaderRegexp := regexp.MustCompile(`(?i)Authorization:.*`)
@@ -932,7 +1010,7 @@ func (processor logRequestProcessor) Process(req *http.Request) error {
 	replaced := authHeaderRegexp.ReplaceAllString(string(reqDump), "<Omitted Authorization header>")
 	replaced = cspHeaderRegexp.ReplaceAllString(replaced, "<Omitted Csp-Auth-Token header>")
 
-	log.Printf("Issuing request towards NSX:\n%s", replaced)
+	log.Printf("Issuing request towards NSX:\n%s%s", replaced, redactBody(req.Header.Get("Content-Type"), body))
 	return nil
 }
 
@@ -944,11 +1022,16 @@ func newLogResponseAcceptor() *logResponseAcceptor {
 }
 
 func (processor logResponseAcceptor) Accept(req *http.Response) {
-	dumpResponse, err := httputil.DumpResponse(req, true)
+	dumpResponse, err := httputil.DumpResponse(req, false)
 	if err != nil {
 		log.Fatal(err)
 	}
-	log.Printf("Received NSX response:\n%s", dumpResponse)
+	body, respBody, err := readBody(req.Body)
+	if err != nil {
+		log.Fatal(err)
+	}
+	req.Body = respBody
+	log.Printf("Received NSX response:\n%s%s", dumpResponse, redactBody(req.Header.Get("Content-Type"), body))
 }
 
 type bearerAuthHeaderProcessor struct {
@@ -965,24 +1048,6 @@ func (processor bearerAuthHeaderProcessor) Process(req *http.Request) error {
 	return nil
 }
 
-type sessionHeaderProcessor struct {
-	cookie string
-	xsrf   string
-}
-
-func newSessionHeaderProcessor(cookie string, xsrf string) *sessionHeaderProcessor {
-	return &sessionHeaderProcessor{
-		cookie: cookie,
-		xsrf:   xsrf,
-	}
-}
-
-func (processor sessionHeaderProcessor) Process(req *http.Request) error {
-	req.Header.Set("Cookie", processor.cookie)
-	req.Header.Set("X-XSRF-TOKEN", processor.xsrf)
-	return nil
-}
-
 func getLicenses(connector client.Connector) ([]string, error) {
 	var licenseList []string
 	client := nsx.NewLicensesClient(connector)
@@ -1044,6 +1109,7 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 	maxRetries := d.Get("max_retries").(int)
 	retryMinDelay := d.Get("retry_min_delay").(int)
 	retryMaxDelay := d.Get("retry_max_delay").(int)
+	retryStrategy := d.Get("retry_strategy").(string)
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
 
@@ -1066,26 +1132,32 @@ func initCommonConfig(d *schema.ResourceData) commonProviderConfig {
 		MinRetryInterval:       retryMinDelay,
 		MaxRetryInterval:       retryMaxDelay,
 		RetryStatusCodes:       retryStatuses,
+		RetryStrategy:          retryStrategy,
 		Username:               username,
 		Password:               password,
 		LicenseKeys:            licenses,
 	}
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	commonConfig := initCommonConfig(d)
 	clients := nsxtClients{
 		CommonConfig: commonConfig,
 	}
 
-	err := configureNsxtClient(d, &clients)
+	transport, err := getConnectorTransport(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	err = configureNsxtClient(d, &clients, transport)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
-	err = configurePolicyConnectorData(d, &clients)
+	err = configurePolicyConnectorData(d, &clients, transport)
 	if err != nil {
-		return nil, err
+		return nil, diag.FromErr(err)
 	}
 
 	return clients, nil
@@ -1106,44 +1178,51 @@ func getStandalonePolicyConnector(clients interface{}, withRetry bool) client.Co
 func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[string]string, standaloneFlow bool, withRetry bool) client.Connector {
 	c := clients.(nsxtClients)
 
-	retryFunc := func(retryContext retry.RetryContext) bool {
-		shouldRetry := false
-		if retryContext.Response != nil {
-			for _, code := range c.CommonConfig.RetryStatusCodes {
-				if retryContext.Response.StatusCode == code {
-					log.Printf("[DEBUG]: Retrying request due to error code %d", code)
-					shouldRetry = true
-					break
-				}
-			}
-		} else {
-			shouldRetry = true
-			log.Printf("[DEBUG]: Retrying request due to error")
-		}
+	// Shared connector is used unless the call requires custom headers, or
+	// the clients were constructed outside of provider configuration
+	connector := c.PolicyConnector
+	if connector == nil || customHeaders != nil {
+		connector = newPolicyConnector(c, customHeaders)
+	}
 
-		if !shouldRetry {
-			return false
-		}
+	// Context decorator is outermost, so that retries observe operation context
+	var decorators []core.APIProviderDecorator
+	if withRetry {
+		decorators = append(decorators, newRetryDecorator(newRetryPolicy(c.CommonConfig)))
+	}
+	if c.Context != nil {
+		decorators = append(decorators, newContextDecorator(c.Context))
+	}
+	if len(decorators) > 0 {
+		connector = newOperationConnector(connector, decorators...)
+	}
 
-		min := c.CommonConfig.MinRetryInterval
-		max := c.CommonConfig.MaxRetryInterval
-		if max > 0 {
-			interval := (rand.Intn(max-min) + min)
-			time.Sleep(time.Duration(interval) * time.Millisecond)
-			log.Printf("[DEBUG]: Waited %d ms before retrying", interval)
+	// Init NSX version on demand if not done yet
+	// This is also our indication to apply licenses, in case of delayed connection
+	// This step is skipped if the connector is for special purpose, or for different endpoint
+	if util.NsxVersion == "" && !standaloneFlow {
+		initNSXVersion(connector)
+		err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
+		if err != nil {
+			addProviderWarning(clients, "Failed to apply NSX licenses", "%v", err)
 		}
-
-		return true
 	}
+	return connector
+}
 
-	connectorOptions := []client.ConnectorOption{client.UsingRest(nil), client.WithHttpClient(c.PolicyHTTPClient)}
+// newPolicyConnector creates policy connector that is safe for concurrent use
+// across provider operations
+func newPolicyConnector(c nsxtClients, customHeaders *map[string]string) client.Connector {
+	// Application context is initialized upfront, since connector initializes
+	// it lazily without synchronization
+	connectorOptions := []client.ConnectorOption{
+		client.UsingRest(nil),
+		client.WithHttpClient(c.PolicyHTTPClient),
+		client.WithApplicationContext(core.NewApplicationContext(nil)),
+	}
 	var requestProcessors []core.RequestProcessor
 	var responseAcceptors []core.ResponseAcceptor
 
-	if withRetry {
-		connectorOptions = append(connectorOptions, client.WithDecorators(retry.NewRetryDecorator(uint(c.CommonConfig.MaxRetries), retryFunc)))
-	}
-
 	if c.PolicySecurityContext != nil {
 		connectorOptions = append(connectorOptions, client.WithSecurityContext(c.PolicySecurityContext))
 	}
@@ -1157,20 +1236,6 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 		requestProcessors = append(requestProcessors, newCustomHeaderProcessor(customHeaders).Process)
 	}
 
-	// Session support for policy resources (main rationale - vIDM environment where auth is slow)
-	// Currently session creation is done via old MP sdk.
-	// TODO - when MP resources are removed, switch to official SDK to initiate session/create API
-	// TODO - re-trigger session/create when token is expired
-	if c.NsxtClientConfig != nil && len(c.NsxtClientConfig.DefaultHeader["Cookie"]) > 0 {
-		cookie := c.NsxtClientConfig.DefaultHeader["Cookie"]
-		xsrf := ""
-		if len(c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]) > 0 {
-			xsrf = c.NsxtClientConfig.DefaultHeader["X-XSRF-TOKEN"]
-		}
-		requestProcessors = append(requestProcessors, newSessionHeaderProcessor(cookie, xsrf).Process)
-		log.Printf("[INFO]: Session headers configured for policy objects")
-	}
-
 	if os.Getenv("TF_LOG_PROVIDER_NSX_HTTP") != "" {
 		requestProcessors = append(requestProcessors, newLogRequestProcessor().Process)
 		responseAcceptors = append(responseAcceptors, newLogResponseAcceptor().Accept)
@@ -1182,18 +1247,36 @@ func getPolicyConnectorWithHeaders(clients interface{}, customHeaders *map[strin
 	if len(responseAcceptors) > 0 {
 		connectorOptions = append(connectorOptions, client.WithResponseAcceptors(responseAcceptors...))
 	}
-	connector := client.NewConnector(c.Host, connectorOptions...)
-	// Init NSX version on demand if not done yet
-	// This is also our indication to apply licenses, in case of delayed connection
-	// This step is skipped if the connector is for special purpose, or for different endpoint
-	if util.NsxVersion == "" && !standaloneFlow {
-		initNSXVersion(connector)
-		err := configureLicenses(connector, c.CommonConfig.LicenseKeys)
-		if err != nil {
-			log.Printf("[ERROR]: Failed to apply NSX licenses")
-		}
+	return client.NewConnector(c.Host, connectorOptions...)
+}
+
+// sharedPolicyConnector is policy connector shared by all provider operations,
+// along with data that is cached per provider
+type sharedPolicyConnector struct {
+	client.Connector
+	sharedObjects *policySharedObjectsCache
+}
+
+// operationConnector decorates API provider of shared connector with
+// settings specific to single provider operation
+type operationConnector struct {
+	client.Connector
+	provider core.APIProvider
+}
+
+func newOperationConnector(connector client.Connector, decorators ...core.APIProviderDecorator) *operationConnector {
+	provider := connector.GetApiProvider()
+	for _, decorator := range decorators {
+		provider = decorator(provider)
 	}
-	return connector
+	return &operationConnector{
+		Connector: connector,
+		provider:  provider,
+	}
+}
+
+func (c *operationConnector) GetApiProvider() core.APIProvider {
+	return c.provider
 }
 
 func getPolicyEnforcementPoint(clients interface{}) string {
@@ -0,0 +1,131 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+)
+
+// providerWarnings collects warning diagnostics raised during single
+// provider operation
+type providerWarnings struct {
+	mu    sync.Mutex
+	diags diag.Diagnostics
+}
+
+func (w *providerWarnings) add(summary string, detail string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.diags = append(w.diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+func (w *providerWarnings) diagnostics() diag.Diagnostics {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.diags
+}
+
+// withContext adapts CRUD function to terraform context-aware interface.
+// The context and warning collector are carried to the function within
+// provider clients structure.
+func withContext(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		clients := m.(nsxtClients)
+		clients.Context = ctx
+		clients.Warnings = &providerWarnings{}
+
+		err := f(d, clients)
+		diags := clients.Warnings.diagnostics()
+		if err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+// withoutOperationTimeout moves context-aware CRUD functions of the resource
+// to their variants without operation timeout. Otherwise terraform cancels the
+// context after the timeout configured in timeouts block, or after 20 minutes
+// if the block is not declared, which would cut waits that are bounded by
+// their own timeout attributes.
+func withoutOperationTimeout(r *schema.Resource) {
+	if r.CreateContext != nil {
+		r.CreateWithoutTimeout = r.CreateContext
+		r.CreateContext = nil
+	}
+	if r.ReadContext != nil {
+		r.ReadWithoutTimeout = r.ReadContext
+		r.ReadContext = nil
+	}
+	if r.UpdateContext != nil {
+		r.UpdateWithoutTimeout = r.UpdateContext
+		r.UpdateContext = nil
+	}
+	if r.DeleteContext != nil {
+		r.DeleteWithoutTimeout = r.DeleteContext
+		r.DeleteContext = nil
+	}
+}
+
+// getProviderContext returns context of current provider operation
+func getProviderContext(m interface{}) context.Context {
+	if clients, ok := m.(nsxtClients); ok && clients.Context != nil {
+		return clients.Context
+	}
+	return context.Background()
+}
+
+// addProviderWarning surfaces soft failure to the user as warning diagnostic.
+// Outside of CRUD operation context, the warning is logged.
+func addProviderWarning(m interface{}, summary string, format string, args ...interface{}) {
+	detail := fmt.Sprintf(format, args...)
+	log.Printf("[WARNING]: %s: %s", summary, detail)
+	if clients, ok := m.(nsxtClients); ok && clients.Warnings != nil {
+		clients.Warnings.add(summary, detail)
+	}
+}
+
+// newContextDecorator propagates provider operation context to vAPI calls,
+// so that canceled operation aborts requests in flight
+func newContextDecorator(ctx context.Context) core.APIProviderDecorator {
+	return func(next core.APIProvider) core.APIProvider {
+		return &contextProvider{next: next, ctx: ctx}
+	}
+}
+
+type contextProvider struct {
+	next core.APIProvider
+	ctx  context.Context
+}
+
+func (p *contextProvider) Invoke(serviceID string, operationID string, inputValue data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	ctx.WithContext(operationContext{Context: p.ctx, values: ctx.Context()})
+	return p.next.Invoke(serviceID, operationID, inputValue, ctx)
+}
+
+// operationContext takes deadline and cancellation from provider operation,
+// while preserving values of vAPI execution context, such as REST metadata
+type operationContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c operationContext) Value(key interface{}) interface{} {
+	if value := c.values.Value(key); value != nil {
+		return value
+	}
+	return c.Context.Value(key)
+}
@@ -0,0 +1,107 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+)
+
+type testContextKey struct{}
+
+func TestWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), testContextKey{}, "value")
+
+	f := withContext(func(d *schema.ResourceData, m interface{}) error {
+		if getProviderContext(m).Value(testContextKey{}) != "value" {
+			t.Errorf("context was not propagated")
+		}
+		addProviderWarning(m, "Soft failure", "detail %d", 1)
+		return fmt.Errorf("hard failure")
+	})
+
+	diags := f(ctx, nil, nsxtClients{})
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d", len(diags))
+	}
+	if diags[0].Severity != diag.Warning || diags[0].Summary != "Soft failure" || diags[0].Detail != "detail 1" {
+		t.Errorf("unexpected warning diagnostic %v", diags[0])
+	}
+	if diags[1].Severity != diag.Error || diags[1].Summary != "hard failure" {
+		t.Errorf("unexpected error diagnostic %v", diags[1])
+	}
+}
+
+func TestWithoutOperationTimeout(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"timeout": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if _, ok := ctx.Deadline(); ok {
+				t.Errorf("expected read context without deadline")
+			}
+			d.SetId("id")
+			return nil
+		},
+	}
+	withoutOperationTimeout(r)
+	if r.ReadContext != nil || r.ReadWithoutTimeout == nil {
+		t.Fatalf("expected read to be moved to variant without timeout")
+	}
+
+	if _, diags := r.ReadDataApply(context.Background(), &terraform.InstanceDiff{}, nil); diags.HasError() {
+		t.Fatalf("read failed: %v", diags)
+	}
+
+	for name, r := range Provider().ResourcesMap {
+		if r.CreateContext != nil || r.ReadContext != nil || r.UpdateContext != nil || r.DeleteContext != nil {
+			t.Errorf("resource %s has CRUD function with operation timeout", name)
+		}
+	}
+	for name, r := range Provider().DataSourcesMap {
+		if r.ReadContext != nil {
+			t.Errorf("data source %s has read function with operation timeout", name)
+		}
+	}
+}
+
+func TestAddProviderWarningWithoutOperation(t *testing.T) {
+	// warning outside of CRUD operation should not panic
+	addProviderWarning(nsxtClients{}, "Soft failure", "detail")
+	if getProviderContext(nsxtClients{}) != context.Background() {
+		t.Errorf("expected background context")
+	}
+}
+
+type testAPIProvider struct {
+	ctx context.Context
+}
+
+func (p *testAPIProvider) Invoke(serviceID string, operationID string, inputValue data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	p.ctx = ctx.Context()
+	return core.NewMethodResult(nil, nil)
+}
+
+func TestContextDecorator(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	next := &testAPIProvider{}
+	provider := newContextDecorator(ctx)(next)
+	provider.Invoke("service", "operation", nil, core.NewExecutionContext(nil, nil))
+	if next.ctx.Done() != ctx.Done() {
+		t.Errorf("context was not set on execution context")
+	}
+}
@@ -10,8 +10,10 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +27,8 @@ import (
 var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var testAccConnector client.Connector
+var testAccSimulator *simulator.Server
+var testAccSimulatorOnce sync.Once
 
 func init() {
 
@@ -44,7 +48,181 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = Provider()
 }
 
+// testAccStartSimulator points acceptance tests to in-process NSX API
+// simulator when NSXT_TEST_SIMULATOR is set, and seeds the objects tests
+// expect to be pre-configured on NSX
+func testAccStartSimulator() {
+	if os.Getenv("NSXT_TEST_SIMULATOR") == "" {
+		return
+	}
+
+	testAccSimulatorOnce.Do(func() {
+		testAccSimulator = simulator.NewServer()
+		os.Setenv("NSXT_MANAGER_HOST", testAccSimulator.Host())
+		os.Setenv("NSXT_USERNAME", testAccSimulator.Username)
+		os.Setenv("NSXT_PASSWORD", testAccSimulator.Password)
+		os.Setenv("NSXT_ALLOW_UNVERIFIED_SSL", "true")
+
+		epPath := "/infra/sites/default/enforcement-points/default"
+		testAccSimulator.Seed(epPath+"/transport-zones/"+getOverlayTransportZoneName(), map[string]interface{}{
+			"display_name":   getOverlayTransportZoneName(),
+			"resource_type":  "PolicyTransportZone",
+			"tz_type":        "OVERLAY_STANDARD",
+			"is_default":     true,
+			"transport_type": "OVERLAY",
+		})
+		testAccSimulator.Seed(epPath+"/transport-zones/"+getVlanTransportZoneName(), map[string]interface{}{
+			"display_name":   getVlanTransportZoneName(),
+			"resource_type":  "PolicyTransportZone",
+			"tz_type":        "VLAN_BACKED",
+			"is_default":     false,
+			"transport_type": "VLAN",
+		})
+		testAccSimulator.Seed(epPath+"/edge-clusters/"+getEdgeClusterName(), map[string]interface{}{
+			"display_name":  getEdgeClusterName(),
+			"resource_type": "PolicyEdgeCluster",
+		})
+		testAccSimulator.Seed("/infra/tier-0s/"+getTier0RouterName(), map[string]interface{}{
+			"display_name":  getTier0RouterName(),
+			"resource_type": "Tier0",
+		})
+	})
+}
+
+func getTestSimulatorProviderMeta(t *testing.T, srv *simulator.Server) interface{} {
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"host":                 srv.Host(),
+		"username":             srv.Username,
+		"password":             srv.Password,
+		"allow_unverified_ssl": true,
+	})
+	m, diags := providerConfigure(context.Background(), d)
+	if diags.HasError() {
+		t.Fatalf("failed to configure provider: %v", diags)
+	}
+	return m
+}
+
+// newTestSimulator starts NSX API simulator for the duration of the test and
+// returns provider meta configured against it
+func newTestSimulator(t *testing.T) (*simulator.Server, interface{}) {
+	srv := simulator.NewServer()
+	t.Cleanup(srv.Close)
+	return srv, getTestSimulatorProviderMeta(t, srv)
+}
+
+// getTestSimulatorObject returns object stored by the simulator on Policy
+// path, or Manager API singleton configuration on /api/v1 path
+func getTestSimulatorObject(srv *simulator.Server, path string) (map[string]interface{}, bool) {
+	if strings.HasPrefix(path, "/api/v1/") {
+		return srv.GetManagerConfig(path)
+	}
+	return srv.Get(path)
+}
+
+// testSimulatorResource describes resource exercised by testSimulatorCrud
+type testSimulatorResource struct {
+	name     string
+	resource *schema.Resource
+	config   map[string]interface{}
+	// NSX path of the object configured by the resource
+	path string
+	// create is expected to be rejected, and no object created on NSX
+	createFails bool
+	// checks object on NSX and resource state after create
+	checkCreate func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData)
+	// attributes changed on update, nil value removes the attribute. Update
+	// is skipped when empty.
+	update      map[string]interface{}
+	checkUpdate func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData)
+	// checks object on NSX after delete. By default the object is expected
+	// to be removed.
+	checkDelete func(t *testing.T, obj map[string]interface{}, found bool)
+}
+
+// testSimulatorCrud creates resources against NSX API simulator in given
+// order, so that later resources may refer to earlier ones, then updates
+// them and deletes them in reverse order
+func testSimulatorCrud(t *testing.T, srv *simulator.Server, m interface{}, resources []testSimulatorResource) {
+	data := make([]*schema.ResourceData, len(resources))
+	for i, res := range resources {
+		d := schema.TestResourceDataRaw(t, res.resource.Schema, res.config)
+		diags := res.resource.CreateContext(context.Background(), d, m)
+		if res.createFails {
+			if !diags.HasError() {
+				t.Errorf("expected create of %s to fail", res.name)
+			}
+			if _, ok := getTestSimulatorObject(srv, res.path); ok && res.path != "" {
+				t.Errorf("%s should not be created on NSX", res.name)
+			}
+			continue
+		}
+		if diags.HasError() {
+			t.Fatalf("%s create failed: %v", res.name, diags)
+		}
+		obj, ok := getTestSimulatorObject(srv, res.path)
+		if !ok {
+			t.Fatalf("%s was not created on NSX", res.name)
+		}
+		if res.checkCreate != nil {
+			res.checkCreate(t, obj, d)
+		}
+		data[i] = d
+	}
+
+	for i, res := range resources {
+		if data[i] == nil || len(res.update) == 0 {
+			continue
+		}
+		config := make(map[string]interface{})
+		for key, value := range res.config {
+			config[key] = value
+		}
+		for key, value := range res.update {
+			if value == nil {
+				delete(config, key)
+				continue
+			}
+			config[key] = value
+		}
+		state := data[i].State()
+		diff, err := res.resource.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), m)
+		if err != nil {
+			t.Fatalf("%s diff failed: %v", res.name, err)
+		}
+		updated, err := schema.InternalMap(res.resource.Schema).Data(state, diff)
+		if err != nil {
+			t.Fatalf("failed to apply %s diff: %v", res.name, err)
+		}
+		if diags := res.resource.UpdateContext(context.Background(), updated, m); diags.HasError() {
+			t.Fatalf("%s update failed: %v", res.name, diags)
+		}
+		obj, _ := getTestSimulatorObject(srv, res.path)
+		if res.checkUpdate != nil {
+			res.checkUpdate(t, obj, updated)
+		}
+		data[i] = updated
+	}
+
+	for i := len(resources) - 1; i >= 0; i-- {
+		res := resources[i]
+		if data[i] == nil {
+			continue
+		}
+		if diags := res.resource.DeleteContext(context.Background(), data[i], m); diags.HasError() {
+			t.Fatalf("%s delete failed: %v", res.name, diags)
+		}
+		obj, found := getTestSimulatorObject(srv, res.path)
+		if res.checkDelete != nil {
+			res.checkDelete(t, obj, found)
+		} else if found {
+			t.Errorf("%s was not deleted on NSX", res.name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
+	testAccStartSimulator()
 	var requiredVariables = []string{"NSXT_USERNAME", "NSXT_PASSWORD", "NSXT_MANAGER_HOST", "NSXT_ALLOW_UNVERIFIED_SSL"}
 	for _, element := range requiredVariables {
 		if v := os.Getenv(element); v == "" {
@@ -135,6 +313,8 @@ func testAccGetPolicyConnector() (client.Connector, error) {
 		return testAccConnector, nil
 	}
 
+	testAccStartSimulator()
+
 	if os.Getenv("NSXT_MANAGER_HOST") == "" {
 		return nil, fmt.Errorf("NSXT_MANAGER_HOST is not set in environment")
 	}
@@ -0,0 +1,122 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// Idle connections kept per NSX manager when request concurrency is not limited
+	defaultMaxIdleConnsPerHost = 32
+	transportDialTimeout       = 30 * time.Second
+	transportKeepAlive         = 30 * time.Second
+	transportIdleConnTimeout   = 90 * time.Second
+	transportTLSTimeout        = 10 * time.Second
+)
+
+// getConnectorTransport returns HTTP transport shared by Policy and MP clients
+// of the provider instance, so that TLS connections towards NSX are reused
+// across all provider operations
+func getConnectorTransport(d *schema.ResourceData) (http.RoundTripper, error) {
+	tlsConfig, err := getConnectorTLSConfig(d)
+	if err != nil {
+		return nil, err
+	}
+
+	maxConcurrentRequests := d.Get("max_concurrent_requests").(int)
+	maxIdleConnsPerHost := defaultMaxIdleConnsPerHost
+	if maxConcurrentRequests > 0 {
+		maxIdleConnsPerHost = maxConcurrentRequests
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   transportDialTimeout,
+		KeepAlive: transportKeepAlive,
+	}
+	tr := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         dialer.DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: transportTLSTimeout,
+		MaxIdleConns:        maxIdleConnsPerHost * 2,
+		MaxIdleConnsPerHost: maxIdleConnsPerHost,
+		IdleConnTimeout:     transportIdleConnTimeout,
+	}
+
+	var transport http.RoundTripper = tr
+	if traceFile := d.Get("http_trace_file").(string); traceFile != "" {
+		transport, err = newHTTPTraceTransport(transport, traceFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if maxConcurrentRequests > 0 {
+		return newConcurrencyLimitTransport(transport, maxConcurrentRequests), nil
+	}
+	return transport, nil
+}
+
+// concurrencyLimitTransport limits number of in-flight requests towards NSX.
+// A request occupies its slot until response body is read to the end or
+// closed. Some clients read error responses without closing the body, hence
+// closing alone can not be relied upon.
+type concurrencyLimitTransport struct {
+	next      http.RoundTripper
+	semaphore chan struct{}
+}
+
+func newConcurrencyLimitTransport(next http.RoundTripper, limit int) *concurrencyLimitTransport {
+	return &concurrencyLimitTransport{
+		next:      next,
+		semaphore: make(chan struct{}, limit),
+	}
+}
+
+func (t *concurrencyLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, req.Context().Err()
+	}
+
+	release := func() { <-t.semaphore }
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnCloseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.release)
+	}
+	return n, err
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,140 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testBlockingTransport struct {
+	inFlight    int32
+	maxInFlight int32
+	unblock     chan struct{}
+}
+
+func (t *testBlockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := atomic.AddInt32(&t.inFlight, 1)
+	for {
+		max := atomic.LoadInt32(&t.maxInFlight)
+		if current <= max || atomic.CompareAndSwapInt32(&t.maxInFlight, max, current) {
+			break
+		}
+	}
+	<-t.unblock
+	atomic.AddInt32(&t.inFlight, -1)
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+func TestConcurrencyLimitTransport(t *testing.T) {
+	next := &testBlockingTransport{unblock: make(chan struct{})}
+	tr := newConcurrencyLimitTransport(next, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://nsx/api/v1/node/version", nil)
+			resp, err := tr.RoundTrip(req)
+			if err != nil {
+				t.Errorf("unexpected error %v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	for i := 0; i < 6; i++ {
+		next.unblock <- struct{}{}
+	}
+	wg.Wait()
+
+	if next.maxInFlight > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", next.maxInFlight)
+	}
+	if len(tr.semaphore) != 0 {
+		t.Errorf("expected all slots to be released, %d still taken", len(tr.semaphore))
+	}
+}
+
+func TestConcurrencyLimitTransportReadWithoutClose(t *testing.T) {
+	next := &testBlockingTransport{unblock: make(chan struct{}, 1)}
+	tr := newConcurrencyLimitTransport(next, 1)
+
+	// error responses may be read to the end without closing the body
+	for i := 0; i < 3; i++ {
+		next.unblock <- struct{}{}
+		req, _ := http.NewRequest(http.MethodGet, "https://nsx/api/v1/node/version", nil)
+		resp, err := tr.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if _, err := io.ReadAll(resp.Body); err != nil {
+			t.Fatalf("unexpected read error %v", err)
+		}
+		if len(tr.semaphore) != 0 {
+			t.Fatalf("expected slot to be released after body was read")
+		}
+	}
+
+	// closing after the body was read must not release the slot again
+	next.unblock <- struct{}{}
+	req, _ := http.NewRequest(http.MethodGet, "https://nsx/api/v1/node/version", nil)
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	io.ReadAll(resp.Body)
+	done := make(chan struct{})
+	go func() {
+		resp.Body.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("slot was released twice")
+	}
+}
+
+func TestConcurrencyLimitTransportCanceled(t *testing.T) {
+	next := &testBlockingTransport{unblock: make(chan struct{})}
+	tr := newConcurrencyLimitTransport(next, 1)
+
+	// occupy the only slot
+	tr.semaphore <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://nsx/api/v1/node/version", nil)
+	_, err := tr.RoundTrip(req)
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded error, got %v", err)
+	}
+}
+
+func TestOperationConnector(t *testing.T) {
+	shared := newPolicyConnector(nsxtClients{Host: "https://nsx", PolicyHTTPClient: &http.Client{}}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	connector := getPolicyConnectorWithHeaders(nsxtClients{PolicyConnector: shared, Context: ctx}, nil, true, true)
+	opConnector, ok := connector.(*operationConnector)
+	if !ok {
+		t.Fatalf("expected operation connector, got %T", connector)
+	}
+	if opConnector.Connector != shared {
+		t.Errorf("expected shared connector to be reused")
+	}
+	if opConnector.GetApiProvider() == shared.GetApiProvider() {
+		t.Errorf("expected operation decorators on top of shared connector")
+	}
+}
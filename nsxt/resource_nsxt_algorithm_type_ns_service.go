@@ -17,10 +17,10 @@ var algTypeValues = []string{"ORACLE_TNS", "FTP", "SUN_RPC_TCP", "SUN_RPC_UDP",
 
 func resourceNsxtAlgorithmTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtAlgorithmTypeNsServiceCreate,
-		Read:   resourceNsxtAlgorithmTypeNsServiceRead,
-		Update: resourceNsxtAlgorithmTypeNsServiceUpdate,
-		Delete: resourceNsxtAlgorithmTypeNsServiceDelete,
+		CreateContext: withContext(resourceNsxtAlgorithmTypeNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtAlgorithmTypeNsServiceRead),
+		UpdateContext: withContext(resourceNsxtAlgorithmTypeNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtAlgorithmTypeNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
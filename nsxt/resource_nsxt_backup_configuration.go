@@ -0,0 +1,378 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/cluster/backups"
+	nsxModel "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-mp/nsx/model"
+)
+
+func resourceNsxtBackupConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtBackupConfigurationCreate),
+		ReadContext:   withContext(resourceNsxtBackupConfigurationRead),
+		UpdateContext: withContext(resourceNsxtBackupConfigurationUpdate),
+		DeleteContext: withContext(resourceNsxtBackupConfigurationDelete),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether automated backup is enabled",
+				Optional:    true,
+				Default:     true,
+			},
+			"after_inventory_update_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Number of seconds after last backup before topology change triggers new backup",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+			"inventory_summary_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Minimum number of seconds between uploads of inventory summary to backup server",
+				Optional:     true,
+				Default:      240,
+				ValidateFunc: validation.IntBetween(30, 3600),
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Description: "Passphrase used to encrypt backup files",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"remote_file_server": {
+				Type:        schema.TypeList,
+				Description: "SFTP server to upload backups to",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"server": {
+							Type:        schema.TypeString,
+							Description: "IP address or FQDN of the server",
+							Required:    true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "Server port",
+							Optional:     true,
+							Default:      22,
+							ValidateFunc: validation.IsPortNumber,
+						},
+						"directory_path": {
+							Type:        schema.TypeString,
+							Description: "Remote server directory to upload backups to",
+							Required:    true,
+						},
+						"ssh_fingerprint": {
+							Type:        schema.TypeString,
+							Description: "Expected SSH fingerprint of the server",
+							Required:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "User name to authenticate with",
+							Required:    true,
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Description: "Password to authenticate with",
+							Required:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+			"weekly_schedule": {
+				Type:          schema.TypeList,
+				Description:   "Schedule to run backups on specific days of the week",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"interval_schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_week": {
+							Type:        schema.TypeSet,
+							Description: "Days of the week to run backups on, where 0 is Sunday",
+							Required:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 6),
+							},
+						},
+						"hour_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Hour of the day to run backups at",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 23),
+						},
+						"minute_of_day": {
+							Type:         schema.TypeInt,
+							Description:  "Minute of the hour to run backups at",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 59),
+						},
+					},
+				},
+			},
+			"interval_schedule": {
+				Type:          schema.TypeList,
+				Description:   "Schedule to run backups in regular intervals",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"weekly_schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"seconds_between_backups": {
+							Type:         schema.TypeInt,
+							Description:  "Number of seconds between backups",
+							Optional:     true,
+							Default:      3600,
+							ValidateFunc: validation.IntBetween(300, 86400),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getBackupScheduleFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	var dataValue data.DataValue
+	var errs []error
+	if weekly := d.Get("weekly_schedule").([]interface{}); len(weekly) > 0 && weekly[0] != nil {
+		schedule := weekly[0].(map[string]interface{})
+		var daysOfWeek []int64
+		for _, day := range schedule["days_of_week"].(*schema.Set).List() {
+			daysOfWeek = append(daysOfWeek, int64(day.(int)))
+		}
+		hourOfDay := int64(schedule["hour_of_day"].(int))
+		minuteOfDay := int64(schedule["minute_of_day"].(int))
+		obj := nsxModel.WeeklyBackupSchedule{
+			DaysOfWeek:   daysOfWeek,
+			HourOfDay:    &hourOfDay,
+			MinuteOfDay:  &minuteOfDay,
+			ResourceType: nsxModel.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE,
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, nsxModel.WeeklyBackupScheduleBindingType())
+	} else if interval := d.Get("interval_schedule").([]interface{}); len(interval) > 0 {
+		secondsBetweenBackups := int64(3600)
+		if interval[0] != nil {
+			secondsBetweenBackups = int64(interval[0].(map[string]interface{})["seconds_between_backups"].(int))
+		}
+		obj := nsxModel.IntervalBackupSchedule{
+			SecondsBetweenBackups: &secondsBetweenBackups,
+			ResourceType:          nsxModel.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE,
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, nsxModel.IntervalBackupScheduleBindingType())
+	} else {
+		return nil, nil
+	}
+
+	if errs != nil {
+		return nil, fmt.Errorf("Error converting backup schedule: %v", errs[0])
+	}
+	return dataValue.(*data.StructValue), nil
+}
+
+func setBackupScheduleInSchema(d *schema.ResourceData, schedule *data.StructValue) error {
+	converter := bindings.NewTypeConverter()
+
+	var weekly []interface{}
+	var interval []interface{}
+	if schedule != nil {
+		base, errs := converter.ConvertToGolang(schedule, nsxModel.BackupScheduleBindingType())
+		if errs != nil {
+			return fmt.Errorf("Error converting backup schedule: %v", errs[0])
+		}
+		switch base.(nsxModel.BackupSchedule).ResourceType {
+		case nsxModel.BackupSchedule_RESOURCE_TYPE_WEEKLYBACKUPSCHEDULE:
+			obj, errs := converter.ConvertToGolang(schedule, nsxModel.WeeklyBackupScheduleBindingType())
+			if errs != nil {
+				return fmt.Errorf("Error converting weekly backup schedule: %v", errs[0])
+			}
+			weeklySchedule := obj.(nsxModel.WeeklyBackupSchedule)
+			elem := make(map[string]interface{})
+			elem["days_of_week"] = weeklySchedule.DaysOfWeek
+			elem["hour_of_day"] = weeklySchedule.HourOfDay
+			elem["minute_of_day"] = weeklySchedule.MinuteOfDay
+			weekly = append(weekly, elem)
+		case nsxModel.BackupSchedule_RESOURCE_TYPE_INTERVALBACKUPSCHEDULE:
+			obj, errs := converter.ConvertToGolang(schedule, nsxModel.IntervalBackupScheduleBindingType())
+			if errs != nil {
+				return fmt.Errorf("Error converting interval backup schedule: %v", errs[0])
+			}
+			intervalSchedule := obj.(nsxModel.IntervalBackupSchedule)
+			elem := make(map[string]interface{})
+			elem["seconds_between_backups"] = intervalSchedule.SecondsBetweenBackups
+			interval = append(interval, elem)
+		}
+	}
+
+	d.Set("weekly_schedule", weekly)
+	d.Set("interval_schedule", interval)
+	return nil
+}
+
+func getRemoteFileServerFromSchema(d *schema.ResourceData) *nsxModel.RemoteFileServer {
+	servers := d.Get("remote_file_server").([]interface{})
+	if len(servers) == 0 || servers[0] == nil {
+		return nil
+	}
+	server := servers[0].(map[string]interface{})
+
+	address := server["server"].(string)
+	port := int64(server["port"].(int))
+	directoryPath := server["directory_path"].(string)
+	sshFingerprint := server["ssh_fingerprint"].(string)
+	username := server["username"].(string)
+	password := server["password"].(string)
+	protocolName := nsxModel.FileTransferProtocol_PROTOCOL_NAME_SFTP
+	schemeName := nsxModel.FileTransferAuthenticationScheme_SCHEME_NAME_PASSWORD
+	return &nsxModel.RemoteFileServer{
+		Server:        &address,
+		Port:          &port,
+		DirectoryPath: &directoryPath,
+		Protocol: &nsxModel.FileTransferProtocol{
+			ProtocolName:   &protocolName,
+			SshFingerprint: &sshFingerprint,
+			AuthenticationScheme: &nsxModel.FileTransferAuthenticationScheme{
+				SchemeName: &schemeName,
+				Username:   &username,
+				Password:   &password,
+			},
+		},
+	}
+}
+
+func setRemoteFileServerInSchema(d *schema.ResourceData, server *nsxModel.RemoteFileServer) {
+	if server == nil {
+		d.Set("remote_file_server", nil)
+		return
+	}
+
+	elem := make(map[string]interface{})
+	// password is not returned by NSX, and is kept from configuration
+	if servers := d.Get("remote_file_server").([]interface{}); len(servers) > 0 && servers[0] != nil {
+		elem["password"] = servers[0].(map[string]interface{})["password"]
+	}
+	elem["server"] = server.Server
+	elem["port"] = server.Port
+	elem["directory_path"] = server.DirectoryPath
+	if server.Protocol != nil {
+		elem["ssh_fingerprint"] = server.Protocol.SshFingerprint
+		if server.Protocol.AuthenticationScheme != nil {
+			elem["username"] = server.Protocol.AuthenticationScheme.Username
+		}
+	}
+	d.Set("remote_file_server", []interface{}{elem})
+}
+
+func setBackupConfiguration(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	client := backups.NewConfigClient(connector)
+
+	backupEnabled := d.Get("backup_enabled").(bool)
+	inventorySummaryInterval := int64(d.Get("inventory_summary_interval").(int))
+	schedule, err := getBackupScheduleFromSchema(d)
+	if err != nil {
+		return err
+	}
+	obj := nsxModel.BackupConfiguration{
+		BackupEnabled:            &backupEnabled,
+		BackupSchedule:           schedule,
+		InventorySummaryInterval: &inventorySummaryInterval,
+		RemoteFileServer:         getRemoteFileServerFromSchema(d),
+	}
+	if interval := int64(d.Get("after_inventory_update_interval").(int)); interval > 0 {
+		obj.AfterInventoryUpdateInterval = &interval
+	}
+	if passphrase := d.Get("passphrase").(string); passphrase != "" {
+		obj.Passphrase = &passphrase
+	}
+
+	_, err = client.Update(obj, nil, nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to set backup configuration: %v", err)
+		return err
+	}
+	return nil
+}
+
+func resourceNsxtBackupConfigurationCreate(d *schema.ResourceData, m interface{}) error {
+	// Backup configuration is a singleton, ID is only needed for terraform
+	id := d.Id()
+	if id == "" {
+		id = newUUID()
+	}
+	err := setBackupConfiguration(d, m)
+	if err != nil {
+		return handleCreateError("BackupConfiguration", id, err)
+	}
+	d.SetId(id)
+	return resourceNsxtBackupConfigurationRead(d, m)
+}
+
+func resourceNsxtBackupConfigurationRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BackupConfiguration ID")
+	}
+	connector := getPolicyConnector(m)
+	client := backups.NewConfigClient(connector)
+
+	obj, err := client.Get()
+	if err != nil {
+		return handleReadError(d, "BackupConfiguration", id, err)
+	}
+
+	d.Set("backup_enabled", obj.BackupEnabled)
+	d.Set("after_inventory_update_interval", obj.AfterInventoryUpdateInterval)
+	d.Set("inventory_summary_interval", obj.InventorySummaryInterval)
+	setRemoteFileServerInSchema(d, obj.RemoteFileServer)
+
+	return setBackupScheduleInSchema(d, obj.BackupSchedule)
+}
+
+func resourceNsxtBackupConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	err := setBackupConfiguration(d, m)
+	if err != nil {
+		return handleUpdateError("BackupConfiguration", id, err)
+	}
+	return resourceNsxtBackupConfigurationRead(d, m)
+}
+
+func resourceNsxtBackupConfigurationDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining BackupConfiguration ID")
+	}
+	connector := getPolicyConnector(m)
+	client := backups.NewConfigClient(connector)
+
+	// Backup configuration can not be removed, disable automated backup instead
+	backupEnabled := false
+	obj := nsxModel.BackupConfiguration{
+		BackupEnabled: &backupEnabled,
+	}
+	_, err := client.Update(obj, nil, nil)
+	if err != nil {
+		log.Printf("[WARNING] Failed to disable backup: %v", err)
+		return handleDeleteError("BackupConfiguration", id, err)
+	}
+	return nil
+}
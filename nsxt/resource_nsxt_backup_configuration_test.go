@@ -0,0 +1,185 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccResourceNsxtBackupConfiguration_basic(t *testing.T) {
+	testResourceName := "nsxt_backup_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SSH_FINGERPRINT")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtBackupConfigurationTemplate(`
+  weekly_schedule {
+    days_of_week  = [1, 3, 5]
+    hour_of_day   = 2
+    minute_of_day = 30
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "backup_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "inventory_summary_interval", "300"),
+					resource.TestCheckResourceAttr(testResourceName, "remote_file_server.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "remote_file_server.0.directory_path", "/backups"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.days_of_week.#", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.0.hour_of_day", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "0"),
+				),
+			},
+			{
+				Config: testAccNsxtBackupConfigurationTemplate(`
+  interval_schedule {
+    seconds_between_backups = 7200
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "weekly_schedule.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "interval_schedule.0.seconds_between_backups", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtBackupConfiguration_importBasic(t *testing.T) {
+	testResourceName := "nsxt_backup_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SERVER")
+			testAccEnvDefined(t, "NSXT_TEST_BACKUP_SSH_FINGERPRINT")
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtBackupConfigurationTemplate(""),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"passphrase", "remote_file_server.0.password"},
+			},
+		},
+	})
+}
+
+func testAccNsxtBackupConfigurationTemplate(schedule string) string {
+	return fmt.Sprintf(`
+resource "nsxt_backup_configuration" "test" {
+  passphrase                 = "Terraform-backup-1"
+  inventory_summary_interval = 300
+
+  remote_file_server {
+    server          = "%s"
+    directory_path  = "/backups"
+    ssh_fingerprint = "%s"
+    username        = "%s"
+    password        = "%s"
+  }
+%s
+}`, os.Getenv("NSXT_TEST_BACKUP_SERVER"), os.Getenv("NSXT_TEST_BACKUP_SSH_FINGERPRINT"),
+		os.Getenv("NSXT_TEST_BACKUP_USERNAME"), os.Getenv("NSXT_TEST_BACKUP_PASSWORD"), schedule)
+}
+
+func TestBackupConfigurationSimulator(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{{
+		name:     "backup configuration",
+		resource: resourceNsxtBackupConfiguration(),
+		config: map[string]interface{}{
+			"passphrase":                 "Secret-passphrase-1",
+			"inventory_summary_interval": 300,
+			"remote_file_server": []interface{}{
+				map[string]interface{}{
+					"server":          "10.0.0.5",
+					"directory_path":  "/backups",
+					"ssh_fingerprint": "SHA256:abc",
+					"username":        "backup",
+					"password":        "Secret-password-1",
+				},
+			},
+			"weekly_schedule": []interface{}{
+				map[string]interface{}{
+					"days_of_week":  []interface{}{1, 5},
+					"hour_of_day":   2,
+					"minute_of_day": 30,
+				},
+			},
+		},
+		path: "/api/v1/cluster/backups/config",
+		checkCreate: func(t *testing.T, stored map[string]interface{}, d *schema.ResourceData) {
+			if stored["passphrase"] != "Secret-passphrase-1" {
+				t.Errorf("unexpected passphrase %v", stored["passphrase"])
+			}
+			if fmt.Sprint(stored["backup_enabled"]) != "true" {
+				t.Errorf("expected backup to be enabled, got %v", stored["backup_enabled"])
+			}
+			protocol := stored["remote_file_server"].(map[string]interface{})["protocol"].(map[string]interface{})
+			if protocol["protocol_name"] != "sftp" || protocol["ssh_fingerprint"] != "SHA256:abc" {
+				t.Errorf("unexpected protocol %v", protocol)
+			}
+			scheme := protocol["authentication_scheme"].(map[string]interface{})
+			if scheme["scheme_name"] != "PASSWORD" || scheme["password"] != "Secret-password-1" {
+				t.Errorf("unexpected authentication scheme %v", scheme)
+			}
+			schedule := stored["backup_schedule"].(map[string]interface{})
+			if schedule["resource_type"] != "WeeklyBackupSchedule" || fmt.Sprint(schedule["hour_of_day"]) != "2" {
+				t.Errorf("unexpected schedule %v", schedule)
+			}
+
+			// Secrets are not returned by NSX, values from configuration are kept
+			if d.Get("passphrase") != "Secret-passphrase-1" {
+				t.Errorf("passphrase was not kept, got %v", d.Get("passphrase"))
+			}
+			if d.Get("remote_file_server.0.password") != "Secret-password-1" {
+				t.Errorf("password was not kept, got %v", d.Get("remote_file_server.0.password"))
+			}
+			if d.Get("remote_file_server.0.port") != 22 {
+				t.Errorf("unexpected port %v", d.Get("remote_file_server.0.port"))
+			}
+			if d.Get("weekly_schedule.0.days_of_week").(*schema.Set).Len() != 2 {
+				t.Errorf("unexpected days of week %v", d.Get("weekly_schedule.0.days_of_week"))
+			}
+		},
+		update: map[string]interface{}{
+			"weekly_schedule": nil,
+			"interval_schedule": []interface{}{
+				map[string]interface{}{"seconds_between_backups": 7200},
+			},
+		},
+		checkUpdate: func(t *testing.T, stored map[string]interface{}, d *schema.ResourceData) {
+			if len(d.Get("weekly_schedule").([]interface{})) != 0 {
+				t.Errorf("expected weekly schedule to be cleared")
+			}
+			if d.Get("interval_schedule.0.seconds_between_backups") != 7200 {
+				t.Errorf("unexpected interval %v", d.Get("interval_schedule.0.seconds_between_backups"))
+			}
+		},
+		// backup configuration is disabled rather than removed on delete
+		checkDelete: func(t *testing.T, stored map[string]interface{}, found bool) {
+			if fmt.Sprint(stored["backup_enabled"]) != "false" {
+				t.Errorf("expected backup to be disabled, got %v", stored["backup_enabled"])
+			}
+		},
+	}})
+}
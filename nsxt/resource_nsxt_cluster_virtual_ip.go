@@ -26,10 +26,10 @@ var DefaultIPv6VirtualAddress = "::"
 
 func resourceNsxtClusterVirualIP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtClusterVirualIPCreate,
-		Read:   resourceNsxtClusterVirualIPRead,
-		Update: resourceNsxtClusterVirualIPUpdate,
-		Delete: resourceNsxtClusterVirualIPDelete,
+		CreateContext: withContext(resourceNsxtClusterVirualIPCreate),
+		ReadContext:   withContext(resourceNsxtClusterVirualIPRead),
+		UpdateContext: withContext(resourceNsxtClusterVirualIPUpdate),
+		DeleteContext: withContext(resourceNsxtClusterVirualIPDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -23,10 +23,10 @@ var accessLevelForOidcValues = []string{
 
 func resourceNsxtComputeManager() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtComputeManagerCreate,
-		Read:   resourceNsxtComputeManagerRead,
-		Update: resourceNsxtComputeManagerUpdate,
-		Delete: resourceNsxtComputeManagerDelete,
+		CreateContext: withContext(resourceNsxtComputeManagerCreate),
+		ReadContext:   withContext(resourceNsxtComputeManagerRead),
+		UpdateContext: withContext(resourceNsxtComputeManagerUpdate),
+		DeleteContext: withContext(resourceNsxtComputeManagerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
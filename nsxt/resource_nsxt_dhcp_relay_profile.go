@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtDhcpRelayProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpRelayProfileCreate,
-		Read:   resourceNsxtDhcpRelayProfileRead,
-		Update: resourceNsxtDhcpRelayProfileUpdate,
-		Delete: resourceNsxtDhcpRelayProfileDelete,
+		CreateContext: withContext(resourceNsxtDhcpRelayProfileCreate),
+		ReadContext:   withContext(resourceNsxtDhcpRelayProfileRead),
+		UpdateContext: withContext(resourceNsxtDhcpRelayProfileUpdate),
+		DeleteContext: withContext(resourceNsxtDhcpRelayProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtDhcpRelayService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpRelayServiceCreate,
-		Read:   resourceNsxtDhcpRelayServiceRead,
-		Update: resourceNsxtDhcpRelayServiceUpdate,
-		Delete: resourceNsxtDhcpRelayServiceDelete,
+		CreateContext: withContext(resourceNsxtDhcpRelayServiceCreate),
+		ReadContext:   withContext(resourceNsxtDhcpRelayServiceRead),
+		UpdateContext: withContext(resourceNsxtDhcpRelayServiceUpdate),
+		DeleteContext: withContext(resourceNsxtDhcpRelayServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtDhcpServerIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpServerIPPoolCreate,
-		Read:   resourceNsxtDhcpServerIPPoolRead,
-		Update: resourceNsxtDhcpServerIPPoolUpdate,
-		Delete: resourceNsxtDhcpServerIPPoolDelete,
+		CreateContext: withContext(resourceNsxtDhcpServerIPPoolCreate),
+		ReadContext:   withContext(resourceNsxtDhcpServerIPPoolRead),
+		UpdateContext: withContext(resourceNsxtDhcpServerIPPoolUpdate),
+		DeleteContext: withContext(resourceNsxtDhcpServerIPPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtDhcpServerIPPoolImport,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtDhcpServerProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtDhcpServerProfileCreate,
-		Read:   resourceNsxtDhcpServerProfileRead,
-		Update: resourceNsxtDhcpServerProfileUpdate,
-		Delete: resourceNsxtDhcpServerProfileDelete,
+		CreateContext: withContext(resourceNsxtDhcpServerProfileCreate),
+		ReadContext:   withContext(resourceNsxtDhcpServerProfileRead),
+		UpdateContext: withContext(resourceNsxtDhcpServerProfileUpdate),
+		DeleteContext: withContext(resourceNsxtDhcpServerProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -22,10 +22,10 @@ var failureDomainAllocationOptions = []string{
 
 func resourceNsxtEdgeCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeClusterCreate,
-		Read:   resourceNsxtEdgeClusterRead,
-		Update: resourceNsxtEdgeClusterUpdate,
-		Delete: resourceNsxtEdgeClusterDelete,
+		CreateContext: withContext(resourceNsxtEdgeClusterCreate),
+		ReadContext:   withContext(resourceNsxtEdgeClusterRead),
+		UpdateContext: withContext(resourceNsxtEdgeClusterUpdate),
+		DeleteContext: withContext(resourceNsxtEdgeClusterDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
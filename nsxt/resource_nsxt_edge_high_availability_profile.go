@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtEdgeHighAvailabilityProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeHighAvailabilityProfileCreate,
-		Read:   resourceNsxtEdgeHighAvailabilityProfileRead,
-		Update: resourceNsxtEdgeHighAvailabilityProfileUpdate,
-		Delete: resourceNsxtEdgeHighAvailabilityProfileDelete,
+		CreateContext: withContext(resourceNsxtEdgeHighAvailabilityProfileCreate),
+		ReadContext:   withContext(resourceNsxtEdgeHighAvailabilityProfileRead),
+		UpdateContext: withContext(resourceNsxtEdgeHighAvailabilityProfileUpdate),
+		DeleteContext: withContext(resourceNsxtEdgeHighAvailabilityProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -74,13 +74,16 @@ var syslogProtocolValues = []string{
 
 func resourceNsxtEdgeTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeTransportNodeCreate,
-		Read:   resourceNsxtEdgeTransportNodeRead,
-		Update: resourceNsxtEdgeTransportNodeUpdate,
-		Delete: resourceNsxtEdgeTransportNodeDelete,
+		CreateContext: withContext(resourceNsxtEdgeTransportNodeCreate),
+		ReadContext:   withContext(resourceNsxtEdgeTransportNodeRead),
+		UpdateContext: withContext(resourceNsxtEdgeTransportNodeUpdate),
+		DeleteContext: withContext(resourceNsxtEdgeTransportNodeDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"revision":     getRevisionSchema(),
 			"description":  getDescriptionSchema(),
@@ -1532,7 +1535,7 @@ func resourceNsxtEdgeTransportNodeUpdate(d *schema.ResourceData, m interface{})
 	return resourceNsxtEdgeTransportNodeRead(d, m)
 }
 
-func getTransportNodeStateConf(connector client.Connector, id string) *resource.StateChangeConf {
+func getTransportNodeStateConf(connector client.Connector, id string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -1553,7 +1556,7 @@ func getTransportNodeStateConf(connector client.Connector, id string) *resource.
 			return "notyet", "notyet", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
@@ -1573,8 +1576,8 @@ func resourceNsxtEdgeTransportNodeDelete(d *schema.ResourceData, m interface{})
 		return handleDeleteError("TransportNode", id, err)
 	}
 
-	stateConf := getTransportNodeStateConf(connector, id)
-	_, err = stateConf.WaitForState()
+	stateConf := getTransportNodeStateConf(connector, id, d.Timeout(schema.TimeoutDelete))
+	_, err = stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("failed to get deletion status for %s: %v", id, err)
 	}
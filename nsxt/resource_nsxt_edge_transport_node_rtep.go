@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtEdgeTransportNodeRTEP() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEdgeTransportNodeRTEPCreate,
-		Read:   resourceNsxtEdgeTransportNodeRTEPRead,
-		Update: resourceNsxtEdgeTransportNodeRTEPUpdate,
-		Delete: resourceNsxtEdgeTransportNodeRTEPDelete,
+		CreateContext: withContext(resourceNsxtEdgeTransportNodeRTEPCreate),
+		ReadContext:   withContext(resourceNsxtEdgeTransportNodeRTEPRead),
+		UpdateContext: withContext(resourceNsxtEdgeTransportNodeRTEPUpdate),
+		DeleteContext: withContext(resourceNsxtEdgeTransportNodeRTEPDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtEtherTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtEtherTypeNsServiceCreate,
-		Read:   resourceNsxtEtherTypeNsServiceRead,
-		Update: resourceNsxtEtherTypeNsServiceUpdate,
-		Delete: resourceNsxtEtherTypeNsServiceDelete,
+		CreateContext: withContext(resourceNsxtEtherTypeNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtEtherTypeNsServiceRead),
+		UpdateContext: withContext(resourceNsxtEtherTypeNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtEtherTypeNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
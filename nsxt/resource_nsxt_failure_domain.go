@@ -21,10 +21,10 @@ var edgeServices = []string{
 
 func resourceNsxtFailureDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtFailureDomainCreate,
-		Read:   resourceNsxtFailureDomainRead,
-		Update: resourceNsxtFailureDomainUpdate,
-		Delete: resourceNsxtFailureDomainDelete,
+		CreateContext: withContext(resourceNsxtFailureDomainCreate),
+		ReadContext:   withContext(resourceNsxtFailureDomainRead),
+		UpdateContext: withContext(resourceNsxtFailureDomainUpdate),
+		DeleteContext: withContext(resourceNsxtFailureDomainDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
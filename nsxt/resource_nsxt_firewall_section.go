@@ -22,10 +22,10 @@ var firewallSectionTypeValues = []string{"LAYER2", "LAYER3"}
 
 func resourceNsxtFirewallSection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtFirewallSectionCreate,
-		Read:   resourceNsxtFirewallSectionRead,
-		Update: resourceNsxtFirewallSectionUpdate,
-		Delete: resourceNsxtFirewallSectionDelete,
+		CreateContext: withContext(resourceNsxtFirewallSectionCreate),
+		ReadContext:   withContext(resourceNsxtFirewallSectionRead),
+		UpdateContext: withContext(resourceNsxtFirewallSectionUpdate),
+		DeleteContext: withContext(resourceNsxtFirewallSectionDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
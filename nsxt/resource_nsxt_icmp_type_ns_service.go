@@ -17,10 +17,10 @@ var icmpProtocolValues = []string{"ICMPv4", "ICMPv6"}
 
 func resourceNsxtIcmpTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIcmpTypeNsServiceCreate,
-		Read:   resourceNsxtIcmpTypeNsServiceRead,
-		Update: resourceNsxtIcmpTypeNsServiceUpdate,
-		Delete: resourceNsxtIcmpTypeNsServiceDelete,
+		CreateContext: withContext(resourceNsxtIcmpTypeNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtIcmpTypeNsServiceRead),
+		UpdateContext: withContext(resourceNsxtIcmpTypeNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtIcmpTypeNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtIgmpTypeNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIgmpTypeNsServiceCreate,
-		Read:   resourceNsxtIgmpTypeNsServiceRead,
-		Update: resourceNsxtIgmpTypeNsServiceUpdate,
-		Delete: resourceNsxtIgmpTypeNsServiceDelete,
+		CreateContext: withContext(resourceNsxtIgmpTypeNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtIgmpTypeNsServiceRead),
+		UpdateContext: withContext(resourceNsxtIgmpTypeNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtIgmpTypeNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
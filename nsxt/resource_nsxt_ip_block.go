@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPBlockCreate,
-		Read:   resourceNsxtIPBlockRead,
-		Update: resourceNsxtIPBlockUpdate,
-		Delete: resourceNsxtIPBlockDelete,
+		CreateContext: withContext(resourceNsxtIPBlockCreate),
+		ReadContext:   withContext(resourceNsxtIPBlockRead),
+		UpdateContext: withContext(resourceNsxtIPBlockUpdate),
+		DeleteContext: withContext(resourceNsxtIPBlockDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
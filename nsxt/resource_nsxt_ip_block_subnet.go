@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtIPBlockSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPBlockSubnetCreate,
-		Read:   resourceNsxtIPBlockSubnetRead,
+		CreateContext: withContext(resourceNsxtIPBlockSubnetCreate),
+		ReadContext:   withContext(resourceNsxtIPBlockSubnetRead),
 		// Update IP block subnet is not supported by the NSX
-		Delete: resourceNsxtIPBlockSubnetDelete,
+		DeleteContext: withContext(resourceNsxtIPBlockSubnetDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
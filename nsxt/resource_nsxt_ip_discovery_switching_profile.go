@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtIPDiscoverySwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPDiscoverySwitchingProfileCreate,
-		Read:   resourceNsxtIPDiscoverySwitchingProfileRead,
-		Update: resourceNsxtIPDiscoverySwitchingProfileUpdate,
-		Delete: resourceNsxtIPDiscoverySwitchingProfileDelete,
+		CreateContext: withContext(resourceNsxtIPDiscoverySwitchingProfileCreate),
+		ReadContext:   withContext(resourceNsxtIPDiscoverySwitchingProfileRead),
+		UpdateContext: withContext(resourceNsxtIPDiscoverySwitchingProfileUpdate),
+		DeleteContext: withContext(resourceNsxtIPDiscoverySwitchingProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
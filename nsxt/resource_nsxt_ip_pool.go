@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPPoolCreate,
-		Read:   resourceNsxtIPPoolRead,
-		Update: resourceNsxtIPPoolUpdate,
-		Delete: resourceNsxtIPPoolDelete,
+		CreateContext: withContext(resourceNsxtIPPoolCreate),
+		ReadContext:   withContext(resourceNsxtIPPoolRead),
+		UpdateContext: withContext(resourceNsxtIPPoolUpdate),
+		DeleteContext: withContext(resourceNsxtIPPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
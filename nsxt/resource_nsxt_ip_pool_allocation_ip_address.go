@@ -15,9 +15,9 @@ import (
 
 func resourceNsxtIPPoolAllocationIPAddress() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPPoolAllocationIPAddressCreate,
-		Read:   resourceNsxtIPPoolAllocationIPAddressRead,
-		Delete: resourceNsxtIPPoolAllocationIPAddressDelete,
+		CreateContext: withContext(resourceNsxtIPPoolAllocationIPAddressCreate),
+		ReadContext:   withContext(resourceNsxtIPPoolAllocationIPAddressRead),
+		DeleteContext: withContext(resourceNsxtIPPoolAllocationIPAddressDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtIPPoolAllocationIPAddressImport,
 		},
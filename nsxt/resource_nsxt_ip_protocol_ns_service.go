@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtIPProtocolNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPProtocolNsServiceCreate,
-		Read:   resourceNsxtIPProtocolNsServiceRead,
-		Update: resourceNsxtIPProtocolNsServiceUpdate,
-		Delete: resourceNsxtIPProtocolNsServiceDelete,
+		CreateContext: withContext(resourceNsxtIPProtocolNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtIPProtocolNsServiceRead),
+		UpdateContext: withContext(resourceNsxtIPProtocolNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtIPProtocolNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtIPSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtIPSetCreate,
-		Read:   resourceNsxtIPSetRead,
-		Update: resourceNsxtIPSetUpdate,
-		Delete: resourceNsxtIPSetDelete,
+		CreateContext: withContext(resourceNsxtIPSetCreate),
+		ReadContext:   withContext(resourceNsxtIPSetRead),
+		UpdateContext: withContext(resourceNsxtIPSetUpdate),
+		DeleteContext: withContext(resourceNsxtIPSetDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -17,10 +17,10 @@ var protocolValues = []string{"TCP", "UDP"}
 
 func resourceNsxtL4PortSetNsService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtL4PortSetNsServiceCreate,
-		Read:   resourceNsxtL4PortSetNsServiceRead,
-		Update: resourceNsxtL4PortSetNsServiceUpdate,
-		Delete: resourceNsxtL4PortSetNsServiceDelete,
+		CreateContext: withContext(resourceNsxtL4PortSetNsServiceCreate),
+		ReadContext:   withContext(resourceNsxtL4PortSetNsServiceRead),
+		UpdateContext: withContext(resourceNsxtL4PortSetNsServiceUpdate),
+		DeleteContext: withContext(resourceNsxtL4PortSetNsServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbClientSslProfileCreate,
-		Read:   resourceNsxtLbClientSslProfileRead,
-		Update: resourceNsxtLbClientSslProfileUpdate,
-		Delete: resourceNsxtLbClientSslProfileDelete,
+		CreateContext: withContext(resourceNsxtLbClientSslProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbClientSslProfileRead),
+		UpdateContext: withContext(resourceNsxtLbClientSslProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbClientSslProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
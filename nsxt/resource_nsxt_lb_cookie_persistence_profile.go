@@ -18,10 +18,10 @@ var cookieExpiryTypes = []string{"SESSION_COOKIE_TIME", "PERSISTENCE_COOKIE_TIME
 
 func resourceNsxtLbCookiePersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbCookiePersistenceProfileCreate,
-		Read:   resourceNsxtLbCookiePersistenceProfileRead,
-		Update: resourceNsxtLbCookiePersistenceProfileUpdate,
-		Delete: resourceNsxtLbCookiePersistenceProfileDelete,
+		CreateContext: withContext(resourceNsxtLbCookiePersistenceProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbCookiePersistenceProfileRead),
+		UpdateContext: withContext(resourceNsxtLbCookiePersistenceProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbCookiePersistenceProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
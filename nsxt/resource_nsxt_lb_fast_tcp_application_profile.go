@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbFastTCPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbFastTCPApplicationProfileCreate,
-		Read:   resourceNsxtLbFastTCPApplicationProfileRead,
-		Update: resourceNsxtLbFastTCPApplicationProfileUpdate,
-		Delete: resourceNsxtLbFastTCPApplicationProfileDelete,
+		CreateContext: withContext(resourceNsxtLbFastTCPApplicationProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbFastTCPApplicationProfileRead),
+		UpdateContext: withContext(resourceNsxtLbFastTCPApplicationProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbFastTCPApplicationProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
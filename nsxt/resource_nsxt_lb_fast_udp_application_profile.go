@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbFastUDPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbFastUDPApplicationProfileCreate,
-		Read:   resourceNsxtLbFastUDPApplicationProfileRead,
-		Update: resourceNsxtLbFastUDPApplicationProfileUpdate,
-		Delete: resourceNsxtLbFastUDPApplicationProfileDelete,
+		CreateContext: withContext(resourceNsxtLbFastUDPApplicationProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbFastUDPApplicationProfileRead),
+		UpdateContext: withContext(resourceNsxtLbFastUDPApplicationProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbFastUDPApplicationProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
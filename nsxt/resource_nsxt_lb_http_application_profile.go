@@ -17,10 +17,10 @@ var xForwardedValues = []string{"INSERT", "REPLACE"}
 
 func resourceNsxtLbHTTPApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPApplicationProfileCreate,
-		Read:   resourceNsxtLbHTTPApplicationProfileRead,
-		Update: resourceNsxtLbHTTPApplicationProfileUpdate,
-		Delete: resourceNsxtLbHTTPApplicationProfileDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPApplicationProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPApplicationProfileRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPApplicationProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbHTTPApplicationProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbHTTPForwardingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPForwardingRuleCreate,
-		Read:   resourceNsxtLbHTTPForwardingRuleRead,
-		Update: resourceNsxtLbHTTPForwardingRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPForwardingRuleCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPForwardingRuleRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPForwardingRuleUpdate),
+		DeleteContext: withContext(resourceNsxtLbHTTPRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
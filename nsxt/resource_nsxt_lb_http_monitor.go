@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbHTTPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPMonitorCreate,
-		Read:   resourceNsxtLbHTTPMonitorRead,
-		Update: resourceNsxtLbHTTPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
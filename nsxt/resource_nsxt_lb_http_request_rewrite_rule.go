@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbHTTPRequestRewriteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPRequestRewriteRuleCreate,
-		Read:   resourceNsxtLbHTTPRequestRewriteRuleRead,
-		Update: resourceNsxtLbHTTPRequestRewriteRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPRequestRewriteRuleCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPRequestRewriteRuleRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPRequestRewriteRuleUpdate),
+		DeleteContext: withContext(resourceNsxtLbHTTPRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbHTTPResponseRewriteRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPResponseRewriteRuleCreate,
-		Read:   resourceNsxtLbHTTPResponseRewriteRuleRead,
-		Update: resourceNsxtLbHTTPResponseRewriteRuleUpdate,
-		Delete: resourceNsxtLbHTTPRuleDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPResponseRewriteRuleCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPResponseRewriteRuleRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPResponseRewriteRuleUpdate),
+		DeleteContext: withContext(resourceNsxtLbHTTPRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbHTTPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPVirtualServerCreate,
-		Read:   resourceNsxtLbHTTPVirtualServerRead,
-		Update: resourceNsxtLbHTTPVirtualServerUpdate,
-		Delete: resourceNsxtLbHTTPVirtualServerDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPVirtualServerCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPVirtualServerRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPVirtualServerUpdate),
+		DeleteContext: withContext(resourceNsxtLbHTTPVirtualServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbHTTPSMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbHTTPSMonitorCreate,
-		Read:   resourceNsxtLbHTTPSMonitorRead,
-		Update: resourceNsxtLbHTTPSMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbHTTPSMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbHTTPSMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbHTTPSMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
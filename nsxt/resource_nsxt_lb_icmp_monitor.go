@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLbIcmpMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbIcmpMonitorCreate,
-		Read:   resourceNsxtLbIcmpMonitorRead,
-		Update: resourceNsxtLbIcmpMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbIcmpMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbIcmpMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbIcmpMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbPassiveMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbPassiveMonitorCreate,
-		Read:   resourceNsxtLbPassiveMonitorRead,
-		Update: resourceNsxtLbPassiveMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbPassiveMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbPassiveMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbPassiveMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
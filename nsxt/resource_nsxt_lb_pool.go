@@ -21,10 +21,10 @@ var ipRevisionFilterTypeValues = []string{"IPV4", "IPV6"}
 
 func resourceNsxtLbPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbPoolCreate,
-		Read:   resourceNsxtLbPoolRead,
-		Update: resourceNsxtLbPoolUpdate,
-		Delete: resourceNsxtLbPoolDelete,
+		CreateContext: withContext(resourceNsxtLbPoolCreate),
+		ReadContext:   withContext(resourceNsxtLbPoolRead),
+		UpdateContext: withContext(resourceNsxtLbPoolUpdate),
+		DeleteContext: withContext(resourceNsxtLbPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbServerSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbServerSslProfileCreate,
-		Read:   resourceNsxtLbServerSslProfileRead,
-		Update: resourceNsxtLbServerSslProfileUpdate,
-		Delete: resourceNsxtLbServerSslProfileDelete,
+		CreateContext: withContext(resourceNsxtLbServerSslProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbServerSslProfileRead),
+		UpdateContext: withContext(resourceNsxtLbServerSslProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbServerSslProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
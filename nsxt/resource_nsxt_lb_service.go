@@ -18,10 +18,10 @@ var lbServiceSizes = []string{"SMALL", "MEDIUM", "LARGE"}
 
 func resourceNsxtLbService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbServiceCreate,
-		Read:   resourceNsxtLbServiceRead,
-		Update: resourceNsxtLbServiceUpdate,
-		Delete: resourceNsxtLbServiceDelete,
+		CreateContext: withContext(resourceNsxtLbServiceCreate),
+		ReadContext:   withContext(resourceNsxtLbServiceRead),
+		UpdateContext: withContext(resourceNsxtLbServiceUpdate),
+		DeleteContext: withContext(resourceNsxtLbServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbSourceIPPersistenceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbSourceIPPersistenceProfileCreate,
-		Read:   resourceNsxtLbSourceIPPersistenceProfileRead,
-		Update: resourceNsxtLbSourceIPPersistenceProfileUpdate,
-		Delete: resourceNsxtLbSourceIPPersistenceProfileDelete,
+		CreateContext: withContext(resourceNsxtLbSourceIPPersistenceProfileCreate),
+		ReadContext:   withContext(resourceNsxtLbSourceIPPersistenceProfileRead),
+		UpdateContext: withContext(resourceNsxtLbSourceIPPersistenceProfileUpdate),
+		DeleteContext: withContext(resourceNsxtLbSourceIPPersistenceProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
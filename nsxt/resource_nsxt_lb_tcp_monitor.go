@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbTCPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbTCPMonitorCreate,
-		Read:   resourceNsxtLbTCPMonitorRead,
-		Update: resourceNsxtLbTCPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbTCPMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbTCPMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbTCPMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
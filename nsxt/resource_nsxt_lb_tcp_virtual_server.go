@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbTCPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbTCPVirtualServerCreate,
-		Read:   resourceNsxtLbTCPVirtualServerRead,
-		Update: resourceNsxtLbTCPVirtualServerUpdate,
-		Delete: resourceNsxtLbTCPVirtualServerDelete,
+		CreateContext: withContext(resourceNsxtLbTCPVirtualServerCreate),
+		ReadContext:   withContext(resourceNsxtLbTCPVirtualServerRead),
+		UpdateContext: withContext(resourceNsxtLbTCPVirtualServerUpdate),
+		DeleteContext: withContext(resourceNsxtLbTCPVirtualServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
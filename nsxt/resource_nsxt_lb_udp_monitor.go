@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbUDPMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbUDPMonitorCreate,
-		Read:   resourceNsxtLbUDPMonitorRead,
-		Update: resourceNsxtLbUDPMonitorUpdate,
-		Delete: resourceNsxtLbMonitorDelete,
+		CreateContext: withContext(resourceNsxtLbUDPMonitorCreate),
+		ReadContext:   withContext(resourceNsxtLbUDPMonitorRead),
+		UpdateContext: withContext(resourceNsxtLbUDPMonitorUpdate),
+		DeleteContext: withContext(resourceNsxtLbMonitorDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLbUDPVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLbUDPVirtualServerCreate,
-		Read:   resourceNsxtLbUDPVirtualServerRead,
-		Update: resourceNsxtLbUDPVirtualServerUpdate,
-		Delete: resourceNsxtLbUDPVirtualServerDelete,
+		CreateContext: withContext(resourceNsxtLbUDPVirtualServerCreate),
+		ReadContext:   withContext(resourceNsxtLbUDPVirtualServerRead),
+		UpdateContext: withContext(resourceNsxtLbUDPVirtualServerUpdate),
+		DeleteContext: withContext(resourceNsxtLbUDPVirtualServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -16,10 +16,10 @@ var dhcpType = "DHCP_SERVICE"
 
 func resourceNsxtLogicalDhcpPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalDhcpPortCreate,
-		Read:   resourceNsxtLogicalDhcpPortRead,
-		Update: resourceNsxtLogicalDhcpPortUpdate,
-		Delete: resourceNsxtLogicalDhcpPortDelete,
+		CreateContext: withContext(resourceNsxtLogicalDhcpPortCreate),
+		ReadContext:   withContext(resourceNsxtLogicalDhcpPortRead),
+		UpdateContext: withContext(resourceNsxtLogicalDhcpPortUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalDhcpPortDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLogicalDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalDhcpServerCreate,
-		Read:   resourceNsxtLogicalDhcpServerRead,
-		Update: resourceNsxtLogicalDhcpServerUpdate,
-		Delete: resourceNsxtLogicalDhcpServerDelete,
+		CreateContext: withContext(resourceNsxtLogicalDhcpServerCreate),
+		ReadContext:   withContext(resourceNsxtLogicalDhcpServerRead),
+		UpdateContext: withContext(resourceNsxtLogicalDhcpServerUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalDhcpServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
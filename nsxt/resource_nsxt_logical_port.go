@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLogicalPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalPortCreate,
-		Read:   resourceNsxtLogicalPortRead,
-		Update: resourceNsxtLogicalPortUpdate,
-		Delete: resourceNsxtLogicalPortDelete,
+		CreateContext: withContext(resourceNsxtLogicalPortCreate),
+		ReadContext:   withContext(resourceNsxtLogicalPortRead),
+		UpdateContext: withContext(resourceNsxtLogicalPortUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalPortDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtLogicalRouterCentralizedServicePort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterCentralizedServicePortCreate,
-		Read:   resourceNsxtLogicalRouterCentralizedServicePortRead,
-		Update: resourceNsxtLogicalRouterCentralizedServicePortUpdate,
-		Delete: resourceNsxtLogicalRouterCentralizedServicePortDelete,
+		CreateContext: withContext(resourceNsxtLogicalRouterCentralizedServicePortCreate),
+		ReadContext:   withContext(resourceNsxtLogicalRouterCentralizedServicePortRead),
+		UpdateContext: withContext(resourceNsxtLogicalRouterCentralizedServicePortUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalRouterCentralizedServicePortDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -19,10 +19,10 @@ var logicalRouterPortUrpfModeValues = []string{"NONE", "STRICT"}
 
 func resourceNsxtLogicalRouterDownLinkPort() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterDownLinkPortCreate,
-		Read:   resourceNsxtLogicalRouterDownLinkPortRead,
-		Update: resourceNsxtLogicalRouterDownLinkPortUpdate,
-		Delete: resourceNsxtLogicalRouterDownLinkPortDelete,
+		CreateContext: withContext(resourceNsxtLogicalRouterDownLinkPortCreate),
+		ReadContext:   withContext(resourceNsxtLogicalRouterDownLinkPortRead),
+		UpdateContext: withContext(resourceNsxtLogicalRouterDownLinkPortUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalRouterDownLinkPortDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLogicalRouterLinkPortOnTier0() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterLinkPortOnTier0Create,
-		Read:   resourceNsxtLogicalRouterLinkPortOnTier0Read,
-		Update: resourceNsxtLogicalRouterLinkPortOnTier0Update,
-		Delete: resourceNsxtLogicalRouterLinkPortOnTier0Delete,
+		CreateContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier0Create),
+		ReadContext:   withContext(resourceNsxtLogicalRouterLinkPortOnTier0Read),
+		UpdateContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier0Update),
+		DeleteContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier0Delete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtLogicalRouterLinkPortOnTier1() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalRouterLinkPortOnTier1Create,
-		Read:   resourceNsxtLogicalRouterLinkPortOnTier1Read,
-		Update: resourceNsxtLogicalRouterLinkPortOnTier1Update,
-		Delete: resourceNsxtLogicalRouterLinkPortOnTier1Delete,
+		CreateContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier1Create),
+		ReadContext:   withContext(resourceNsxtLogicalRouterLinkPortOnTier1Read),
+		UpdateContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier1Update),
+		DeleteContext: withContext(resourceNsxtLogicalRouterLinkPortOnTier1Delete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
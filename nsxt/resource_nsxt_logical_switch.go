@@ -34,13 +34,17 @@ and try again.
 // Vlan logical switch is represented with separate resource
 func resourceNsxtLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalSwitchCreate,
-		Read:   resourceNsxtLogicalSwitchRead,
-		Update: resourceNsxtLogicalSwitchUpdate,
-		Delete: resourceNsxtLogicalSwitchDelete,
+		CreateContext: withContext(resourceNsxtLogicalSwitchCreate),
+		ReadContext:   withContext(resourceNsxtLogicalSwitchRead),
+		UpdateContext: withContext(resourceNsxtLogicalSwitchUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalSwitchDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -147,7 +151,8 @@ func resourceNsxtLogicalSwitchCreate(d *schema.ResourceData, m interface{}) erro
 	return resourceNsxtLogicalSwitchRead(d, m)
 }
 
-func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch, toleratePartialSuccess bool) error {
+func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, m interface{}, nsxClient *api.APIClient, logicalSwitch *manager.LogicalSwitch) error {
+	toleratePartialSuccess := getCommonProviderConfig(m).ToleratePartialSuccess
 	// verifying switch realization on hypervisor
 	pendingStates := []string{"in_progress", "pending"}
 	targetStates := []string{"success"}
@@ -156,6 +161,7 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 	} else {
 		pendingStates = append(pendingStates, "partial_success")
 	}
+	realizationState := ""
 	stateConf := &resource.StateChangeConf{
 		Pending: pendingStates,
 		Target:  targetStates,
@@ -174,13 +180,14 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 			}
 
 			log.Printf("[DEBUG] Realization state: %s", state.State)
+			realizationState = state.State
 			return logicalSwitch, state.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(getCreateOrReadTimeoutKey(d)),
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		// Realization failed - rollback & delete the switch
 		log.Printf("[ERROR] Rollback switch %s creation due to unrealized state", logicalSwitch.Id)
@@ -193,6 +200,11 @@ func resourceNsxtLogicalSwitchVerifyRealization(d *schema.ResourceData, nsxClien
 		return err
 	}
 
+	if realizationState == "partial_success" {
+		addProviderWarning(m, "Logical switch is partially realized",
+			"Logical switch %s was not realized on all transport nodes", logicalSwitch.Id)
+	}
+
 	return nil
 }
 
@@ -217,8 +229,7 @@ func resourceNsxtLogicalSwitchRead(d *schema.ResourceData, m interface{}) error
 		return fmt.Errorf("Error during LogicalSwitch read: %v", err)
 	}
 
-	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(d, m, nsxClient, &logicalSwitch)
 
 	if err != nil {
 		return err
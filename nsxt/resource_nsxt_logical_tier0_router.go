@@ -18,10 +18,10 @@ var highAvailabilityValues = []string{"ACTIVE_ACTIVE", "ACTIVE_STANDBY"}
 // TODO: add advanced config
 func resourceNsxtLogicalTier0Router() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalTier0RouterCreate,
-		Read:   resourceNsxtLogicalTier0RouterRead,
-		Update: resourceNsxtLogicalTier0RouterUpdate,
-		Delete: resourceNsxtLogicalTier0RouterDelete,
+		CreateContext: withContext(resourceNsxtLogicalTier0RouterCreate),
+		ReadContext:   withContext(resourceNsxtLogicalTier0RouterRead),
+		UpdateContext: withContext(resourceNsxtLogicalTier0RouterUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalTier0RouterDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
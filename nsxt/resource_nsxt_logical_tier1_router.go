@@ -32,10 +32,10 @@ and try again.
 // TODO: add advanced config
 func resourceNsxtLogicalTier1Router() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtLogicalTier1RouterCreate,
-		Read:   resourceNsxtLogicalTier1RouterRead,
-		Update: resourceNsxtLogicalTier1RouterUpdate,
-		Delete: resourceNsxtLogicalTier1RouterDelete,
+		CreateContext: withContext(resourceNsxtLogicalTier1RouterCreate),
+		ReadContext:   withContext(resourceNsxtLogicalTier1RouterRead),
+		UpdateContext: withContext(resourceNsxtLogicalTier1RouterUpdate),
+		DeleteContext: withContext(resourceNsxtLogicalTier1RouterDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
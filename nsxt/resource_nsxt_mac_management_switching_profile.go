@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtMacManagementSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtMacManagementSwitchingProfileCreate,
-		Read:   resourceNsxtMacManagementSwitchingProfileRead,
-		Update: resourceNsxtMacManagementSwitchingProfileUpdate,
-		Delete: resourceNsxtMacManagementSwitchingProfileDelete,
+		CreateContext: withContext(resourceNsxtMacManagementSwitchingProfileCreate),
+		ReadContext:   withContext(resourceNsxtMacManagementSwitchingProfileRead),
+		UpdateContext: withContext(resourceNsxtMacManagementSwitchingProfileUpdate),
+		DeleteContext: withContext(resourceNsxtMacManagementSwitchingProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -6,7 +6,6 @@ package nsxt
 import (
 	"fmt"
 	"log"
-	"math/rand"
 	"net"
 	"strings"
 	"time"
@@ -25,13 +24,17 @@ import (
 const nodeConnectivityInitialDelay int = 20
 const nodeConnectivityInterval int = 16
 const nodeConnectivityTimeout int = 1800
+const defaultManagerClusterTimeout = time.Duration(nodeConnectivityTimeout) * time.Second
 
 func resourceNsxtManagerCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtManagerClusterCreate,
-		Read:   resourceNsxtManagerClusterRead,
-		Update: resourceNsxtManagerClusterUpdate,
-		Delete: resourceNsxtManagerClusterDelete,
+		CreateContext: withContext(resourceNsxtManagerClusterCreate),
+		ReadContext:   withContext(resourceNsxtManagerClusterRead),
+		UpdateContext: withContext(resourceNsxtManagerClusterUpdate),
+		DeleteContext: withContext(resourceNsxtManagerClusterDelete),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultManagerClusterTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -123,7 +126,7 @@ type NsxClusterNode struct {
 	Status    string
 }
 
-func getNodeConnectivityStateConf(connector client.Connector, delay int, interval int, timeout int) *resource.StateChangeConf {
+func getNodeConnectivityStateConf(connector client.Connector, delay int, interval int, timeout time.Duration) *resource.StateChangeConf {
 
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
@@ -142,7 +145,7 @@ func getNodeConnectivityStateConf(connector client.Connector, delay int, interva
 			return resp, "success", nil
 		},
 		Delay:        time.Duration(delay) * time.Second,
-		Timeout:      time.Duration(timeout) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(interval) * time.Second,
 	}
 }
@@ -151,7 +154,7 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 
 	delay := nodeConnectivityInitialDelay
 	interval := nodeConnectivityInterval
-	timeout := nodeConnectivityTimeout
+	timeout := getWaitTimeout(d, schema.TimeoutCreate, "api_probing.0.timeout", nodeConnectivityTimeout)
 	probingEnabled := true
 	probing := d.Get("api_probing").([]interface{})
 	for _, item := range probing {
@@ -159,7 +162,6 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 		probingEnabled = entry["enabled"].(bool)
 		delay = entry["delay"].(int)
 		interval = entry["interval"].(int)
-		timeout = entry["timeout"].(int)
 		break
 	}
 
@@ -170,7 +172,7 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 	}
 	connector := getStandalonePolicyConnector(m, false)
 	stateConf := getNodeConnectivityStateConf(connector, delay, interval, timeout)
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to connect to main NSX manager endpoint")
 	}
@@ -184,7 +186,7 @@ func waitForNodeStatus(d *schema.ResourceData, m interface{}, nodes []NsxCluster
 		newNsxClients := c.(nsxtClients)
 		nodeConnector := getStandalonePolicyConnector(newNsxClients, false)
 		nodeConf := getNodeConnectivityStateConf(nodeConnector, 0, interval, timeout)
-		_, err = nodeConf.WaitForState()
+		_, err = nodeConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return fmt.Errorf("Failed to connect to NSX node endpoint %s", node.IPAddress)
 		}
@@ -245,8 +247,7 @@ func getClusterInfoFromHostNode(d *schema.ResourceData, m interface{}) (string,
 	connector := getPolicyConnector(m)
 	client := nsx.NewClusterClient(connector)
 	c := m.(nsxtClients)
-	min := c.CommonConfig.MinRetryInterval
-	max := c.CommonConfig.MaxRetryInterval
+	policy := newRetryPolicy(c.CommonConfig)
 	maxRetries := c.CommonConfig.MaxRetries
 	hostIPs := []string{}
 	for i := 0; i < maxRetries; i++ {
@@ -270,9 +271,9 @@ func getClusterInfoFromHostNode(d *schema.ResourceData, m interface{}) (string,
 			certSha256Thumbprint := *apiListenAddr.CertificateSha256Thumbprint
 			return clusterID, certSha256Thumbprint, hostIPs, nil
 		}
-		interval := (rand.Intn(max-min) + min)
-		time.Sleep(time.Duration(interval) * time.Millisecond)
-		log.Printf("[DEBUG]: Waited %d ms before retrying getting API Listen Address, attempt %d", interval, i+1)
+		interval := policy.delay(i, nil)
+		time.Sleep(interval)
+		log.Printf("[DEBUG]: Waited %v before retrying getting API Listen Address, attempt %d", interval, i+1)
 	}
 	return "", "", hostIPs, fmt.Errorf("Failed to read ClusterConfig after %d attempts", maxRetries)
 }
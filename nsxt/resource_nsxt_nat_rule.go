@@ -27,10 +27,10 @@ var natRuleActionValues = []string{
 
 func resourceNsxtNatRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNatRuleCreate,
-		Read:   resourceNsxtNatRuleRead,
-		Update: resourceNsxtNatRuleUpdate,
-		Delete: resourceNsxtNatRuleDelete,
+		CreateContext: withContext(resourceNsxtNatRuleCreate),
+		ReadContext:   withContext(resourceNsxtNatRuleRead),
+		UpdateContext: withContext(resourceNsxtNatRuleUpdate),
+		DeleteContext: withContext(resourceNsxtNatRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtNatRuleImport,
 		},
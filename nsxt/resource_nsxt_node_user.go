@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtUsers() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNodeUserCreate,
-		Read:   resourceNsxtNodeUserRead,
-		Update: resourceNsxtNodeUserUpdate,
-		Delete: resourceNsxtNodeUserDelete,
+		CreateContext: withContext(resourceNsxtNodeUserCreate),
+		ReadContext:   withContext(resourceNsxtNodeUserRead),
+		UpdateContext: withContext(resourceNsxtNodeUserUpdate),
+		DeleteContext: withContext(resourceNsxtNodeUserDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -16,10 +16,10 @@ var nsGroupTagOperationValues = []string{"EQUALS"}
 
 func resourceNsxtNsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNsGroupCreate,
-		Read:   resourceNsxtNsGroupRead,
-		Update: resourceNsxtNsGroupUpdate,
-		Delete: resourceNsxtNsGroupDelete,
+		CreateContext: withContext(resourceNsxtNsGroupCreate),
+		ReadContext:   withContext(resourceNsxtNsGroupRead),
+		UpdateContext: withContext(resourceNsxtNsGroupUpdate),
+		DeleteContext: withContext(resourceNsxtNsGroupDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtNsServiceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtNsServiceGroupCreate,
-		Read:   resourceNsxtNsServiceGroupRead,
-		Update: resourceNsxtNsServiceGroupUpdate,
-		Delete: resourceNsxtNsServiceGroupDelete,
+		CreateContext: withContext(resourceNsxtNsServiceGroupCreate),
+		ReadContext:   withContext(resourceNsxtNsServiceGroupRead),
+		UpdateContext: withContext(resourceNsxtNsServiceGroupUpdate),
+		DeleteContext: withContext(resourceNsxtNsServiceGroupDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
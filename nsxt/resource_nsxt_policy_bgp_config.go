@@ -21,10 +21,10 @@ func resourceNsxtPolicyBgpConfig() *schema.Resource {
 	bgpSchema["locale_service_id"] = getComputedLocaleServiceIDSchema()
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyBgpConfigCreate,
-		Read:   resourceNsxtPolicyBgpConfigRead,
-		Update: resourceNsxtPolicyBgpConfigUpdate,
-		Delete: resourceNsxtPolicyBgpConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyBgpConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyBgpConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyBgpConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyBgpConfigDelete),
 
 		Schema: bgpSchema,
 	}
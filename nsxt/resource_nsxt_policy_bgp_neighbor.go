@@ -32,10 +32,10 @@ var bgpNeighborConfigRouteFilteringAddressFamilyValues = []string{
 
 func resourceNsxtPolicyBgpNeighbor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyBgpNeighborCreate,
-		Read:   resourceNsxtPolicyBgpNeighborRead,
-		Update: resourceNsxtPolicyBgpNeighborUpdate,
-		Delete: resourceNsxtPolicyBgpNeighborDelete,
+		CreateContext: withContext(resourceNsxtPolicyBgpNeighborCreate),
+		ReadContext:   withContext(resourceNsxtPolicyBgpNeighborRead),
+		UpdateContext: withContext(resourceNsxtPolicyBgpNeighborUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyBgpNeighborDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyBgpNeighborImport,
 		},
@@ -0,0 +1,201 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	infra "github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyCaBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyCaBundleCreate),
+		ReadContext:   withContext(resourceNsxtPolicyCaBundleRead),
+		UpdateContext: withContext(resourceNsxtPolicyCaBundleUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyCaBundleDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:         schema.TypeString,
+				Description:  "PEM encoded CA certificates",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"not_after": getCertificateNotAfterSchema(),
+			"subjects": {
+				Type:        schema.TypeList,
+				Description: "Subjects of certificates in the bundle",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCaBundleExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewCabundlesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyCaBundleUpdate(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+	revision := int64(d.Get("revision").(int))
+
+	obj := model.CaBundle{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+	if !d.IsNewResource() {
+		obj.Revision = &revision
+	}
+
+	// CA bundle API does not support PATCH, hence PUT is used for create as well
+	client := infra.NewCabundlesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	_, err := client.Update(id, obj)
+	return err
+}
+
+func setPolicyCaBundleAttributesInSchema(d *schema.ResourceData) error {
+	certs, err := parsePEMCertificates(d.Get("pem_encoded").(string))
+	if err != nil {
+		return err
+	}
+
+	var subjects []string
+	notAfter := certs[0].NotAfter
+	for _, cert := range certs {
+		subjects = append(subjects, cert.Subject.String())
+		if cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	d.Set("not_after", formatCertificateTime(notAfter))
+	d.Set("subjects", subjects)
+	return nil
+}
+
+func resourceNsxtPolicyCaBundleCreate(d *schema.ResourceData, m interface{}) error {
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyCaBundleExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating CA Bundle with ID %s", id)
+	err = policyCaBundleUpdate(id, d, m)
+	if err != nil {
+		return handleCreateError("CA Bundle", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCaBundleRead(d, m)
+}
+
+func resourceNsxtPolicyCaBundleRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CA Bundle ID")
+	}
+
+	client := infra.NewCabundlesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "CA Bundle", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// Bundle content is only taken from NSX on import, since NSX may normalize the PEM
+	if d.Get("pem_encoded").(string) == "" && obj.PemEncoded != nil {
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+
+	return setPolicyCaBundleAttributesInSchema(d)
+}
+
+func resourceNsxtPolicyCaBundleUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CA Bundle ID")
+	}
+
+	log.Printf("[INFO] Updating CA Bundle with ID %s", id)
+	err := policyCaBundleUpdate(id, d, m)
+	if err != nil {
+		return handleUpdateError("CA Bundle", id, err)
+	}
+
+	return resourceNsxtPolicyCaBundleRead(d, m)
+}
+
+func resourceNsxtPolicyCaBundleDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CA Bundle ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCabundlesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("CA Bundle", id, err)
+	}
+
+	return nil
+}
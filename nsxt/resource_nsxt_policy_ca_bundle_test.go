@@ -0,0 +1,188 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyCaBundleCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyCaBundleUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func TestAccResourceNsxtPolicyCaBundle_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ca_bundle.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCaBundleCheckDestroy(state, accTestPolicyCaBundleUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCaBundleTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCaBundleExists(accTestPolicyCaBundleCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCaBundleCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCaBundleCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "subjects.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCaBundleTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCaBundleExists(accTestPolicyCaBundleUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCaBundleUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCaBundleUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "subjects.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "not_after"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCaBundle_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ca_bundle.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCaBundleCheckDestroy(state, accTestPolicyCaBundleCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCaBundleTemplate(true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCaBundleExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy CA Bundle resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy CA Bundle resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCaBundleExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy CA Bundle %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCaBundleCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ca_bundle" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCaBundleExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy CA Bundle %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCaBundleTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyCaBundleCreateAttributes
+	} else {
+		attrMap = accTestPolicyCaBundleUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ca_bundle" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%s
+EOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], testAccPolicyCaCertificatePem)
+}
+
+func TestPolicyCaBundleCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	pemEncoded := testAccPolicyCaCertificatePem + "\n" + testAccPolicyLeafCertificatePem
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{{
+		name:     "CA bundle",
+		resource: resourceNsxtPolicyCaBundle(),
+		config: map[string]interface{}{
+			"nsx_id":       "bundle1",
+			"display_name": "bundle1",
+			"pem_encoded":  pemEncoded,
+		},
+		path: "/infra/cabundles/bundle1",
+		checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+			if d.Get("subjects.#") != 2 || d.Get("subjects.0") != "CN=terraform-test-ca,O=Terraform" {
+				t.Errorf("unexpected subjects: %v", d.Get("subjects"))
+			}
+			// CA certificate expires earlier than the leaf certificate
+			if d.Get("not_after") != "2036-10-14T23:45:58Z" {
+				t.Errorf("unexpected expiry %v", d.Get("not_after"))
+			}
+		},
+		update: map[string]interface{}{"display_name": "updated"},
+		checkUpdate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+			if obj["display_name"] != "updated" || obj["pem_encoded"] != pemEncoded {
+				t.Errorf("unexpected CA bundle on NSX after update: %v", obj)
+			}
+		},
+	}})
+}
@@ -0,0 +1,242 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	infra "github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyCertificateCreate),
+		ReadContext:   withContext(resourceNsxtPolicyCertificateRead),
+		UpdateContext: withContext(resourceNsxtPolicyCertificateUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyCertificateDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"pem_encoded": {
+				Type:         schema.TypeString,
+				Description:  "PEM encoded certificate chain, leaf certificate first",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePEMCertificates(),
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Description:      "PEM encoded private key of the leaf certificate",
+				Optional:         true,
+				Sensitive:        true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressPolicyCertificateSecretDiff,
+			},
+			"passphrase": {
+				Type:             schema.TypeString,
+				Description:      "Passphrase of the private key",
+				Optional:         true,
+				Sensitive:        true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressPolicyCertificateSecretDiff,
+			},
+			"key_algo": {
+				Type:        schema.TypeString,
+				Description: "Key algorithm contained in this certificate",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Description: "Certificate validity start time in RFC3339 format",
+				Computed:    true,
+			},
+			"not_after": getCertificateNotAfterSchema(),
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "Subject of the leaf certificate",
+				Computed:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the leaf certificate",
+				Computed:    true,
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Description: "Serial number of the leaf certificate",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// NSX never returns private key material, hence after import the value is
+// unknown. Ignore the diff in this case instead of forcing replacement.
+func suppressPolicyCertificateSecretDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func resourceNsxtPolicyCertificateExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewCertificatesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyCertificatePatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	pemEncoded := d.Get("pem_encoded").(string)
+
+	obj := model.TlsTrustData{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		PemEncoded:  &pemEncoded,
+	}
+
+	if privateKey := d.Get("private_key").(string); len(privateKey) > 0 {
+		obj.PrivateKey = &privateKey
+	}
+	if passphrase := d.Get("passphrase").(string); len(passphrase) > 0 {
+		obj.Passphrase = &passphrase
+	}
+	if keyAlgo := d.Get("key_algo").(string); len(keyAlgo) > 0 {
+		obj.KeyAlgo = &keyAlgo
+	}
+
+	client := infra.NewCertificatesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	// Certificates are patched with trust data rather than the certificate model
+	return infra.TlsTrustDataClientContext(*client).Patch(id, obj)
+}
+
+func setPolicyCertificateAttributesInSchema(d *schema.ResourceData) error {
+	certs, err := parsePEMCertificates(d.Get("pem_encoded").(string))
+	if err != nil {
+		return err
+	}
+	leaf := certs[0]
+	d.Set("not_before", formatCertificateTime(leaf.NotBefore))
+	d.Set("not_after", formatCertificateTime(leaf.NotAfter))
+	d.Set("subject", leaf.Subject.String())
+	d.Set("issuer", leaf.Issuer.String())
+	d.Set("serial_number", leaf.SerialNumber.String())
+	return nil
+}
+
+func resourceNsxtPolicyCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyCertificateExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating Certificate with ID %s", id)
+	err = policyCertificatePatch(id, d, m)
+	if err != nil {
+		return handleCreateError("Certificate", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	client := infra.NewCertificatesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "Certificate", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	// Certificate content is only taken from NSX on import, since NSX may
+	// normalize the PEM and private key is never returned
+	if d.Get("pem_encoded").(string) == "" && obj.PemEncoded != nil {
+		d.Set("pem_encoded", obj.PemEncoded)
+	}
+
+	return setPolicyCertificateAttributesInSchema(d)
+}
+
+func resourceNsxtPolicyCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	log.Printf("[INFO] Updating Certificate with ID %s", id)
+	err := policyCertificatePatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("Certificate", id, err)
+	}
+
+	return resourceNsxtPolicyCertificateRead(d, m)
+}
+
+func resourceNsxtPolicyCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Certificate ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCertificatesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Certificate", id, err)
+	}
+
+	return nil
+}
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyComputeSubCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyComputeSubClusterCreate,
-		Read:   resourceNsxtPolicyComputeSubClusterRead,
-		Update: resourceNsxtPolicyComputeSubClusterUpdate,
-		Delete: resourceNsxtPolicyComputeSubClusterDelete,
+		CreateContext: withContext(resourceNsxtPolicyComputeSubClusterCreate),
+		ReadContext:   withContext(resourceNsxtPolicyComputeSubClusterRead),
+		UpdateContext: withContext(resourceNsxtPolicyComputeSubClusterUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyComputeSubClusterDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyComputeSubClusterImporter,
 		},
@@ -46,10 +46,10 @@ var subAttributeReverseKeyMap = map[string]string{
 
 func resourceNsxtPolicyContextProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCreate,
-		Read:   resourceNsxtPolicyContextProfileRead,
-		Update: resourceNsxtPolicyContextProfileUpdate,
-		Delete: resourceNsxtPolicyContextProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyContextProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyContextProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyContextProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyContextProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
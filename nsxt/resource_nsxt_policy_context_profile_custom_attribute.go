@@ -34,9 +34,9 @@ func makeCustomAttributeID(key string, attribute string) string {
 
 func resourceNsxtPolicyContextProfileCustomAttribute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyContextProfileCustomAttributeCreate,
-		Read:   resourceNsxtPolicyContextProfileCustomAttributeRead,
-		Delete: resourceNsxtPolicyContextProfileCustomAttributeDelete,
+		CreateContext: withContext(resourceNsxtPolicyContextProfileCustomAttributeCreate),
+		ReadContext:   withContext(resourceNsxtPolicyContextProfileCustomAttributeRead),
+		DeleteContext: withContext(resourceNsxtPolicyContextProfileCustomAttributeDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
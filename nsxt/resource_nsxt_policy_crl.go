@@ -0,0 +1,249 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	infra "github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var policyCrlTypeValues = []string{
+	model.TlsCrl_CRL_TYPE_X509,
+	model.TlsCrl_CRL_TYPE_ONECRL,
+}
+
+func resourceNsxtPolicyCrl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyCrlCreate),
+		ReadContext:   withContext(resourceNsxtPolicyCrlRead),
+		UpdateContext: withContext(resourceNsxtPolicyCrlUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyCrlDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"crl_type": {
+				Type:         schema.TypeString,
+				Description:  "Type of the CRL",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      model.TlsCrl_CRL_TYPE_X509,
+				ValidateFunc: validation.StringInSlice(policyCrlTypeValues, false),
+			},
+			"pem_encoded": {
+				Type:         schema.TypeString,
+				Description:  "PEM encoded X509 CRL",
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"pem_encoded", "one_crl"},
+			},
+			"one_crl": {
+				Type:        schema.TypeString,
+				Description: "JSON encoded OneCRL-like object",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Description: "Issuer of the X509 CRL",
+				Computed:    true,
+			},
+			"this_update": {
+				Type:        schema.TypeString,
+				Description: "Issue time of the X509 CRL in RFC3339 format",
+				Computed:    true,
+			},
+			"next_update": {
+				Type:        schema.TypeString,
+				Description: "Time of next X509 CRL update in RFC3339 format",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyCrlExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewCrlsClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func policyCrlPatch(id string, d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	crlType := d.Get("crl_type").(string)
+
+	obj := model.TlsCrl{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		CrlType:     &crlType,
+	}
+
+	if pemEncoded := d.Get("pem_encoded").(string); len(pemEncoded) > 0 {
+		obj.PemEncoded = &pemEncoded
+	}
+	if oneCrl := d.Get("one_crl").(string); len(oneCrl) > 0 {
+		obj.OneCrl = &oneCrl
+	}
+
+	client := infra.NewCrlsClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(id, obj)
+}
+
+func setPolicyCrlAttributesInSchema(d *schema.ResourceData) error {
+	pemEncoded := d.Get("pem_encoded").(string)
+	if len(pemEncoded) == 0 {
+		return nil
+	}
+
+	crl, err := parsePEMCrl(pemEncoded)
+	if err != nil {
+		return err
+	}
+	d.Set("issuer", crl.Issuer.String())
+	d.Set("this_update", formatCertificateTime(crl.ThisUpdate))
+	if !crl.NextUpdate.IsZero() {
+		d.Set("next_update", formatCertificateTime(crl.NextUpdate))
+	}
+	return nil
+}
+
+func validatePolicyCrl(d *schema.ResourceData) error {
+	crlType := d.Get("crl_type").(string)
+	pemEncoded := d.Get("pem_encoded").(string)
+	if crlType == model.TlsCrl_CRL_TYPE_X509 {
+		if len(pemEncoded) == 0 {
+			return fmt.Errorf("pem_encoded is required for %s CRL type", crlType)
+		}
+		if _, err := parsePEMCrl(pemEncoded); err != nil {
+			return fmt.Errorf("failed to parse pem_encoded: %v", err)
+		}
+	} else if len(pemEncoded) > 0 {
+		return fmt.Errorf("pem_encoded is not supported for %s CRL type, use one_crl instead", crlType)
+	}
+	return nil
+}
+
+func resourceNsxtPolicyCrlCreate(d *schema.ResourceData, m interface{}) error {
+	if err := validatePolicyCrl(d); err != nil {
+		return err
+	}
+
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyCrlExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating CRL with ID %s", id)
+	err = policyCrlPatch(id, d, m)
+	if err != nil {
+		return handleCreateError("CRL", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	client := infra.NewCrlsClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id, nil)
+	if err != nil {
+		return handleReadError(d, "CRL", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("crl_type", obj.CrlType)
+
+	// CRL content is only taken from NSX on import, since NSX may normalize it
+	if d.Get("pem_encoded").(string) == "" && d.Get("one_crl").(string) == "" {
+		d.Set("pem_encoded", obj.PemEncoded)
+		d.Set("one_crl", obj.OneCrl)
+	}
+
+	return setPolicyCrlAttributesInSchema(d)
+}
+
+func resourceNsxtPolicyCrlUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	log.Printf("[INFO] Updating CRL with ID %s", id)
+	err := policyCrlPatch(id, d, m)
+	if err != nil {
+		return handleUpdateError("CRL", id, err)
+	}
+
+	return resourceNsxtPolicyCrlRead(d, m)
+}
+
+func resourceNsxtPolicyCrlDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining CRL ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewCrlsClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("CRL", id, err)
+	}
+
+	return nil
+}
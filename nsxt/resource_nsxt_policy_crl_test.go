@@ -0,0 +1,193 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyCrlCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+}
+
+var accTestPolicyCrlUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+}
+
+func TestAccResourceNsxtPolicyCrl_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, accTestPolicyCrlUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCrlExists(accTestPolicyCrlCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "crl_type", "X509"),
+					resource.TestCheckResourceAttr(testResourceName, "issuer", "CN=terraform-test-ca,O=Terraform"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyCrlTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyCrlExists(accTestPolicyCrlUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyCrlUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyCrlUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "crl_type", "X509"),
+					resource.TestCheckResourceAttr(testResourceName, "issuer", "CN=terraform-test-ca,O=Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyCrl_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_crl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyCrlCheckDestroy(state, accTestPolicyCrlCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyCrlTemplate(true),
+			},
+			{
+				ResourceName:            testResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pem_encoded"},
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyCrlExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy CRL resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy CRL resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyCrlExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy CRL %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyCrlCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_crl" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyCrlExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy CRL %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyCrlTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyCrlCreateAttributes
+	} else {
+		attrMap = accTestPolicyCrlUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_crl" "test" {
+  display_name = "%s"
+  description  = "%s"
+  pem_encoded  = <<EOT
+%s
+EOT
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], testAccPolicyCrlPem)
+}
+
+func TestPolicyCrlCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	r := resourceNsxtPolicyCrl()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "CRL",
+			resource: r,
+			config: map[string]interface{}{
+				"nsx_id":       "crl1",
+				"display_name": "crl1",
+				"pem_encoded":  testAccPolicyCrlPem,
+			},
+			path: "/infra/crls/crl1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["crl_type"] != "X509" {
+					t.Errorf("unexpected CRL on NSX: %v", obj)
+				}
+				if d.Get("issuer") != "CN=terraform-test-ca,O=Terraform" || d.Get("next_update") != "2036-10-14T23:45:59Z" {
+					t.Errorf("unexpected parsed attributes: %v", d.State())
+				}
+			},
+		},
+		{
+			// certificate is not accepted instead of CRL
+			name:     "invalid CRL",
+			resource: r,
+			config: map[string]interface{}{
+				"display_name": "invalid",
+				"pem_encoded":  testAccPolicyCaCertificatePem,
+			},
+			createFails: true,
+		},
+	})
+}
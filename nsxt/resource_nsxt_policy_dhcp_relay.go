@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyDhcpRelayConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpRelayConfigCreate,
-		Read:   resourceNsxtPolicyDhcpRelayConfigRead,
-		Update: resourceNsxtPolicyDhcpRelayConfigUpdate,
-		Delete: resourceNsxtPolicyDhcpRelayConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyDhcpRelayConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDhcpRelayConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyDhcpRelayConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDhcpRelayConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicyDhcpServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpServerCreate,
-		Read:   resourceNsxtPolicyDhcpServerRead,
-		Update: resourceNsxtPolicyDhcpServerUpdate,
-		Delete: resourceNsxtPolicyDhcpServerDelete,
+		CreateContext: withContext(resourceNsxtPolicyDhcpServerCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDhcpServerRead),
+		UpdateContext: withContext(resourceNsxtPolicyDhcpServerUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDhcpServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
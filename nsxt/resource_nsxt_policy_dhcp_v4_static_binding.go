@@ -23,10 +23,10 @@ import (
 
 func resourceNsxtPolicyDhcpV4StaticBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpV4StaticBindingCreate,
-		Read:   resourceNsxtPolicyDhcpV4StaticBindingRead,
-		Update: resourceNsxtPolicyDhcpV4StaticBindingUpdate,
-		Delete: resourceNsxtPolicyDhcpStaticBindingDelete,
+		CreateContext: withContext(resourceNsxtPolicyDhcpV4StaticBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDhcpV4StaticBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicyDhcpV4StaticBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDhcpStaticBindingDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtSegmentResourceImporter,
 		},
@@ -349,13 +349,18 @@ func resourceNsxtPolicyDhcpStaticBindingDelete(d *schema.ResourceData, m interfa
 }
 
 func nsxtSegmentResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return nsxtSegmentChildResourceImporter(d, m, "/dhcp-static-binding-configs/")
+}
+
+// Import segment child object either by policy path or by [gatewayID]/segmentID/ID
+func nsxtSegmentChildResourceImporter(d *schema.ResourceData, m interface{}, childSeparator string) ([]*schema.ResourceData, error) {
 	importID := d.Id()
 	importSegment := ""
 	importGW := ""
 	s := strings.Split(importID, "/")
 	rd, err := nsxtPolicyPathResourceImporterHelper(d, m)
 	if err == nil {
-		segmentPath, err := getParameterFromPolicyPath("", "/dhcp-static-binding-configs/", importID)
+		segmentPath, err := getParameterFromPolicyPath("", childSeparator, importID)
 		if err != nil {
 			return nil, err
 		}
@@ -365,7 +370,7 @@ func nsxtSegmentResourceImporter(d *schema.ResourceData, m interface{}) ([]*sche
 		return rd, err
 	}
 	if len(s) < 2 {
-		return []*schema.ResourceData{d}, fmt.Errorf("Import format [gatewayID]/segmentID/bindingID expected, got %s", importID)
+		return []*schema.ResourceData{d}, fmt.Errorf("Import format [gatewayID]/segmentID/ID expected, got %s", importID)
 	}
 	if len(s) == 3 {
 		importGW = s[0]
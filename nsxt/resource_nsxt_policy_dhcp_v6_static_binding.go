@@ -19,10 +19,10 @@ import (
 
 func resourceNsxtPolicyDhcpV6StaticBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDhcpV6StaticBindingCreate,
-		Read:   resourceNsxtPolicyDhcpV6StaticBindingRead,
-		Update: resourceNsxtPolicyDhcpV6StaticBindingUpdate,
-		Delete: resourceNsxtPolicyDhcpStaticBindingDelete,
+		CreateContext: withContext(resourceNsxtPolicyDhcpV6StaticBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDhcpV6StaticBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicyDhcpV6StaticBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDhcpStaticBindingDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtSegmentResourceImporter,
 		},
@@ -20,10 +20,10 @@ import (
 
 func resourceNsxtPolicyDistributedFloodProtectionProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDistributedFloodProtectionProfileCreate,
-		Read:   resourceNsxtPolicyDistributedFloodProtectionProfileRead,
-		Update: resourceNsxtPolicyDistributedFloodProtectionProfileUpdate,
-		Delete: resourceNsxtPolicyFloodProtectionProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyDistributedFloodProtectionProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDistributedFloodProtectionProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyDistributedFloodProtectionProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyFloodProtectionProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyDistributedFloodProtectionProfileBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDistributedFloodProtectionProfileBindingCreate,
-		Read:   resourceNsxtPolicyDistributedFloodProtectionProfileBindingRead,
-		Update: resourceNsxtPolicyDistributedFloodProtectionProfileBindingUpdate,
-		Delete: resourceNsxtPolicyDistributedFloodProtectionProfileBindingDelete,
+		CreateContext: withContext(resourceNsxtPolicyDistributedFloodProtectionProfileBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDistributedFloodProtectionProfileBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicyDistributedFloodProtectionProfileBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDistributedFloodProtectionProfileBindingDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDistributedFloodProtectionProfileBindingImporter,
 		},
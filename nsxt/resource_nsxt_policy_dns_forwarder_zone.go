@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicyDNSForwarderZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDNSForwarderZoneCreate,
-		Read:   resourceNsxtPolicyDNSForwarderZoneRead,
-		Update: resourceNsxtPolicyDNSForwarderZoneUpdate,
-		Delete: resourceNsxtPolicyDNSForwarderZoneDelete,
+		CreateContext: withContext(resourceNsxtPolicyDNSForwarderZoneCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDNSForwarderZoneRead),
+		UpdateContext: withContext(resourceNsxtPolicyDNSForwarderZoneUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDNSForwarderZoneDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -20,10 +20,10 @@ import (
 // This resource is supported only for Policy Global Manager
 func resourceNsxtPolicyDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyDomainCreate,
-		Read:   resourceNsxtPolicyDomainRead,
-		Update: resourceNsxtPolicyDomainUpdate,
-		Delete: resourceNsxtPolicyDomainDelete,
+		CreateContext: withContext(resourceNsxtPolicyDomainCreate),
+		ReadContext:   withContext(resourceNsxtPolicyDomainRead),
+		UpdateContext: withContext(resourceNsxtPolicyDomainUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyDomainDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -21,10 +21,10 @@ var policyEvpnConfigTypeValues = []string{
 
 func resourceNsxtPolicyEvpnConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnConfigCreate,
-		Read:   resourceNsxtPolicyEvpnConfigRead,
-		Update: resourceNsxtPolicyEvpnConfigUpdate,
-		Delete: resourceNsxtPolicyEvpnConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyEvpnConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyEvpnConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyEvpnConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyEvpnConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnConfigImport,
 		},
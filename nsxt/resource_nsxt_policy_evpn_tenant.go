@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtPolicyEvpnTenant() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnTenantCreate,
-		Read:   resourceNsxtPolicyEvpnTenantRead,
-		Update: resourceNsxtPolicyEvpnTenantUpdate,
-		Delete: resourceNsxtPolicyEvpnTenantDelete,
+		CreateContext: withContext(resourceNsxtPolicyEvpnTenantCreate),
+		ReadContext:   withContext(resourceNsxtPolicyEvpnTenantRead),
+		UpdateContext: withContext(resourceNsxtPolicyEvpnTenantUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyEvpnTenantDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
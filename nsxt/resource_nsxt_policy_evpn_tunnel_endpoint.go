@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyEvpnTunnelEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyEvpnTunnelEndpointCreate,
-		Read:   resourceNsxtPolicyEvpnTunnelEndpointRead,
-		Update: resourceNsxtPolicyEvpnTunnelEndpointUpdate,
-		Delete: resourceNsxtPolicyEvpnTunnelEndpointDelete,
+		CreateContext: withContext(resourceNsxtPolicyEvpnTunnelEndpointCreate),
+		ReadContext:   withContext(resourceNsxtPolicyEvpnTunnelEndpointRead),
+		UpdateContext: withContext(resourceNsxtPolicyEvpnTunnelEndpointUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyEvpnTunnelEndpointDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyEvpnTunnelEndpointImport,
 		},
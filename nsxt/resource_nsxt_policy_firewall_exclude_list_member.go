@@ -15,9 +15,9 @@ import (
 
 func resourceNsxtPolicyFirewallExcludeListMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyFirewallExcludeListMemberCreate,
-		Read:   resourceNsxtPolicyFirewallExcludeListMemberRead,
-		Delete: resourceNsxtPolicyFirewallExcludeListMemberDelete,
+		CreateContext: withContext(resourceNsxtPolicyFirewallExcludeListMemberCreate),
+		ReadContext:   withContext(resourceNsxtPolicyFirewallExcludeListMemberRead),
+		DeleteContext: withContext(resourceNsxtPolicyFirewallExcludeListMemberDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
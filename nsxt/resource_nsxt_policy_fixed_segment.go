@@ -13,10 +13,10 @@ import (
 
 func resourceNsxtPolicyFixedSegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyFixedSegmentCreate,
-		Read:   resourceNsxtPolicyFixedSegmentRead,
-		Update: resourceNsxtPolicyFixedSegmentUpdate,
-		Delete: resourceNsxtPolicyFixedSegmentDelete,
+		CreateContext: withContext(resourceNsxtPolicyFixedSegmentCreate),
+		ReadContext:   withContext(resourceNsxtPolicyFixedSegmentRead),
+		UpdateContext: withContext(resourceNsxtPolicyFixedSegmentUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyFixedSegmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtGatewayResourceImporter,
 		},
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyGatewayCommunityList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayCommunityListCreate,
-		Read:   resourceNsxtPolicyGatewayCommunityListRead,
-		Update: resourceNsxtPolicyGatewayCommunityListUpdate,
-		Delete: resourceNsxtPolicyGatewayCommunityListDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayCommunityListCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayCommunityListRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayCommunityListUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayCommunityListDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayImporter,
 		},
@@ -28,10 +28,10 @@ var gatewayDNSForwarderLogLevelTypeValues = []string{
 
 func resourceNsxtPolicyGatewayDNSForwarder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayDNSForwarderCreate,
-		Read:   resourceNsxtPolicyGatewayDNSForwarderRead,
-		Update: resourceNsxtPolicyGatewayDNSForwarderUpdate,
-		Delete: resourceNsxtPolicyGatewayDNSForwarderDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayDNSForwarderCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayDNSForwarderRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayDNSForwarderUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayDNSForwarderDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyGatewayDNSForwarderImport,
 		},
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyGatewayFloodProtectionProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayFloodProtectionProfileCreate,
-		Read:   resourceNsxtPolicyGatewayFloodProtectionProfileRead,
-		Update: resourceNsxtPolicyGatewayFloodProtectionProfileUpdate,
-		Delete: resourceNsxtPolicyFloodProtectionProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayFloodProtectionProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayFloodProtectionProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayFloodProtectionProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyFloodProtectionProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -19,10 +19,10 @@ import (
 
 func resourceNsxtPolicyGatewayFloodProtectionProfileBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayFloodProtectionProfileBindingCreate,
-		Read:   resourceNsxtPolicyGatewayFloodProtectionProfileBindingRead,
-		Update: resourceNsxtPolicyGatewayFloodProtectionProfileBindingUpdate,
-		Delete: resourceNsxtPolicyGatewayFloodProtectionProfileBindingDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayFloodProtectionProfileBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayFloodProtectionProfileBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayFloodProtectionProfileBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayFloodProtectionProfileBindingDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtGatewayFloodProtectionProfileBindingImporter,
 		},
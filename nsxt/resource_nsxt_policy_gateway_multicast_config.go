@@ -0,0 +1,217 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tier0_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_0s/locale_services"
+	tier1_locale_services "github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/tier_1s/locale_services"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyGatewayMulticastConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyGatewayMulticastConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayMulticastConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayMulticastConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayMulticastConfigDelete),
+		Importer: &schema.ResourceImporter{
+			State: resourceNsxtPolicyGatewayMulticastConfigImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"gateway_path": getPolicyPathSchema(true, true, "Policy path for Tier0 or Tier1 Gateway"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Flag to enable multicast on the gateway",
+				Optional:    true,
+				Default:     true,
+			},
+			"igmp_profile_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of IGMP profile, applicable to Tier0 Gateway only",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"pim_profile_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of PIM profile, applicable to Tier0 Gateway only",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"replication_multicast_range": {
+				Type:         schema.TypeString,
+				Description:  "Replication multicast range, applicable to Tier0 Gateway only",
+				Optional:     true,
+				ValidateFunc: validateCidr(),
+			},
+			"locale_service_id": getComputedLocaleServiceIDSchema(),
+			"gateway_id":        getComputedGatewayIDSchema(),
+		},
+	}
+}
+
+func policyGatewayMulticastConfigPatch(d *schema.ResourceData, m interface{}, isT0 bool, gwID string, localeServiceID string, enabled bool) error {
+	connector := getPolicyConnector(m)
+
+	igmpProfilePath := d.Get("igmp_profile_path").(string)
+	pimProfilePath := d.Get("pim_profile_path").(string)
+	replicationMulticastRange := d.Get("replication_multicast_range").(string)
+
+	if !isT0 {
+		if len(igmpProfilePath) > 0 || len(pimProfilePath) > 0 || len(replicationMulticastRange) > 0 {
+			return fmt.Errorf("IGMP profile, PIM profile and replication multicast range are only applicable to Tier0 Gateway")
+		}
+		obj := model.PolicyTier1MulticastConfig{
+			Enabled: &enabled,
+		}
+		client := tier1_locale_services.NewMulticastClient(connector)
+		return client.Patch(gwID, localeServiceID, obj)
+	}
+
+	obj := model.PolicyMulticastConfig{
+		Enabled: &enabled,
+	}
+	if len(igmpProfilePath) > 0 {
+		obj.IgmpProfilePath = &igmpProfilePath
+	}
+	if len(pimProfilePath) > 0 {
+		obj.PimProfilePath = &pimProfilePath
+	}
+	if len(replicationMulticastRange) > 0 {
+		obj.ReplicationMulticastRange = &replicationMulticastRange
+	}
+
+	client := tier0_locale_services.NewMulticastClient(connector)
+	return client.Patch(gwID, localeServiceID, obj)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	gwPath := d.Get("gateway_path").(string)
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return fmt.Errorf("Gateway path expected, got %s", gwPath)
+	}
+
+	localeService, err := getPolicyGatewayLocaleServiceWithEdgeCluster(getSessionContext(d, m), connector, isT0, gwID)
+	if err != nil {
+		return err
+	}
+	if localeService == nil {
+		return fmt.Errorf("Edge cluster is mandatory on gateway %s in order to configure multicast", gwID)
+	}
+	localeServiceID := *localeService.Id
+
+	id := newUUID()
+	err = policyGatewayMulticastConfigPatch(d, m, isT0, gwID, localeServiceID, d.Get("enabled").(bool))
+	if err != nil {
+		return handleCreateError("Gateway Multicast Config", gwID, err)
+	}
+
+	d.SetId(id)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeServiceID)
+
+	return resourceNsxtPolicyGatewayMulticastConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+	if gwID == "" || localeServiceID == "" {
+		return fmt.Errorf("Error obtaining Gateway id or Locale Service id")
+	}
+
+	if !isT0 {
+		client := tier1_locale_services.NewMulticastClient(connector)
+		obj, err := client.Get(gwID, localeServiceID)
+		if err != nil {
+			return handleReadError(d, "Gateway Multicast Config", gwID, err)
+		}
+		d.Set("enabled", obj.Enabled)
+		return nil
+	}
+
+	client := tier0_locale_services.NewMulticastClient(connector)
+	obj, err := client.Get(gwID, localeServiceID)
+	if err != nil {
+		return handleReadError(d, "Gateway Multicast Config", gwID, err)
+	}
+
+	d.Set("enabled", obj.Enabled)
+	d.Set("igmp_profile_path", obj.IgmpProfilePath)
+	d.Set("pim_profile_path", obj.PimProfilePath)
+	d.Set("replication_multicast_range", obj.ReplicationMulticastRange)
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigUpdate(d *schema.ResourceData, m interface{}) error {
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+
+	err := policyGatewayMulticastConfigPatch(d, m, isT0, gwID, localeServiceID, d.Get("enabled").(bool))
+	if err != nil {
+		return handleUpdateError("Gateway Multicast Config", gwID, err)
+	}
+
+	return resourceNsxtPolicyGatewayMulticastConfigRead(d, m)
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigDelete(d *schema.ResourceData, m interface{}) error {
+	// Multicast config can not be deleted as long as locale service exists,
+	// hence multicast is disabled on the gateway instead
+	isT0, _ := parseGatewayPolicyPath(d.Get("gateway_path").(string))
+	gwID := d.Get("gateway_id").(string)
+	localeServiceID := d.Get("locale_service_id").(string)
+
+	err := policyGatewayMulticastConfigPatch(d, m, isT0, gwID, localeServiceID, false)
+	if err != nil {
+		return handleDeleteError("Gateway Multicast Config", gwID, err)
+	}
+
+	return nil
+}
+
+func resourceNsxtPolicyGatewayMulticastConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	gwPath := d.Id()
+	isT0, gwID := parseGatewayPolicyPath(gwPath)
+	if gwID == "" {
+		return nil, fmt.Errorf("Please provide gateway policy path as an input")
+	}
+
+	connector := getPolicyConnector(m)
+	localeService, err := getPolicyGatewayLocaleServiceWithEdgeCluster(getSessionContext(d, m), connector, isT0, gwID)
+	if err != nil {
+		return nil, err
+	}
+	if localeService == nil {
+		return nil, fmt.Errorf("Failed to find locale service on gateway %s", gwID)
+	}
+
+	d.Set("gateway_path", gwPath)
+	d.Set("gateway_id", gwID)
+	d.Set("locale_service_id", localeService.Id)
+
+	d.SetId(newUUID())
+
+	return []*schema.ResourceData{d}, nil
+}
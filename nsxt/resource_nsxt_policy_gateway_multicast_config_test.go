@@ -0,0 +1,164 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accTestPolicyGatewayMulticastConfigCreateAttributes = map[string]string{
+	"enabled":                     "true",
+	"replication_multicast_range": "233.1.0.0/24",
+}
+
+var accTestPolicyGatewayMulticastConfigUpdateAttributes = map[string]string{
+	"enabled":                     "false",
+	"replication_multicast_range": "233.2.0.0/24",
+}
+
+var accTestPolicyGatewayMulticastConfigHelperName = getAccTestResourceName()
+
+func TestAccResourceNsxtPolicyGatewayMulticastConfig_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_gateway_multicast_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccOnlyLocalManager(t); testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyGatewayMulticastConfigTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", accTestPolicyGatewayMulticastConfigCreateAttributes["enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "replication_multicast_range", accTestPolicyGatewayMulticastConfigCreateAttributes["replication_multicast_range"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "pim_profile_path", "nsxt_policy_pim_profile.test", "path"),
+					resource.TestCheckResourceAttrPair(testResourceName, "igmp_profile_path", "nsxt_policy_igmp_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "gateway_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "locale_service_id"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyGatewayMulticastConfigTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(testResourceName, "enabled", accTestPolicyGatewayMulticastConfigUpdateAttributes["enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "replication_multicast_range", accTestPolicyGatewayMulticastConfigUpdateAttributes["replication_multicast_range"]),
+				),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyGatewayMulticastConfigTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyGatewayMulticastConfigCreateAttributes
+	} else {
+		attrMap = accTestPolicyGatewayMulticastConfigUpdateAttributes
+	}
+	return fmt.Sprintf(`
+data "nsxt_policy_edge_cluster" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tier0_gateway" "test" {
+  display_name      = "%s"
+  edge_cluster_path = data.nsxt_policy_edge_cluster.test.path
+}
+
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_igmp_profile" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_gateway_multicast_config" "test" {
+  gateway_path                = nsxt_policy_tier0_gateway.test.path
+  enabled                     = %s
+  pim_profile_path            = nsxt_policy_pim_profile.test.path
+  igmp_profile_path           = nsxt_policy_igmp_profile.test.path
+  replication_multicast_range = "%s"
+}`, getEdgeClusterName(), accTestPolicyGatewayMulticastConfigHelperName, accTestPolicyGatewayMulticastConfigHelperName,
+		accTestPolicyGatewayMulticastConfigHelperName, attrMap["enabled"], attrMap["replication_multicast_range"])
+}
+
+func TestPolicyGatewayMulticastConfigCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	srv.Seed("/infra/tier-0s/t0", map[string]interface{}{"resource_type": "Tier0"})
+	srv.Seed("/infra/tier-0s/t0/locale-services/default", map[string]interface{}{"resource_type": "LocaleServices"})
+	srv.Seed("/infra/tier-1s/t1", map[string]interface{}{"resource_type": "Tier1"})
+	srv.Seed("/infra/tier-1s/t1/locale-services/default", map[string]interface{}{"resource_type": "LocaleServices"})
+
+	// multicast config is disabled rather than removed on delete
+	checkDisabled := func(t *testing.T, obj map[string]interface{}, found bool) {
+		if obj["enabled"] != false {
+			t.Errorf("multicast was not disabled on NSX: %v", obj)
+		}
+	}
+
+	r := resourceNsxtPolicyGatewayMulticastConfig()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "PIM profile",
+			resource: resourceNsxtPolicyPimProfile(),
+			config: map[string]interface{}{
+				"nsx_id":       "pim1",
+				"display_name": "pim1",
+				"rp_address_multicast_range": []interface{}{map[string]interface{}{
+					"rp_address":       "10.0.0.1",
+					"multicast_ranges": []interface{}{"239.1.0.0/16"},
+				}},
+			},
+			path: "/infra/pim-profiles/pim1",
+		},
+		{
+			name:     "Tier0 multicast config",
+			resource: r,
+			config: map[string]interface{}{
+				"gateway_path":                "/infra/tier-0s/t0",
+				"pim_profile_path":            "/infra/pim-profiles/pim1",
+				"replication_multicast_range": "233.1.0.0/24",
+			},
+			path: "/infra/tier-0s/t0/locale-services/default/multicast",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["enabled"] != true || obj["pim_profile_path"] != "/infra/pim-profiles/pim1" || obj["replication_multicast_range"] != "233.1.0.0/24" {
+					t.Errorf("unexpected multicast config on NSX: %v", obj)
+				}
+				if d.Get("locale_service_id") != "default" || d.Get("gateway_id") != "t0" {
+					t.Errorf("unexpected state: %v", d.State())
+				}
+			},
+			checkDelete: checkDisabled,
+		},
+		{
+			// Tier1 gateway only supports enablement flag
+			name:     "Tier1 multicast config with PIM profile",
+			resource: r,
+			config: map[string]interface{}{
+				"gateway_path":     "/infra/tier-1s/t1",
+				"pim_profile_path": "/infra/pim-profiles/pim1",
+			},
+			path:        "/infra/tier-1s/t1/locale-services/default/multicast",
+			createFails: true,
+		},
+		{
+			name:     "Tier1 multicast config",
+			resource: r,
+			config: map[string]interface{}{
+				"gateway_path": "/infra/tier-1s/t1",
+			},
+			path: "/infra/tier-1s/t1/locale-services/default/multicast",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["enabled"] != true {
+					t.Errorf("unexpected Tier1 multicast config on NSX: %v", obj)
+				}
+			},
+			checkDelete: checkDisabled,
+		},
+	})
+}
@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicyGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyGatewayPolicyRead,
-		Update: resourceNsxtPolicyGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyGatewayPolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
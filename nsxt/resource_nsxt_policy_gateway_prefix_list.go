@@ -26,10 +26,10 @@ var gatewayPrefixListActionTypeValues = []string{
 
 func resourceNsxtPolicyGatewayPrefixList() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayPrefixListCreate,
-		Read:   resourceNsxtPolicyGatewayPrefixListRead,
-		Update: resourceNsxtPolicyGatewayPrefixListUpdate,
-		Delete: resourceNsxtPolicyGatewayPrefixListDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayPrefixListCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayPrefixListRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayPrefixListUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayPrefixListDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayImporter,
 		},
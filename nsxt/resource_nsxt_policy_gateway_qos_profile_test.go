@@ -122,7 +122,7 @@ func testAccNsxtPolicyGatewayQosProfileExists(displayName string, resourceName s
 			return fmt.Errorf("Policy GatewayQosProfile resource ID not set in resources")
 		}
 
-		exists, err := resourceNsxtPolicyGatewayQosProfileExists(resourceID, connector, testAccIsGlobalManager())
+		exists, err := resourceNsxtPolicyGatewayQosProfileExists(testAccGetSessionContext(), resourceID, connector)
 		if err != nil {
 			return err
 		}
@@ -143,7 +143,7 @@ func testAccNsxtPolicyGatewayQosProfileCheckDestroy(state *terraform.State, disp
 		}
 
 		resourceID := rs.Primary.Attributes["id"]
-		exists, err := resourceNsxtPolicyGatewayQosProfileExists(resourceID, connector, testAccIsGlobalManager())
+		exists, err := resourceNsxtPolicyGatewayQosProfileExists(testAccGetSessionContext(), resourceID, connector)
 		if err == nil {
 			return err
 		}
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyGatewayRedistributionConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayRedistributionConfigCreate,
-		Read:   resourceNsxtPolicyGatewayRedistributionConfigRead,
-		Update: resourceNsxtPolicyGatewayRedistributionConfigUpdate,
-		Delete: resourceNsxtPolicyGatewayRedistributionConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayRedistributionConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayRedistributionConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayRedistributionConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayRedistributionConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyGatewayRedistributionConfigImport,
 		},
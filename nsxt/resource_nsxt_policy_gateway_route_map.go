@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicyGatewayRouteMap() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGatewayRouteMapCreate,
-		Read:   resourceNsxtPolicyGatewayRouteMapRead,
-		Update: resourceNsxtPolicyGatewayRouteMapUpdate,
-		Delete: resourceNsxtPolicyGatewayRouteMapDelete,
+		CreateContext: withContext(resourceNsxtPolicyGatewayRouteMapCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGatewayRouteMapRead),
+		UpdateContext: withContext(resourceNsxtPolicyGatewayRouteMapUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGatewayRouteMapDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayImporter,
 		},
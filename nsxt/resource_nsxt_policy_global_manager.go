@@ -20,10 +20,10 @@ var globalManagerModeValues = []string{
 
 func resourceNsxtPolicyGlobalManager() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGlobalManagerCreate,
-		Read:   resourceNsxtPolicyGlobalManagerRead,
-		Update: resourceNsxtPolicyGlobalManagerUpdate,
-		Delete: resourceNsxtPolicyGlobalManagerDelete,
+		CreateContext: withContext(resourceNsxtPolicyGlobalManagerCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGlobalManagerRead),
+		UpdateContext: withContext(resourceNsxtPolicyGlobalManagerUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGlobalManagerDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
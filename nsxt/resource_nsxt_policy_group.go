@@ -86,10 +86,10 @@ var groupTypeValues = []string{
 
 func resourceNsxtPolicyGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyGroupCreate,
-		Read:   resourceNsxtPolicyGroupRead,
-		Update: resourceNsxtPolicyGroupUpdate,
-		Delete: resourceNsxtPolicyGroupDelete,
+		CreateContext: withContext(resourceNsxtPolicyGroupCreate),
+		ReadContext:   withContext(resourceNsxtPolicyGroupRead),
+		UpdateContext: withContext(resourceNsxtPolicyGroupUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyGroupDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
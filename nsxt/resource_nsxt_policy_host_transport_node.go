@@ -20,13 +20,16 @@ import (
 
 func resourceNsxtPolicyHostTransportNode() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeRead,
-		Update: resourceNsxtPolicyHostTransportNodeUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeDelete,
+		CreateContext: withContext(resourceNsxtPolicyHostTransportNodeCreate),
+		ReadContext:   withContext(resourceNsxtPolicyHostTransportNodeRead),
+		UpdateContext: withContext(resourceNsxtPolicyHostTransportNodeUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyHostTransportNodeDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -207,7 +210,7 @@ func resourceNsxtPolicyHostTransportNodeUpdate(d *schema.ResourceData, m interfa
 	return resourceNsxtPolicyHostTransportNodeRead(d, m)
 }
 
-func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID string) *resource.StateChangeConf {
+func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -227,7 +230,7 @@ func getHostTransportNodeStateConf(connector client.Connector, id, siteID, epID
 			return "notyet", "notyet", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
@@ -253,8 +256,8 @@ func resourceNsxtPolicyHostTransportNodeDelete(d *schema.ResourceData, m interfa
 		log.Printf("[INFO] Removing NSX from host HostTransportNode with ID %s", id)
 
 		// Busy-wait until removal is complete
-		stateConf := getHostTransportNodeStateConf(connector, id, siteID, epID)
-		_, err := stateConf.WaitForState()
+		stateConf := getHostTransportNodeStateConf(connector, id, siteID, epID, d.Timeout(schema.TimeoutDelete))
+		_, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return fmt.Errorf("failed to remove NSX bits from hosts: %v", err)
 		}
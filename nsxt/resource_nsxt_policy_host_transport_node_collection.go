@@ -21,13 +21,16 @@ const removeOnDestroyDefault = true
 
 func resourceNsxtPolicyHostTransportNodeCollection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeCollectionCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeCollectionRead,
-		Update: resourceNsxtPolicyHostTransportNodeCollectionUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeCollectionDelete,
+		CreateContext: withContext(resourceNsxtPolicyHostTransportNodeCollectionCreate),
+		ReadContext:   withContext(resourceNsxtPolicyHostTransportNodeCollectionRead),
+		UpdateContext: withContext(resourceNsxtPolicyHostTransportNodeCollectionUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyHostTransportNodeCollectionDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyHostTransportNodeCollectionImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -274,7 +277,7 @@ func resourceNsxtPolicyHostTransportNodeCollectionUpdate(d *schema.ResourceData,
 	return resourceNsxtPolicyHostTransportNodeCollectionRead(d, m)
 }
 
-func getComputeCollectionMemberStateConf(connector client.Connector, id string) *resource.StateChangeConf {
+func getComputeCollectionMemberStateConf(connector client.Connector, id string, timeout time.Duration) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending: []string{"notyet"},
 		Target:  []string{"success", "failed"},
@@ -293,7 +296,7 @@ func getComputeCollectionMemberStateConf(connector client.Connector, id string)
 			return "success", "success", nil
 		},
 		Delay:        time.Duration(5) * time.Second,
-		Timeout:      time.Duration(1200) * time.Second,
+		Timeout:      timeout,
 		PollInterval: time.Duration(5) * time.Second,
 	}
 }
@@ -316,8 +319,8 @@ func resourceNsxtPolicyHostTransportNodeCollectionDelete(d *schema.ResourceData,
 
 		// Busy-wait until removal is complete
 		ccID := d.Get("compute_collection_id").(string)
-		stateConf := getComputeCollectionMemberStateConf(connector, ccID)
-		_, err := stateConf.WaitForState()
+		stateConf := getComputeCollectionMemberStateConf(connector, ccID, d.Timeout(schema.TimeoutDelete))
+		_, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return fmt.Errorf("failed to remove NSX bits from hosts: %v", err)
 		}
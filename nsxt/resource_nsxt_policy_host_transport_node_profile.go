@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtPolicyHostTransportNodeProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyHostTransportNodeProfileCreate,
-		Read:   resourceNsxtPolicyHostTransportNodeProfileRead,
-		Update: resourceNsxtPolicyHostTransportNodeProfileUpdate,
-		Delete: resourceNsxtPolicyHostTransportNodeProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyHostTransportNodeProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyHostTransportNodeProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyHostTransportNodeProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyHostTransportNodeProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
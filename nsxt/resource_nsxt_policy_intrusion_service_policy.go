@@ -22,10 +22,10 @@ var policyIntrusionServiceRuleActionValues = []string{model.IdsRule_ACTION_DETEC
 
 func resourceNsxtPolicyIntrusionServicePolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIntrusionServicePolicyCreate,
-		Read:   resourceNsxtPolicyIntrusionServicePolicyRead,
-		Update: resourceNsxtPolicyIntrusionServicePolicyUpdate,
-		Delete: resourceNsxtPolicyIntrusionServicePolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicyIntrusionServicePolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIntrusionServicePolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyIntrusionServicePolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIntrusionServicePolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
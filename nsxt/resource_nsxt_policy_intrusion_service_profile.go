@@ -41,10 +41,10 @@ var idsProfileSignatureActionValues = []string{
 
 func resourceNsxtPolicyIntrusionServiceProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIntrusionServiceProfileCreate,
-		Read:   resourceNsxtPolicyIntrusionServiceProfileRead,
-		Update: resourceNsxtPolicyIntrusionServiceProfileUpdate,
-		Delete: resourceNsxtPolicyIntrusionServiceProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyIntrusionServiceProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIntrusionServiceProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIntrusionServiceProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIntrusionServiceProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,13 +25,17 @@ var addressRealizationTimeoutDefault = int(1200)
 
 func resourceNsxtPolicyIPAddressAllocation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPAddressAllocationCreate,
-		Read:   resourceNsxtPolicyIPAddressAllocationRead,
-		Update: resourceNsxtPolicyIPAddressAllocationUpdate,
-		Delete: resourceNsxtPolicyIPAddressAllocationDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPAddressAllocationCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPAddressAllocationRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPAddressAllocationUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPAddressAllocationDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPAddressAllocationImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Duration(addressRealizationTimeoutDefault) * time.Second),
+			Read:   schema.DefaultTimeout(time.Duration(addressRealizationTimeoutDefault) * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -54,6 +59,7 @@ func resourceNsxtPolicyIPAddressAllocation() *schema.Resource {
 				Description:  "Realization timeout in seconds",
 				Optional:     true,
 				Default:      addressRealizationTimeoutDefault,
+				Deprecated:   "Use timeouts block instead",
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 		},
@@ -168,11 +174,11 @@ func resourceNsxtPolicyIPAddressAllocationRead(d *schema.ResourceData, m interfa
 	d.Set("allocation_ip", obj.AllocationIp)
 
 	if d.Get("allocation_ip").(string) == "" {
-		timeout := d.Get("timeout").(int)
+		timeout := getWaitTimeout(d, getCreateOrReadTimeoutKey(d), "timeout", addressRealizationTimeoutDefault)
 		log.Printf("[DEBUG] Waiting for realization of IP Address for IP Allocation with ID %s", id)
 
 		stateConf := nsxtPolicyWaitForRealizationStateConf(connector, d, d.Get("path").(string), timeout)
-		entity, err := stateConf.WaitForState()
+		entity, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return err
 		}
@@ -24,10 +24,10 @@ var visibilityTypes = []string{
 
 func resourceNsxtPolicyIPBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPBlockCreate,
-		Read:   resourceNsxtPolicyIPBlockRead,
-		Update: resourceNsxtPolicyIPBlockUpdate,
-		Delete: resourceNsxtPolicyIPBlockDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPBlockCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPBlockRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPBlockUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPBlockDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -4,9 +4,6 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -14,279 +11,179 @@ import (
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
 	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
 )
 
-func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyIPDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyIPDiscoveryProfileRead,
-		Update: resourceNsxtPolicyIPDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyIPDiscoveryProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
+// Attributes that reside in nested structures of the model are flattened in
+// the schema, and thus skipped in metadata and converted in hooks below
+var ipDiscoveryProfileSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(false, false, false)),
+	"arp_nd_binding_timeout": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "ARP and ND cache timeout (in minutes)",
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(5, 120),
 		},
-
-		Schema: map[string]*schema.Schema{
-			"nsx_id":       getNsxIDSchema(),
-			"path":         getPathSchema(),
-			"display_name": getDisplayNameSchema(),
-			"description":  getDescriptionSchema(),
-			"revision":     getRevisionSchema(),
-			"tag":          getTagsSchema(),
-			"context":      getContextSchema(false, false, false),
-			"arp_nd_binding_timeout": {
-				Type:         schema.TypeInt,
-				Description:  "ARP and ND cache timeout (in minutes)",
-				Optional:     true,
-				Default:      10,
-				ValidateFunc: validation.IntBetween(5, 120),
-			},
-			"duplicate_ip_detection_enabled": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Default:     false,
-				Description: "Duplicate IP detection",
-			},
-			"arp_binding_limit": {
-				Type:         schema.TypeInt,
-				Description:  "Maximum number of ARP bindings",
-				Optional:     true,
-				Default:      1,
-				ValidateFunc: validation.IntBetween(1, 256),
-			},
-			"arp_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is ARP snooping enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"dhcp_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is DHCP snooping enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"vmtools_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is VM tools enabled or not",
-				Optional:    true,
-				Default:     true,
-			},
-			"dhcp_snooping_v6_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is DHCP snoping v6 enabled or not",
-				Optional:    true,
-				Default:     false,
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "ArpNdBindingTimeout",
+			TestData: metadata.Testdata{
+				CreateValue: "20",
+				UpdateValue: "50",
 			},
-			"nd_snooping_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is ND snooping enabled or not",
-				Optional:    true,
-				Default:     false,
-			},
-			"nd_snooping_limit": {
-				Type:         schema.TypeInt,
-				Description:  "Maximum number of ND (Neighbor Discovery Protocol) bindings",
-				Optional:     true,
-				ValidateFunc: validation.IntBetween(2, 15),
-				Default:      3,
-			},
-			"vmtools_v6_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is VM tools enabled or not",
-				Optional:    true,
-				Default:     false,
-			},
-			"tofu_enabled": {
-				Type:        schema.TypeBool,
-				Description: "Is TOFU enabled or not",
-				Optional:    true,
-				Default:     true,
+		},
+	},
+	"duplicate_ip_detection_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Duplicate IP detection",
+	}),
+	"arp_binding_limit": metadata.GetExtendedSchema(&schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Maximum number of ARP bindings",
+		Optional:     true,
+		Default:      1,
+		ValidateFunc: validation.IntBetween(1, 256),
+	}),
+	"arp_snooping_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is ARP snooping enabled or not",
+		Optional:    true,
+		Default:     true,
+	}),
+	"dhcp_snooping_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is DHCP snooping enabled or not",
+		Optional:    true,
+		Default:     true,
+	}),
+	"vmtools_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is VM tools enabled or not",
+		Optional:    true,
+		Default:     true,
+	}),
+	"dhcp_snooping_v6_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is DHCP snoping v6 enabled or not",
+		Optional:    true,
+		Default:     false,
+	}),
+	"nd_snooping_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is ND snooping enabled or not",
+		Optional:    true,
+		Default:     false,
+	}),
+	"nd_snooping_limit": metadata.GetExtendedSchema(&schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "Maximum number of ND (Neighbor Discovery Protocol) bindings",
+		Optional:     true,
+		ValidateFunc: validation.IntBetween(2, 15),
+		Default:      3,
+	}),
+	"vmtools_v6_enabled": metadata.GetExtendedSchema(&schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Is VM tools enabled or not",
+		Optional:    true,
+		Default:     false,
+	}),
+	"tofu_enabled": {
+		Schema: schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Is TOFU enabled or not",
+			Optional:    true,
+			Default:     true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "TofuEnabled",
+			TestData: metadata.Testdata{
+				CreateValue: "false",
+				UpdateValue: "true",
 			},
 		},
-	}
+	},
 }
 
-func ipDiscoveryProfileObjFromSchema(d *schema.ResourceData) model.IPDiscoveryProfile {
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
+var ipDiscoveryProfileResource = policyMetadataResource[model.IPDiscoveryProfile]{
+	name:          "IPDiscoveryProfile",
+	schema:        ipDiscoveryProfileSchema,
+	newClient:     newPolicyResourceClientFactory[model.IPDiscoveryProfile](infra.NewIpDiscoveryProfilesClient),
+	override:      &policyOverrideFalse,
+	schemaToModel: ipDiscoveryProfileSchemaToModel,
+	modelToSchema: ipDiscoveryProfileModelToSchema,
+}
 
-	arpNdBindingTimeout := int64(d.Get("arp_nd_binding_timeout").(int))
-	duplicateIPDetectionEnabled := d.Get("duplicate_ip_detection_enabled").(bool)
+func resourceNsxtPolicyIPDiscoveryProfile() *schema.Resource {
+	return ipDiscoveryProfileResource.resource()
+}
 
+func resourceNsxtPolicyIPDiscoveryProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return ipDiscoveryProfileResource.exists(sessionContext, id, connector)
+}
+
+func ipDiscoveryProfileSchemaToModel(d *schema.ResourceData, obj *model.IPDiscoveryProfile) error {
+	duplicateIPDetectionEnabled := d.Get("duplicate_ip_detection_enabled").(bool)
 	arpBindingLimit := int64(d.Get("arp_binding_limit").(int))
 	arpSnoopingEnabled := d.Get("arp_snooping_enabled").(bool)
 	dhcpSnoopingEnabled := d.Get("dhcp_snooping_enabled").(bool)
 	vmtoolsEnabled := d.Get("vmtools_enabled").(bool)
-
 	dhcpSnoopingV6Enabled := d.Get("dhcp_snooping_v6_enabled").(bool)
 	ndSnoopingEnabled := d.Get("nd_snooping_enabled").(bool)
 	ndSnoopingLimit := int64(d.Get("nd_snooping_limit").(int))
 	vmtoolsV6Enabled := d.Get("vmtools_v6_enabled").(bool)
-	toFuEnabled := d.Get("tofu_enabled").(bool)
 
-	return model.IPDiscoveryProfile{
-		DisplayName:         &displayName,
-		Description:         &description,
-		Tags:                tags,
-		ArpNdBindingTimeout: &arpNdBindingTimeout,
-		DuplicateIpDetection: &model.DuplicateIPDetectionOptions{
-			DuplicateIpDetectionEnabled: &duplicateIPDetectionEnabled,
-		},
-		IpV4DiscoveryOptions: &model.IPv4DiscoveryOptions{
-			ArpSnoopingConfig: &model.ArpSnoopingConfig{
-				ArpBindingLimit:    &arpBindingLimit,
-				ArpSnoopingEnabled: &arpSnoopingEnabled,
-			},
-			DhcpSnoopingEnabled: &dhcpSnoopingEnabled,
-			VmtoolsEnabled:      &vmtoolsEnabled,
+	obj.DuplicateIpDetection = &model.DuplicateIPDetectionOptions{
+		DuplicateIpDetectionEnabled: &duplicateIPDetectionEnabled,
+	}
+	obj.IpV4DiscoveryOptions = &model.IPv4DiscoveryOptions{
+		ArpSnoopingConfig: &model.ArpSnoopingConfig{
+			ArpBindingLimit:    &arpBindingLimit,
+			ArpSnoopingEnabled: &arpSnoopingEnabled,
 		},
-		IpV6DiscoveryOptions: &model.IPv6DiscoveryOptions{
-			DhcpSnoopingV6Enabled: &dhcpSnoopingV6Enabled,
-			NdSnoopingConfig: &model.NdSnoopingConfig{
-				NdSnoopingEnabled: &ndSnoopingEnabled,
-				NdSnoopingLimit:   &ndSnoopingLimit,
-			},
-			VmtoolsV6Enabled: &vmtoolsV6Enabled,
+		DhcpSnoopingEnabled: &dhcpSnoopingEnabled,
+		VmtoolsEnabled:      &vmtoolsEnabled,
+	}
+	obj.IpV6DiscoveryOptions = &model.IPv6DiscoveryOptions{
+		DhcpSnoopingV6Enabled: &dhcpSnoopingV6Enabled,
+		NdSnoopingConfig: &model.NdSnoopingConfig{
+			NdSnoopingEnabled: &ndSnoopingEnabled,
+			NdSnoopingLimit:   &ndSnoopingLimit,
 		},
-		TofuEnabled: &toFuEnabled,
-	}
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
-	client := infra.NewIpDiscoveryProfilesClient(sessionContext, connector)
-	if client == nil {
-		return false, policyResourceNotSupportedError()
-	}
-	_, err := client.Get(id)
-	if err == nil {
-		return true, nil
-	}
-
-	if isNotFoundError(err) {
-		return false, nil
-	}
-
-	return false, logAPIError("Error retrieving resource", err)
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIPDiscoveryProfileExists)
-	if err != nil {
-		return err
-	}
-
-	obj := ipDiscoveryProfileObjFromSchema(d)
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Creating IPDiscoveryProfile with ID %s", id)
-	boolFalse := false
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err = client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleCreateError("IPDiscoveryProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+		VmtoolsV6Enabled: &vmtoolsV6Enabled,
 	}
-
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "IPDiscoveryProfile", id, err)
-	}
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-
-	d.Set("arp_nd_binding_timeout", obj.ArpNdBindingTimeout)
-	d.Set("duplicate_ip_detection_enabled", obj.DuplicateIpDetection.DuplicateIpDetectionEnabled)
-	d.Set("arp_binding_limit", obj.IpV4DiscoveryOptions.ArpSnoopingConfig.ArpBindingLimit)
-	d.Set("arp_snooping_enabled", obj.IpV4DiscoveryOptions.ArpSnoopingConfig.ArpSnoopingEnabled)
-	d.Set("dhcp_snooping_enabled", obj.IpV4DiscoveryOptions.DhcpSnoopingEnabled)
-	d.Set("vmtools_enabled", obj.IpV4DiscoveryOptions.VmtoolsEnabled)
-	d.Set("dhcp_snooping_v6_enabled", obj.IpV6DiscoveryOptions.DhcpSnoopingV6Enabled)
-	d.Set("nd_snooping_enabled", obj.IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingEnabled)
-	d.Set("nd_snooping_limit", obj.IpV6DiscoveryOptions.NdSnoopingConfig.NdSnoopingLimit)
-	d.Set("vmtools_v6_enabled", obj.IpV6DiscoveryOptions.VmtoolsV6Enabled)
-	d.Set("tofu_enabled", obj.TofuEnabled)
-
 	return nil
 }
 
-func resourceNsxtPolicyIPDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
-	}
-
-	// Read the rest of the configured parameters
-	obj := ipDiscoveryProfileObjFromSchema(d)
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Updating IPDiscoveryProfile with ID %s", id)
-	boolFalse := false
-	err := client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleUpdateError("IPDiscoveryProfile", id, err)
-	}
-
-	return resourceNsxtPolicyIPDiscoveryProfileRead(d, m)
-}
-
-func resourceNsxtPolicyIPDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining IPDiscoveryProfile ID")
+func ipDiscoveryProfileModelToSchema(d *schema.ResourceData, obj *model.IPDiscoveryProfile) error {
+	if obj.DuplicateIpDetection != nil {
+		d.Set("duplicate_ip_detection_enabled", obj.DuplicateIpDetection.DuplicateIpDetectionEnabled)
+	}
+	if v4Options := obj.IpV4DiscoveryOptions; v4Options != nil {
+		if v4Options.ArpSnoopingConfig != nil {
+			d.Set("arp_binding_limit", v4Options.ArpSnoopingConfig.ArpBindingLimit)
+			d.Set("arp_snooping_enabled", v4Options.ArpSnoopingConfig.ArpSnoopingEnabled)
+		}
+		d.Set("dhcp_snooping_enabled", v4Options.DhcpSnoopingEnabled)
+		d.Set("vmtools_enabled", v4Options.VmtoolsEnabled)
+	}
+	if v6Options := obj.IpV6DiscoveryOptions; v6Options != nil {
+		d.Set("dhcp_snooping_v6_enabled", v6Options.DhcpSnoopingV6Enabled)
+		if v6Options.NdSnoopingConfig != nil {
+			d.Set("nd_snooping_enabled", v6Options.NdSnoopingConfig.NdSnoopingEnabled)
+			d.Set("nd_snooping_limit", v6Options.NdSnoopingConfig.NdSnoopingLimit)
+		}
+		d.Set("vmtools_v6_enabled", v6Options.VmtoolsV6Enabled)
 	}
-
-	var err error
-	connector := getPolicyConnector(m)
-	boolFalse := false
-
-	client := infra.NewIpDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err = client.Delete(id, &boolFalse)
-
-	if err != nil {
-		return handleDeleteError("IPDiscoveryProfile", id, err)
-	}
-
 	return nil
 }
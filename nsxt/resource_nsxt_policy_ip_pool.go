@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyIPPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolCreate,
-		Read:   resourceNsxtPolicyIPPoolRead,
-		Update: resourceNsxtPolicyIPPoolUpdate,
-		Delete: resourceNsxtPolicyIPPoolDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPPoolCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPPoolRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPPoolUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
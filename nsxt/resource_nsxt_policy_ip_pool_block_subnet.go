@@ -25,13 +25,16 @@ import (
 
 func resourceNsxtPolicyIPPoolBlockSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolBlockSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolBlockSubnetRead,
-		Update: resourceNsxtPolicyIPPoolBlockSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolBlockSubnetDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPPoolBlockSubnetCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPPoolBlockSubnetRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPPoolBlockSubnetUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPPoolBlockSubnetDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPPoolSubnetImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"nsx_id":       getNsxIDSchema(),
@@ -226,11 +229,11 @@ func resourceNsxtPolicyIPPoolBlockSubnetDelete(d *schema.ResourceData, m interfa
 		return handleDeleteError("Block Subnet", id, err)
 	}
 
-	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(getSessionContext(d, m), d, connector)
+	return resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(getSessionContext(d, m), d, m, connector)
 }
 
 // NOTE: This will not be needed when IPAM is handled by NSXT Policy
-func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(sessionContext utl.SessionContext, d *schema.ResourceData, connector client.Connector) error {
+func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(sessionContext utl.SessionContext, d *schema.ResourceData, m interface{}, connector client.Connector) error {
 
 	client := realizedstate.NewRealizedEntitiesClient(sessionContext, connector)
 	if client == nil {
@@ -262,7 +265,7 @@ func resourceNsxtPolicyIPPoolBlockSubnetVerifyDelete(sessionContext utl.SessionC
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to confirm delete realization for %s: %v", path, err)
 	}
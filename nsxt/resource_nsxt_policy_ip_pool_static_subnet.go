@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyIPPoolStaticSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPPoolStaticSubnetCreate,
-		Read:   resourceNsxtPolicyIPPoolStaticSubnetRead,
-		Update: resourceNsxtPolicyIPPoolStaticSubnetUpdate,
-		Delete: resourceNsxtPolicyIPPoolStaticSubnetDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPPoolStaticSubnetCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPPoolStaticSubnetRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPPoolStaticSubnetUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPPoolStaticSubnetDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPPoolSubnetImport,
 		},
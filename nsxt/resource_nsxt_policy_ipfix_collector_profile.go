@@ -0,0 +1,212 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyIpfixCollectorProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyIpfixCollectorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIpfixCollectorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIpfixCollectorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIpfixCollectorProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"collector": {
+				Type:        schema.TypeList,
+				Description: "IPFIX collectors to export DFW flow records to",
+				Required:    true,
+				MaxItems:    4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Description:  "IP address of the IPFIX collector",
+							Required:     true,
+							ValidateFunc: validateSingleIP(),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "Port of the IPFIX collector",
+							Optional:     true,
+							Default:      4739,
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixCollectorProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewIpfixDfwCollectorProfilesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyIpfixCollectorsFromSchema(d *schema.ResourceData) []model.IPFIXDFWCollector {
+	var collectors []model.IPFIXDFWCollector
+	for _, item := range d.Get("collector").([]interface{}) {
+		data := item.(map[string]interface{})
+		ipAddress := data["ip_address"].(string)
+		port := int64(data["port"].(int))
+		collectors = append(collectors, model.IPFIXDFWCollector{
+			CollectorIpAddress: &ipAddress,
+			CollectorPort:      &port,
+		})
+	}
+	return collectors
+}
+
+func setPolicyIpfixCollectorsInSchema(d *schema.ResourceData, collectors []model.IPFIXDFWCollector) {
+	var result []map[string]interface{}
+	for _, item := range collectors {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = item.CollectorIpAddress
+		elem["port"] = item.CollectorPort
+		result = append(result, elem)
+	}
+	d.Set("collector", result)
+}
+
+func resourceNsxtPolicyIpfixCollectorProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+
+	obj := model.IPFIXDFWCollectorProfile{
+		DisplayName:        &displayName,
+		Description:        &description,
+		Tags:               tags,
+		IpfixDfwCollectors: getPolicyIpfixCollectorsFromSchema(d),
+	}
+
+	log.Printf("[INFO] Patching IPFIX Collector Profile with ID %s", id)
+	client := infra.NewIpfixDfwCollectorProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyIpfixCollectorProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIpfixCollectorProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIpfixCollectorProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IPFIX Collector Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixCollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixCollectorProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX Collector Profile ID")
+	}
+
+	client := infra.NewIpfixDfwCollectorProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IPFIX Collector Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	setPolicyIpfixCollectorsInSchema(d, obj.IpfixDfwCollectors)
+
+	return nil
+}
+
+func resourceNsxtPolicyIpfixCollectorProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX Collector Profile ID")
+	}
+
+	err := resourceNsxtPolicyIpfixCollectorProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IPFIX Collector Profile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixCollectorProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixCollectorProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX Collector Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewIpfixDfwCollectorProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("IPFIX Collector Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpfixCollectorProfileCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"ip_address":   "192.168.10.10",
+	"port":         "4739",
+}
+
+var accTestPolicyIpfixCollectorProfileUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"ip_address":   "192.168.10.20",
+	"port":         "2055",
+}
+
+func TestAccResourceNsxtPolicyIpfixCollectorProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_collector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixCollectorProfileCheckDestroy(state, accTestPolicyIpfixCollectorProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixCollectorProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixCollectorProfileExists(accTestPolicyIpfixCollectorProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpfixCollectorProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpfixCollectorProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.ip_address", accTestPolicyIpfixCollectorProfileCreateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.port", accTestPolicyIpfixCollectorProfileCreateAttributes["port"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixCollectorProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixCollectorProfileExists(accTestPolicyIpfixCollectorProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpfixCollectorProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpfixCollectorProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "collector.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.ip_address", accTestPolicyIpfixCollectorProfileUpdateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "collector.0.port", accTestPolicyIpfixCollectorProfileUpdateAttributes["port"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpfixCollectorProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_collector_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixCollectorProfileCheckDestroy(state, accTestPolicyIpfixCollectorProfileCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixCollectorProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpfixCollectorProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IPFIX Collector Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IPFIX Collector Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIpfixCollectorProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IPFIX Collector Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIpfixCollectorProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipfix_collector_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIpfixCollectorProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IPFIX Collector Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIpfixCollectorProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpfixCollectorProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpfixCollectorProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipfix_collector_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+
+  collector {
+    ip_address = "%s"
+    port       = %s
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["ip_address"], attrMap["port"])
+}
+
+func TestPolicyIpfixProfilesCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "IPFIX collector profile",
+			resource: resourceNsxtPolicyIpfixCollectorProfile(),
+			config: map[string]interface{}{
+				"nsx_id":       "collectors1",
+				"display_name": "collectors1",
+				"collector": []interface{}{
+					map[string]interface{}{"ip_address": "192.168.10.10"},
+					map[string]interface{}{"ip_address": "192.168.10.20", "port": 2055},
+				},
+			},
+			path: "/infra/ipfix-dfw-collector-profiles/collectors1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				collectors, _ := obj["ipfix_dfw_collectors"].([]interface{})
+				if len(collectors) != 2 {
+					t.Fatalf("unexpected IPFIX collector profile on NSX: %v", obj)
+				}
+				if first := collectors[0].(map[string]interface{}); first["collector_ip_address"] != "192.168.10.10" || fmt.Sprint(first["collector_port"]) != "4739" {
+					t.Errorf("unexpected IPFIX collector on NSX: %v", first)
+				}
+				if d.Get("collector.1.port") != 2055 {
+					t.Errorf("unexpected state: %v", d.State())
+				}
+			},
+		},
+		{
+			name:     "IPFIX DFW profile",
+			resource: resourceNsxtPolicyIpfixDfwProfile(),
+			config: map[string]interface{}{
+				"nsx_id":                 "dfw1",
+				"display_name":           "dfw1",
+				"collector_profile_path": "/infra/ipfix-dfw-collector-profiles/collectors1",
+				"observation_domain_id":  100,
+				"priority":               5,
+			},
+			path: "/infra/ipfix-dfw-profiles/dfw1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["ipfix_dfw_collector_profile_path"] != "/infra/ipfix-dfw-collector-profiles/collectors1" ||
+					fmt.Sprint(obj["observation_domain_id"]) != "100" || fmt.Sprint(obj["active_flow_export_timeout"]) != "1" {
+					t.Errorf("unexpected IPFIX DFW profile on NSX: %v", obj)
+				}
+			},
+		},
+	})
+}
@@ -0,0 +1,200 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicyIpfixDfwProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyIpfixDfwProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIpfixDfwProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIpfixDfwProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIpfixDfwProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"collector_profile_path": {
+				Type:         schema.TypeString,
+				Description:  "Policy path of IPFIX collector profile",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"active_flow_export_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Interval in minutes after which active flows are exported",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"observation_domain_id": {
+				Type:         schema.TypeInt,
+				Description:  "Identifier of the exporting process observation domain",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Description:  "Priority of this profile, used to resolve conflicts when multiple profiles apply",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 65535),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyIpfixDfwProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewIpfixDfwProfilesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicyIpfixDfwProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	collectorProfilePath := d.Get("collector_profile_path").(string)
+	activeFlowExportTimeout := int64(d.Get("active_flow_export_timeout").(int))
+	observationDomainID := int64(d.Get("observation_domain_id").(int))
+	priority := int64(d.Get("priority").(int))
+
+	obj := model.IPFIXDFWProfile{
+		DisplayName:                  &displayName,
+		Description:                  &description,
+		Tags:                         tags,
+		IpfixDfwCollectorProfilePath: &collectorProfilePath,
+		ActiveFlowExportTimeout:      &activeFlowExportTimeout,
+		ObservationDomainId:          &observationDomainID,
+		Priority:                     &priority,
+	}
+
+	log.Printf("[INFO] Patching IPFIX DFW Profile with ID %s", id)
+	client := infra.NewIpfixDfwProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyIpfixDfwProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyIpfixDfwProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("IPFIX DFW Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyIpfixDfwProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX DFW Profile ID")
+	}
+
+	client := infra.NewIpfixDfwProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "IPFIX DFW Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("collector_profile_path", obj.IpfixDfwCollectorProfilePath)
+	d.Set("active_flow_export_timeout", obj.ActiveFlowExportTimeout)
+	d.Set("observation_domain_id", obj.ObservationDomainId)
+	d.Set("priority", obj.Priority)
+
+	return nil
+}
+
+func resourceNsxtPolicyIpfixDfwProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX DFW Profile ID")
+	}
+
+	err := resourceNsxtPolicyIpfixDfwProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("IPFIX DFW Profile", id, err)
+	}
+
+	return resourceNsxtPolicyIpfixDfwProfileRead(d, m)
+}
+
+func resourceNsxtPolicyIpfixDfwProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining IPFIX DFW Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewIpfixDfwProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("IPFIX DFW Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyIpfixDfwProfileCreateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform created",
+	"active_flow_export_timeout": "5",
+	"observation_domain_id":      "100",
+	"priority":                   "1",
+}
+
+var accTestPolicyIpfixDfwProfileUpdateAttributes = map[string]string{
+	"display_name":               getAccTestResourceName(),
+	"description":                "terraform updated",
+	"active_flow_export_timeout": "10",
+	"observation_domain_id":      "200",
+	"priority":                   "2",
+}
+
+func TestAccResourceNsxtPolicyIpfixDfwProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_dfw_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state, accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixDfwProfileExists(accTestPolicyIpfixDfwProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpfixDfwProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpfixDfwProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "active_flow_export_timeout", accTestPolicyIpfixDfwProfileCreateAttributes["active_flow_export_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "observation_domain_id", accTestPolicyIpfixDfwProfileCreateAttributes["observation_domain_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "priority", accTestPolicyIpfixDfwProfileCreateAttributes["priority"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "collector_profile_path", "nsxt_policy_ipfix_collector_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyIpfixDfwProfileExists(accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyIpfixDfwProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyIpfixDfwProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "active_flow_export_timeout", accTestPolicyIpfixDfwProfileUpdateAttributes["active_flow_export_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "observation_domain_id", accTestPolicyIpfixDfwProfileUpdateAttributes["observation_domain_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "priority", accTestPolicyIpfixDfwProfileUpdateAttributes["priority"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyIpfixDfwProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_ipfix_dfw_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state, accTestPolicyIpfixDfwProfileCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyIpfixDfwProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyIpfixDfwProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy IPFIX DFW Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy IPFIX DFW Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyIpfixDfwProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy IPFIX DFW Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyIpfixDfwProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_ipfix_dfw_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyIpfixDfwProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy IPFIX DFW Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyIpfixDfwProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyIpfixDfwProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyIpfixDfwProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_ipfix_collector_profile" "test" {
+  display_name = "%s"
+
+  collector {
+    ip_address = "192.168.10.10"
+  }
+}
+
+resource "nsxt_policy_ipfix_dfw_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  collector_profile_path = nsxt_policy_ipfix_collector_profile.test.path
+
+  active_flow_export_timeout = %s
+  observation_domain_id      = %s
+  priority                   = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["display_name"], attrMap["description"], attrMap["active_flow_export_timeout"], attrMap["observation_domain_id"], attrMap["priority"])
+}
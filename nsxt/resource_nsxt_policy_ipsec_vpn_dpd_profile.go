@@ -21,10 +21,10 @@ var iPSecVpnDpdProfileDpdProbeModeValues = []string{
 
 func resourceNsxtPolicyIPSecVpnDpdProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnDpdProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnDpdProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnDpdProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnDpdProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnDpdProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnDpdProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnDpdProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnDpdProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
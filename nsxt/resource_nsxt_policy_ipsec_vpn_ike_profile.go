@@ -48,10 +48,10 @@ var iPSecVpnIkeProfileIkeVersionValues = []string{
 
 func resourceNsxtPolicyIPSecVpnIkeProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnIkeProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnIkeProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnIkeProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnIkeProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnIkeProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnIkeProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnIkeProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnIkeProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -20,10 +20,10 @@ import (
 
 func resourceNsxtPolicyIPSecVpnLocalEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnLocalEndpointCreate,
-		Read:   resourceNsxtPolicyIPSecVpnLocalEndpointRead,
-		Update: resourceNsxtPolicyIPSecVpnLocalEndpointUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnLocalEndpointDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnLocalEndpointCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnLocalEndpointRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnLocalEndpointUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnLocalEndpointDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtVPNServiceResourceImporter,
 		},
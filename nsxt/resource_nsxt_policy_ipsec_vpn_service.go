@@ -28,10 +28,10 @@ var IPSecVpnServiceIkeLogLevelTypes = []string{
 
 func resourceNsxtPolicyIPSecVpnService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnServiceCreate,
-		Read:   resourceNsxtPolicyIPSecVpnServiceRead,
-		Update: resourceNsxtPolicyIPSecVpnServiceUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnServiceDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnServiceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnServiceRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnServiceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyIPSecVpnServiceImport,
 		},
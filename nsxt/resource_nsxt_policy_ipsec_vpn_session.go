@@ -64,10 +64,10 @@ var IPSecRulesActionValues = []string{
 
 func resourceNsxtPolicyIPSecVpnSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnSessionCreate,
-		Read:   resourceNsxtPolicyIPSecVpnSessionRead,
-		Update: resourceNsxtPolicyIPSecVpnSessionUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnSessionDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnSessionCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnSessionRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnSessionUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnSessionDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtVpnSessionImporter,
 		},
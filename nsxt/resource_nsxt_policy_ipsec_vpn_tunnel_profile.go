@@ -51,10 +51,10 @@ var ipSecVpnTunnelProfileEncryptionAlgorithmsValues = []string{
 
 func resourceNsxtPolicyIPSecVpnTunnelProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyIPSecVpnTunnelProfileCreate,
-		Read:   resourceNsxtPolicyIPSecVpnTunnelProfileRead,
-		Update: resourceNsxtPolicyIPSecVpnTunnelProfileUpdate,
-		Delete: resourceNsxtPolicyIPSecVpnTunnelProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyIPSecVpnTunnelProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyIPSecVpnTunnelProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyIPSecVpnTunnelProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyIPSecVpnTunnelProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -25,10 +25,10 @@ var L2VpnServiceModes = []string{
 
 func resourceNsxtPolicyL2VpnService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VpnServiceCreate,
-		Read:   resourceNsxtPolicyL2VpnServiceRead,
-		Update: resourceNsxtPolicyL2VpnServiceUpdate,
-		Delete: resourceNsxtPolicyL2VpnServiceDelete,
+		CreateContext: withContext(resourceNsxtPolicyL2VpnServiceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyL2VpnServiceRead),
+		UpdateContext: withContext(resourceNsxtPolicyL2VpnServiceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyL2VpnServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyL2VpnServiceImport,
 		},
@@ -30,10 +30,10 @@ var L2VpnTunnelEncapsulationProtocal = []string{
 
 func resourceNsxtPolicyL2VPNSession() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyL2VPNSessionCreate,
-		Read:   resourceNsxtPolicyL2VPNSessionRead,
-		Update: resourceNsxtPolicyL2VPNSessionUpdate,
-		Delete: resourceNsxtPolicyL2VPNSessionDelete,
+		CreateContext: withContext(resourceNsxtPolicyL2VPNSessionCreate),
+		ReadContext:   withContext(resourceNsxtPolicyL2VPNSessionRead),
+		UpdateContext: withContext(resourceNsxtPolicyL2VPNSessionUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyL2VPNSessionDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtVpnSessionImporter,
 		},
@@ -23,10 +23,10 @@ var lBClientSslProfileCipherGroupLabelValues = []string{
 
 func resourceNsxtPolicyLBClientSslProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBClientSslProfileCreate,
-		Read:   resourceNsxtPolicyLBClientSslProfileRead,
-		Update: resourceNsxtPolicyLBClientSslProfileUpdate,
-		Delete: resourceNsxtPolicyLBClientSslProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBClientSslProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBClientSslProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBClientSslProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBClientSslProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -0,0 +1,337 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBCookiePersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyLBCookiePersistenceProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBCookiePersistenceProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBCookiePersistenceProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBCookiePersistenceProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":             getNsxIDSchema(),
+			"path":               getPathSchema(),
+			"display_name":       getDisplayNameSchema(),
+			"description":        getDescriptionSchema(),
+			"revision":           getRevisionSchema(),
+			"tag":                getTagsSchema(),
+			"persistence_shared": getPolicyLbPersistenceSharedSchema(),
+			"cookie_domain": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie domain, only relevant for INSERT mode",
+				Optional:    true,
+			},
+			"cookie_fallback": {
+				Type:        schema.TypeBool,
+				Description: "If enabled, once the server pointed by the cookie is down, a new server is selected. Otherwise the request is rejected",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_garble": {
+				Type:        schema.TypeBool,
+				Description: "Whether the cookie value (server IP and port) is encrypted",
+				Optional:    true,
+				Default:     true,
+			},
+			"cookie_httponly": {
+				Type:        schema.TypeBool,
+				Description: "Whether HttpOnly flag is set on the cookie, only relevant for INSERT mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_mode": {
+				Type:         schema.TypeString,
+				Description:  "Cookie persistence mode",
+				Optional:     true,
+				Default:      model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT,
+				ValidateFunc: validation.StringInSlice(cookieModeTypes, false),
+			},
+			"cookie_name": {
+				Type:        schema.TypeString,
+				Description: "Cookie name",
+				Optional:    true,
+				Default:     "NSXLB",
+			},
+			"cookie_path": {
+				Type:        schema.TypeString,
+				Description: "HTTP cookie path, only relevant for INSERT mode",
+				Optional:    true,
+			},
+			"cookie_secure": {
+				Type:        schema.TypeBool,
+				Description: "Whether Secure flag is set on the cookie, only relevant for INSERT mode",
+				Optional:    true,
+				Default:     false,
+			},
+			"cookie_time": {
+				Type:        schema.TypeList,
+				Description: "Cookie expiration settings, only relevant for INSERT mode",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of cookie expiration timing",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(cookieExpiryTypes, false),
+						},
+						"max_idle": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the last time it was seen in a request",
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_life": {
+							Type:         schema.TypeInt,
+							Description:  "Maximum interval in seconds the cookie is valid for from the first time it was seen in a request, only relevant for SESSION_COOKIE_TIME type",
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyLbCookieTimeFromSchema(d *schema.ResourceData) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	cookieTimes := d.Get("cookie_time").([]interface{})
+	for _, cookieTime := range cookieTimes {
+		cookieTimeMap := cookieTime.(map[string]interface{})
+		maxIdle := int64(cookieTimeMap["max_idle"].(int))
+
+		if cookieTimeMap["type"].(string) == "PERSISTENCE_COOKIE_TIME" {
+			entry := model.LBPersistenceCookieTime{
+				Type_:         model.LBPersistenceCookieTime__TYPE_IDENTIFIER,
+				CookieMaxIdle: &maxIdle,
+			}
+
+			dataValue, errs := converter.ConvertToVapi(entry, model.LBPersistenceCookieTimeBindingType())
+			if errs != nil {
+				return nil, errs[0]
+			}
+
+			return dataValue.(*data.StructValue), nil
+		}
+
+		entry := model.LBSessionCookieTime{
+			Type_:         model.LBSessionCookieTime__TYPE_IDENTIFIER,
+			CookieMaxIdle: &maxIdle,
+		}
+		maxLife := int64(cookieTimeMap["max_life"].(int))
+		if maxLife > 0 {
+			entry.CookieMaxLife = &maxLife
+		}
+
+		dataValue, errs := converter.ConvertToVapi(entry, model.LBSessionCookieTimeBindingType())
+		if errs != nil {
+			return nil, errs[0]
+		}
+
+		return dataValue.(*data.StructValue), nil
+	}
+
+	return nil, nil
+}
+
+func setPolicyLbCookieTimeInSchema(d *schema.ResourceData, cookieTime *data.StructValue) error {
+	if cookieTime == nil {
+		return d.Set("cookie_time", nil)
+	}
+
+	converter := bindings.NewTypeConverter()
+	var cookieTimeList []map[string]interface{}
+	elem := make(map[string]interface{})
+
+	basicType, errs := converter.ConvertToGolang(cookieTime, model.LBCookieTimeBindingType())
+	if errs != nil {
+		return errs[0]
+	}
+
+	cookieTimeType := basicType.(model.LBCookieTime).Type_
+	if cookieTimeType == model.LBPersistenceCookieTime__TYPE_IDENTIFIER {
+		data, errs := converter.ConvertToGolang(cookieTime, model.LBPersistenceCookieTimeBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		persistenceTime := data.(model.LBPersistenceCookieTime)
+		elem["type"] = "PERSISTENCE_COOKIE_TIME"
+		elem["max_idle"] = persistenceTime.CookieMaxIdle
+	} else {
+		if cookieTimeType != model.LBSessionCookieTime__TYPE_IDENTIFIER {
+			return fmt.Errorf("Unrecognized Cookie Time type %s", cookieTimeType)
+		}
+		data, errs := converter.ConvertToGolang(cookieTime, model.LBSessionCookieTimeBindingType())
+		if errs != nil {
+			return errs[0]
+		}
+		sessionTime := data.(model.LBSessionCookieTime)
+		elem["type"] = "SESSION_COOKIE_TIME"
+		elem["max_idle"] = sessionTime.CookieMaxIdle
+		elem["max_life"] = sessionTime.CookieMaxLife
+	}
+
+	cookieTimeList = append(cookieTimeList, elem)
+	return d.Set("cookie_time", cookieTimeList)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	cookieDomain := d.Get("cookie_domain").(string)
+	cookieFallback := d.Get("cookie_fallback").(bool)
+	cookieGarble := d.Get("cookie_garble").(bool)
+	cookieHttponly := d.Get("cookie_httponly").(bool)
+	cookieMode := d.Get("cookie_mode").(string)
+	cookieName := d.Get("cookie_name").(string)
+	cookiePath := d.Get("cookie_path").(string)
+	cookieSecure := d.Get("cookie_secure").(bool)
+	cookieTime, err := getPolicyLbCookieTimeFromSchema(d)
+	if err != nil {
+		return err
+	}
+	obj := model.LBCookiePersistenceProfile{
+		DisplayName:       &displayName,
+		Description:       &description,
+		Tags:              tags,
+		PersistenceShared: &persistenceShared,
+		CookieFallback:    &cookieFallback,
+		CookieGarble:      &cookieGarble,
+		CookieMode:        &cookieMode,
+		CookieName:        &cookieName,
+		ResourceType:      model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE,
+	}
+
+	// Cookie attributes below are only accepted by NSX in INSERT mode
+	if cookieMode == model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT {
+		obj.CookieHttponly = &cookieHttponly
+		obj.CookieSecure = &cookieSecure
+		obj.CookieTime = cookieTime
+		if len(cookieDomain) > 0 {
+			obj.CookieDomain = &cookieDomain
+		}
+		if len(cookiePath) > 0 {
+			obj.CookiePath = &cookiePath
+		}
+	} else if cookieTime != nil || len(cookieDomain) > 0 || len(cookiePath) > 0 {
+		return fmt.Errorf("cookie_domain, cookie_path and cookie_time are only supported with %s cookie_mode", model.LBCookiePersistenceProfile_COOKIE_MODE_INSERT)
+	}
+
+	log.Printf("[INFO] Patching LBCookiePersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBCookiePersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBCookiePersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBCookiePersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBCookiePersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBCookiePersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBCookiePersistenceProfile)
+	if profile.ResourceType != model.LBPersistenceProfile_RESOURCE_TYPE_LBCOOKIEPERSISTENCEPROFILE {
+		return fmt.Errorf("LBPersistenceProfile with id %s is of type %s, expected LBCookiePersistenceProfile", id, profile.ResourceType)
+	}
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("persistence_shared", profile.PersistenceShared)
+	d.Set("cookie_domain", profile.CookieDomain)
+	d.Set("cookie_fallback", profile.CookieFallback)
+	d.Set("cookie_garble", profile.CookieGarble)
+	d.Set("cookie_httponly", profile.CookieHttponly)
+	d.Set("cookie_mode", profile.CookieMode)
+	d.Set("cookie_name", profile.CookieName)
+	d.Set("cookie_path", profile.CookiePath)
+	d.Set("cookie_secure", profile.CookieSecure)
+
+	return setPolicyLbCookieTimeInSchema(d, profile.CookieTime)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBCookiePersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBCookiePersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBCookiePersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBCookiePersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBCookiePersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyLBPersistenceProfileDelete(d, m)
+}
@@ -0,0 +1,328 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBCookiePersistenceProfileCreateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform created",
+	"cookie_name":     "test-create",
+	"cookie_domain":   ".example.com",
+	"cookie_path":     "/create",
+	"cookie_garble":   "true",
+	"cookie_fallback": "true",
+	"cookie_httponly": "true",
+	"cookie_secure":   "true",
+	"cookie_type":     "SESSION_COOKIE_TIME",
+	"max_idle":        "100",
+	"max_life":        "200",
+}
+
+var accTestPolicyLBCookiePersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":    getAccTestResourceName(),
+	"description":     "terraform updated",
+	"cookie_name":     "test-update",
+	"cookie_domain":   ".example.org",
+	"cookie_path":     "/update",
+	"cookie_garble":   "false",
+	"cookie_fallback": "false",
+	"cookie_httponly": "false",
+	"cookie_secure":   "false",
+	"cookie_type":     "PERSISTENCE_COOKIE_TIME",
+	"max_idle":        "300",
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPersistenceProfileCheckDestroy(state, "nsxt_policy_lb_cookie_persistence_profile", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", "INSERT"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_domain", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_domain"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_path", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_path"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_httponly", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_httponly"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_secure", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_secure"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.type", accTestPolicyLBCookiePersistenceProfileCreateAttributes["cookie_type"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.max_idle", accTestPolicyLBCookiePersistenceProfileCreateAttributes["max_idle"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.max_life", accTestPolicyLBCookiePersistenceProfileCreateAttributes["max_life"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_name", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_domain", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_domain"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_path", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_path"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_garble", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_garble"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_fallback", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_fallback"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_httponly", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_httponly"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_secure", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_secure"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.type", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["cookie_type"]),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.0.max_idle", accTestPolicyLBCookiePersistenceProfileUpdateAttributes["max_idle"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_mode", "PREFIX"),
+					resource.TestCheckResourceAttr(testResourceName, "cookie_time.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBCookiePersistenceProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_cookie_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPersistenceProfileCheckDestroy(state, "nsxt_policy_lb_cookie_persistence_profile", name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBCookiePersistenceProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBPersistenceProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBPersistenceProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBPersistenceProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBPersistenceProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBPersistenceProfileCheckDestroy(state *terraform.State, resourceType string, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != resourceType {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBPersistenceProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LBPersistenceProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBCookiePersistenceProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBCookiePersistenceProfileUpdateAttributes
+	}
+	maxLife := ""
+	if attrMap["max_life"] != "" {
+		maxLife = fmt.Sprintf("max_life = %s", attrMap["max_life"])
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name    = "%s"
+  description     = "%s"
+  cookie_name     = "%s"
+  cookie_domain   = "%s"
+  cookie_path     = "%s"
+  cookie_garble   = %s
+  cookie_fallback = %s
+  cookie_httponly = %s
+  cookie_secure   = %s
+
+  cookie_time {
+    type     = "%s"
+    max_idle = %s
+    %s
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["cookie_name"], attrMap["cookie_domain"], attrMap["cookie_path"], attrMap["cookie_garble"], attrMap["cookie_fallback"], attrMap["cookie_httponly"], attrMap["cookie_secure"], attrMap["cookie_type"], attrMap["max_idle"], maxLife)
+}
+
+func testAccNsxtPolicyLBCookiePersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_cookie_persistence_profile" "test" {
+  display_name = "%s"
+  cookie_mode  = "PREFIX"
+}`, accTestPolicyLBCookiePersistenceProfileUpdateAttributes["display_name"])
+}
+
+func TestPolicyLBPersistenceProfilesCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	r := resourceNsxtPolicyLBCookiePersistenceProfile()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "cookie profile",
+			resource: r,
+			config: map[string]interface{}{
+				"nsx_id":        "cookie1",
+				"display_name":  "cookie1",
+				"cookie_domain": ".example.com",
+				"cookie_time": []interface{}{
+					map[string]interface{}{"type": "SESSION_COOKIE_TIME", "max_idle": 100, "max_life": 200},
+				},
+			},
+			path: "/infra/lb-persistence-profiles/cookie1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["resource_type"] != "LBCookiePersistenceProfile" || obj["cookie_name"] != "NSXLB" || obj["cookie_domain"] != ".example.com" {
+					t.Errorf("unexpected cookie profile on NSX: %v", obj)
+				}
+				cookieTime, _ := obj["cookie_time"].(map[string]interface{})
+				if cookieTime["type"] != "LBSessionCookieTime" || fmt.Sprint(cookieTime["cookie_max_life"]) != "200" {
+					t.Errorf("unexpected cookie time on NSX: %v", cookieTime)
+				}
+				if d.Get("cookie_time.0.type") != "SESSION_COOKIE_TIME" || d.Get("cookie_time.0.max_idle") != 100 || d.Get("cookie_time.0.max_life") != 200 {
+					t.Errorf("unexpected cookie time in state: %v", d.State())
+				}
+			},
+		},
+		{
+			// Cookie attributes are rejected outside of INSERT mode
+			name:     "cookie profile with domain in PREFIX mode",
+			resource: r,
+			config: map[string]interface{}{
+				"display_name":  "invalid",
+				"cookie_mode":   "PREFIX",
+				"cookie_domain": ".example.com",
+			},
+			createFails: true,
+		},
+		{
+			name:     "source ip profile",
+			resource: resourceNsxtPolicyLBSourceIPPersistenceProfile(),
+			config: map[string]interface{}{
+				"nsx_id":       "sourceip1",
+				"display_name": "sourceip1",
+				"purge":        "NO_PURGE",
+			},
+			path: "/infra/lb-persistence-profiles/sourceip1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if d.Get("purge") != "NO_PURGE" || d.Get("timeout") != 300 {
+					t.Errorf("unexpected source ip profile state: %v", d.State())
+				}
+
+				// Profile of a different type is not read by the resource
+				mismatch := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+				mismatch.SetId("sourceip1")
+				if diags := r.ReadContext(context.Background(), mismatch, m); !diags.HasError() {
+					t.Errorf("expected source ip profile not to be read as cookie profile")
+				}
+			},
+		},
+		{
+			name:     "generic profile",
+			resource: resourceNsxtPolicyLBGenericPersistenceProfile(),
+			config: map[string]interface{}{
+				"nsx_id":                           "generic1",
+				"display_name":                     "generic1",
+				"ha_persistence_mirroring_enabled": true,
+			},
+			path: "/infra/lb-persistence-profiles/generic1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if d.Get("ha_persistence_mirroring_enabled") != true {
+					t.Errorf("unexpected generic profile state: %v", d.State())
+				}
+
+				// Profiles are found by the data source according to their type
+				ds := dataSourceNsxtPolicyLbPersistenceProfile()
+				for profileType, expectedID := range map[string]string{"COOKIE": "cookie1", "SOURCE_IP": "sourceip1", "GENERIC": "generic1"} {
+					dsData := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+						"type": profileType,
+					})
+					if diags := ds.ReadContext(context.Background(), dsData, m); diags.HasError() {
+						t.Fatalf("data source read for %s failed: %v", profileType, diags)
+					}
+					if dsData.Id() != expectedID {
+						t.Errorf("expected %s profile %s, got %s", profileType, expectedID, dsData.Id())
+					}
+				}
+			},
+		},
+	})
+}
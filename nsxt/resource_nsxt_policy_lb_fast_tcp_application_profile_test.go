@@ -0,0 +1,268 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBFastTcpApplicationProfileCreateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform created",
+	"close_timeout":             "10",
+	"ha_flow_mirroring_enabled": "true",
+	"idle_timeout":              "100",
+}
+
+var accTestPolicyLBFastTcpApplicationProfileUpdateAttributes = map[string]string{
+	"display_name":              getAccTestResourceName(),
+	"description":               "terraform updated",
+	"close_timeout":             "20",
+	"ha_flow_mirroring_enabled": "false",
+	"idle_timeout":              "200",
+}
+
+func TestAccResourceNsxtPolicyLBFastTcpApplicationProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_fast_tcp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBAppProfileCheckDestroy(state, "nsxt_policy_lb_fast_tcp_application_profile", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastTcpApplicationProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBAppProfileExists(accTestPolicyLBFastTcpApplicationProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastTcpApplicationProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastTcpApplicationProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "close_timeout", accTestPolicyLBFastTcpApplicationProfileCreateAttributes["close_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_flow_mirroring_enabled", accTestPolicyLBFastTcpApplicationProfileCreateAttributes["ha_flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastTcpApplicationProfileCreateAttributes["idle_timeout"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastTcpApplicationProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBAppProfileExists(accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "close_timeout", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["close_timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_flow_mirroring_enabled", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["ha_flow_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["idle_timeout"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBFastTcpApplicationProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBAppProfileExists(accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "close_timeout", "8"),
+					resource.TestCheckResourceAttr(testResourceName, "idle_timeout", "1800"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBFastTcpApplicationProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_fast_tcp_application_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBAppProfileCheckDestroy(state, "nsxt_policy_lb_fast_tcp_application_profile", name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBFastTcpApplicationProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBAppProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy LBAppProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy LBAppProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy LBAppProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyLBAppProfileCheckDestroy(state *terraform.State, resourceType string, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != resourceType {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyLBAppProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy LBAppProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyLBFastTcpApplicationProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBFastTcpApplicationProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBFastTcpApplicationProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_tcp_application_profile" "test" {
+  display_name              = "%s"
+  description               = "%s"
+  close_timeout             = %s
+  ha_flow_mirroring_enabled = %s
+  idle_timeout              = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["close_timeout"], attrMap["ha_flow_mirroring_enabled"], attrMap["idle_timeout"])
+}
+
+func testAccNsxtPolicyLBFastTcpApplicationProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_fast_tcp_application_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBFastTcpApplicationProfileUpdateAttributes["display_name"])
+}
+
+func TestPolicyLBApplicationAndServerSslProfilesCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	fastTCP := resourceNsxtPolicyLBFastTcpApplicationProfile()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "fast tcp profile",
+			resource: fastTCP,
+			config: map[string]interface{}{
+				"nsx_id":                    "tcp1",
+				"display_name":              "tcp1",
+				"ha_flow_mirroring_enabled": true,
+			},
+			path: "/infra/lb-app-profiles/tcp1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["resource_type"] != "LBFastTcpProfile" || fmt.Sprint(obj["close_timeout"]) != "8" || obj["ha_flow_mirroring_enabled"] != true {
+					t.Errorf("unexpected fast tcp profile on NSX: %v", obj)
+				}
+			},
+		},
+		{
+			name:     "fast udp profile",
+			resource: resourceNsxtPolicyLBFastUdpApplicationProfile(),
+			config: map[string]interface{}{
+				"nsx_id":       "udp1",
+				"display_name": "udp1",
+				"idle_timeout": 60,
+			},
+			path: "/infra/lb-app-profiles/udp1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["resource_type"] != "LBFastUdpProfile" || fmt.Sprint(obj["idle_timeout"]) != "60" || obj["flow_mirroring_enabled"] != false {
+					t.Errorf("unexpected fast udp profile on NSX: %v", obj)
+				}
+
+				// Application profile of a different type is not read by the resource
+				mismatch := schema.TestResourceDataRaw(t, fastTCP.Schema, map[string]interface{}{})
+				mismatch.SetId("udp1")
+				if diags := fastTCP.ReadContext(context.Background(), mismatch, m); !diags.HasError() {
+					t.Errorf("expected fast udp profile not to be read as fast tcp profile")
+				}
+
+				// Profiles are found by the data source according to their type
+				ds := dataSourceNsxtPolicyLBAppProfile()
+				for profileType, expectedID := range map[string]string{"TCP": "tcp1", "UDP": "udp1"} {
+					dsData := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+						"type": profileType,
+					})
+					if diags := ds.ReadContext(context.Background(), dsData, m); diags.HasError() {
+						t.Fatalf("data source read for %s failed: %v", profileType, diags)
+					}
+					if dsData.Id() != expectedID {
+						t.Errorf("expected %s profile %s, got %s", profileType, expectedID, dsData.Id())
+					}
+				}
+			},
+		},
+		{
+			name:     "server ssl profile",
+			resource: resourceNsxtPolicyLBServerSslProfile(),
+			config: map[string]interface{}{
+				"nsx_id":             "ssl1",
+				"display_name":       "ssl1",
+				"cipher_group_label": "CUSTOM",
+				"ciphers":            []interface{}{"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"},
+				"protocols":          []interface{}{"TLS_V1_2"},
+			},
+			path: "/infra/lb-server-ssl-profiles/ssl1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["cipher_group_label"] != "CUSTOM" || obj["session_cache_enabled"] != true {
+					t.Errorf("unexpected server ssl profile on NSX: %v", obj)
+				}
+				if d.Get("protocols").(*schema.Set).Len() != 1 || d.Get("ciphers").(*schema.Set).Len() != 1 {
+					t.Errorf("unexpected server ssl profile state: %v", d.State())
+				}
+			},
+		},
+	})
+}
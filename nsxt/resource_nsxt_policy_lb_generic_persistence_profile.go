@@ -0,0 +1,147 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyLBGenericPersistenceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyLBGenericPersistenceProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBGenericPersistenceProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBGenericPersistenceProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBGenericPersistenceProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":                           getNsxIDSchema(),
+			"path":                             getPathSchema(),
+			"display_name":                     getDisplayNameSchema(),
+			"description":                      getDescriptionSchema(),
+			"revision":                         getRevisionSchema(),
+			"tag":                              getTagsSchema(),
+			"persistence_shared":               getPolicyLbPersistenceSharedSchema(),
+			"ha_persistence_mirroring_enabled": getPolicyLbHaPersistenceMirroringSchema(),
+			"timeout":                          getPolicyLbPersistenceTimeoutSchema(),
+		},
+	}
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	persistenceShared := d.Get("persistence_shared").(bool)
+	haPersistenceMirroringEnabled := d.Get("ha_persistence_mirroring_enabled").(bool)
+	timeout := int64(d.Get("timeout").(int))
+	obj := model.LBGenericPersistenceProfile{
+		DisplayName:                   &displayName,
+		Description:                   &description,
+		Tags:                          tags,
+		PersistenceShared:             &persistenceShared,
+		HaPersistenceMirroringEnabled: &haPersistenceMirroringEnabled,
+		Timeout:                       &timeout,
+		ResourceType:                  model.LBPersistenceProfile_RESOURCE_TYPE_LBGENERICPERSISTENCEPROFILE,
+	}
+
+	log.Printf("[INFO] Patching LBGenericPersistenceProfile with ID %s", id)
+	dataValue, errs := converter.ConvertToVapi(obj, model.LBGenericPersistenceProfileBindingType())
+	if errs != nil {
+		return fmt.Errorf("Error converting LBGenericPersistenceProfile %s", errs[0])
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	return client.Patch(id, dataValue.(*data.StructValue))
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyLBPersistenceProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyLBGenericPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	client := infra.NewLbPersistenceProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "LBGenericPersistenceProfile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(obj, model.LBGenericPersistenceProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("LBPersistenceProfile with id %s is not of type LBGenericPersistenceProfile %s", id, errs[0])
+	}
+	profile := baseObj.(model.LBGenericPersistenceProfile)
+	if profile.ResourceType != model.LBPersistenceProfile_RESOURCE_TYPE_LBGENERICPERSISTENCEPROFILE {
+		return fmt.Errorf("LBPersistenceProfile with id %s is of type %s, expected LBGenericPersistenceProfile", id, profile.ResourceType)
+	}
+
+	d.Set("display_name", profile.DisplayName)
+	d.Set("description", profile.Description)
+	setPolicyTagsInSchema(d, profile.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", profile.Path)
+	d.Set("revision", profile.Revision)
+
+	d.Set("persistence_shared", profile.PersistenceShared)
+	d.Set("ha_persistence_mirroring_enabled", profile.HaPersistenceMirroringEnabled)
+	d.Set("timeout", profile.Timeout)
+
+	return nil
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining LBGenericPersistenceProfile ID")
+	}
+
+	err := resourceNsxtPolicyLBGenericPersistenceProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("LBGenericPersistenceProfile", id, err)
+	}
+
+	return resourceNsxtPolicyLBGenericPersistenceProfileRead(d, m)
+}
+
+func resourceNsxtPolicyLBGenericPersistenceProfileDelete(d *schema.ResourceData, m interface{}) error {
+	return resourceNsxtPolicyLBPersistenceProfileDelete(d, m)
+}
@@ -23,10 +23,10 @@ var lBHttpProfileXForwardedForValues = []string{
 
 func resourceNsxtPolicyLBHttpApplicationProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBHttpApplicationProfileCreate,
-		Read:   resourceNsxtPolicyLBHttpApplicationProfileRead,
-		Update: resourceNsxtPolicyLBHttpApplicationProfileUpdate,
-		Delete: resourceNsxtPolicyLBHttpApplicationProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBHttpApplicationProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBHttpApplicationProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBHttpApplicationProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBHttpApplicationProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
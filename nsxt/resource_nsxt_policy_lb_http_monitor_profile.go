@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyLBHttpMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBHttpMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBHttpMonitorProfileRead,
-		Update: resourceNsxtPolicyLBHttpMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBHttpMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBHttpMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBHttpMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBHttpMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBHttpMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -22,10 +22,10 @@ var lBServerSslProfileBindingServerAuthValues = []string{
 
 func resourceNsxtPolicyLBHttpsMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBHttpsMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBHttpsMonitorProfileRead,
-		Update: resourceNsxtPolicyLBHttpsMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBHttpsMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBHttpsMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBHttpsMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBHttpsMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBHttpsMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyLBIcmpMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBIcmpMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBIcmpMonitorProfileRead,
-		Update: resourceNsxtPolicyLBIcmpMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBIcmpMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBIcmpMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBIcmpMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBIcmpMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBIcmpMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
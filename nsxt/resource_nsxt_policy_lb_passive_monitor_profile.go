@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicyLBPassiveMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBPassiveMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBPassiveMonitorProfileRead,
-		Update: resourceNsxtPolicyLBPassiveMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBPassiveMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBPassiveMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBPassiveMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBPassiveMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBPassiveMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
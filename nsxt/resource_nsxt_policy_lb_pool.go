@@ -32,10 +32,10 @@ var lbPoolSnatTypeValues = []string{
 
 func resourceNsxtPolicyLBPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBPoolCreate,
-		Read:   resourceNsxtPolicyLBPoolRead,
-		Update: resourceNsxtPolicyLBPoolUpdate,
-		Delete: resourceNsxtPolicyLBPoolDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBPoolCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBPoolRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBPoolUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
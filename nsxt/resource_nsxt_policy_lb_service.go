@@ -36,10 +36,10 @@ var lBServiceSizeValues = []string{
 
 func resourceNsxtPolicyLBService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBServiceCreate,
-		Read:   resourceNsxtPolicyLBServiceRead,
-		Update: resourceNsxtPolicyLBServiceUpdate,
-		Delete: resourceNsxtPolicyLBServiceDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBServiceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBServiceRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBServiceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
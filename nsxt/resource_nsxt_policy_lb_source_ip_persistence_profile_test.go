@@ -0,0 +1,144 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyLBSourceIPPersistenceProfileCreateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform created",
+	"persistence_shared":               "true",
+	"ha_persistence_mirroring_enabled": "true",
+	"timeout":                          "100",
+	"purge":                            "NO_PURGE",
+}
+
+var accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes = map[string]string{
+	"display_name":                     getAccTestResourceName(),
+	"description":                      "terraform updated",
+	"persistence_shared":               "false",
+	"ha_persistence_mirroring_enabled": "false",
+	"timeout":                          "600",
+	"purge":                            "FULL",
+}
+
+func TestAccResourceNsxtPolicyLBSourceIPPersistenceProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_lb_source_ip_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPersistenceProfileCheckDestroy(state, "nsxt_policy_lb_source_ip_persistence_profile", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring_enabled", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["ha_persistence_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "purge", accTestPolicyLBSourceIPPersistenceProfileCreateAttributes["purge"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "persistence_shared", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["persistence_shared"]),
+					resource.TestCheckResourceAttr(testResourceName, "ha_persistence_mirroring_enabled", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["ha_persistence_mirroring_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["timeout"]),
+					resource.TestCheckResourceAttr(testResourceName, "purge", accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["purge"]),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyLBPersistenceProfileExists(accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "timeout", "300"),
+					resource.TestCheckResourceAttr(testResourceName, "purge", "FULL"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyLBSourceIPPersistenceProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_lb_source_ip_persistence_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyLocalManager(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyLBPersistenceProfileCheckDestroy(state, "nsxt_policy_lb_source_ip_persistence_profile", name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyLBSourceIPPersistenceProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_source_ip_persistence_profile" "test" {
+  display_name                     = "%s"
+  description                      = "%s"
+  persistence_shared               = %s
+  ha_persistence_mirroring_enabled = %s
+  timeout                          = %s
+  purge                            = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["persistence_shared"], attrMap["ha_persistence_mirroring_enabled"], attrMap["timeout"], attrMap["purge"])
+}
+
+func testAccNsxtPolicyLBSourceIPPersistenceProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_lb_source_ip_persistence_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyLBSourceIPPersistenceProfileUpdateAttributes["display_name"])
+}
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyLBTcpMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBTcpMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBTcpMonitorProfileRead,
-		Update: resourceNsxtPolicyLBTcpMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBTcpMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBTcpMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBTcpMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBTcpMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBTcpMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
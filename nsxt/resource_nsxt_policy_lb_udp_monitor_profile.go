@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyLBUdpMonitorProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBUdpMonitorProfileCreate,
-		Read:   resourceNsxtPolicyLBUdpMonitorProfileRead,
-		Update: resourceNsxtPolicyLBUdpMonitorProfileUpdate,
-		Delete: resourceNsxtPolicyLBUdpMonitorProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBUdpMonitorProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBUdpMonitorProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBUdpMonitorProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBUdpMonitorProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -137,10 +137,10 @@ var lbSslModeSelectionActionValues = []string{
 
 func resourceNsxtPolicyLBVirtualServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLBVirtualServerCreate,
-		Read:   resourceNsxtPolicyLBVirtualServerRead,
-		Update: resourceNsxtPolicyLBVirtualServerUpdate,
-		Delete: resourceNsxtPolicyLBVirtualServerDelete,
+		CreateContext: withContext(resourceNsxtPolicyLBVirtualServerCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLBVirtualServerRead),
+		UpdateContext: withContext(resourceNsxtPolicyLBVirtualServerUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLBVirtualServerDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
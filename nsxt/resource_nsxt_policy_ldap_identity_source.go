@@ -26,10 +26,10 @@ var ldapServerTypes = []string{activeDirectoryType, openLdapType}
 
 func resourceNsxtPolicyLdapIdentitySource() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyLdapIdentitySourceCreate,
-		Read:   resourceNsxtPolicyLdapIdentitySourceRead,
-		Update: resourceNsxtPolicyLdapIdentitySourceUpdate,
-		Delete: resourceNsxtPolicyLdapIdentitySourceDelete,
+		CreateContext: withContext(resourceNsxtPolicyLdapIdentitySourceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyLdapIdentitySourceRead),
+		UpdateContext: withContext(resourceNsxtPolicyLdapIdentitySourceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyLdapIdentitySourceDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -4,10 +4,6 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
-	"reflect"
-
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
@@ -78,14 +74,14 @@ var macDiscoveryProfileSchema = map[string]*metadata.ExtendedSchema{
 	},
 	"mac_limit_policy": {
 		Schema: schema.Schema{
-			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice(macDiscoveryProfileMacLimitPolicyValues, false),
-			Optional:     true,
-			Default:      model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
 		},
 		Metadata: metadata.Metadata{
 			SchemaType:   "string",
 			SdkFieldName: "MacLimitPolicy",
+			EnumValues:   macDiscoveryProfileMacLimitPolicyValues,
 			TestData: metadata.Testdata{
 				CreateValue: model.MacDiscoveryProfile_MAC_LIMIT_POLICY_ALLOW,
 				UpdateValue: model.MacDiscoveryProfile_MAC_LIMIT_POLICY_DROP,
@@ -124,167 +120,18 @@ var macDiscoveryProfileSchema = map[string]*metadata.ExtendedSchema{
 	},
 }
 
-func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicyMacDiscoveryProfileCreate,
-		Read:   resourceNsxtPolicyMacDiscoveryProfileRead,
-		Update: resourceNsxtPolicyMacDiscoveryProfileUpdate,
-		Delete: resourceNsxtPolicyMacDiscoveryProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
-		},
-
-		Schema: metadata.GetSchemaFromExtendedSchema(macDiscoveryProfileSchema),
-	}
-}
-
-func resourceNsxtPolicyMacDiscoveryProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
-	var err error
-	client := infra.NewMacDiscoveryProfilesClient(sessionContext, connector)
-	if client == nil {
-		return false, policyResourceNotSupportedError()
-	}
-	_, err = client.Get(id)
-	if err == nil {
-		return true, nil
-	}
-
-	if isNotFoundError(err) {
-		return false, nil
-	}
-
-	return false, logAPIError("Error retrieving resource", err)
-}
-
-func resourceNsxtPolicyMacDiscoveryProfileCreate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyMacDiscoveryProfileExists)
-	if err != nil {
-		return err
-	}
-
-	// TODO - consider including standard object attributes in the schema
-	tags := getPolicyTagsFromSchema(d)
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-
-	obj := model.MacDiscoveryProfile{
-		Tags:        tags,
-		DisplayName: &displayName,
-		Description: &description,
-	}
-
-	elem := reflect.ValueOf(&obj).Elem()
-	if err := metadata.SchemaToStruct(elem, d, macDiscoveryProfileSchema, "", nil); err != nil {
-		return err
-	}
-
-	// Create the resource using PATCH
-	log.Printf("[INFO] Creating MacDiscoveryProfile with ID %s", id)
-	boolFalse := false
-	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err = client.Patch(id, obj, &boolFalse)
-	if err != nil {
-		return handleCreateError("MacDiscoveryProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
-}
-
-func resourceNsxtPolicyMacDiscoveryProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
-	}
-
-	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "MacDiscoveryProfile", id, err)
-	}
-
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	d.Set("revision", obj.Revision)
-	d.Set("path", obj.Path)
-
-	elem := reflect.ValueOf(&obj).Elem()
-	return metadata.StructToSchema(elem, d, macDiscoveryProfileSchema, "", nil)
+var macDiscoveryProfileResource = policyMetadataResource[model.MacDiscoveryProfile]{
+	name:               "MacDiscoveryProfile",
+	schema:             macDiscoveryProfileSchema,
+	newClient:          newPolicyResourceClientFactory[model.MacDiscoveryProfile](infra.NewMacDiscoveryProfilesClient),
+	override:           &policyOverrideFalse,
+	updateWithRevision: true,
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
-	}
-
-	// Read the rest of the configured parameters
-	description := d.Get("description").(string)
-	displayName := d.Get("display_name").(string)
-	tags := getPolicyTagsFromSchema(d)
-
-	revision := int64(d.Get("revision").(int))
-
-	obj := model.MacDiscoveryProfile{
-		DisplayName: &displayName,
-		Description: &description,
-		Tags:        tags,
-		Revision:    &revision,
-	}
-
-	elem := reflect.ValueOf(&obj).Elem()
-	if err := metadata.SchemaToStruct(elem, d, macDiscoveryProfileSchema, "", nil); err != nil {
-		return err
-	}
-
-	// Update the resource using PATCH
-	boolFalse := false
-	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	_, err := client.Update(id, obj, &boolFalse)
-	if err != nil {
-		return handleUpdateError("MacDiscoveryProfile", id, err)
-	}
-
-	return resourceNsxtPolicyMacDiscoveryProfileRead(d, m)
+func resourceNsxtPolicyMacDiscoveryProfile() *schema.Resource {
+	return macDiscoveryProfileResource.resource()
 }
 
-func resourceNsxtPolicyMacDiscoveryProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining MacDiscoveryProfile ID")
-	}
-
-	connector := getPolicyConnector(m)
-	boolFalse := false
-	client := infra.NewMacDiscoveryProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err := client.Delete(id, &boolFalse)
-
-	if err != nil {
-		return handleDeleteError("MacDiscoveryProfile", id, err)
-	}
-
-	return nil
+func resourceNsxtPolicyMacDiscoveryProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return macDiscoveryProfileResource.exists(sessionContext, id, connector)
 }
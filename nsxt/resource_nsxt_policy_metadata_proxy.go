@@ -17,10 +17,10 @@ var cryptoProtocolsValues = []string{"TLS_V1", "TLS_V1_1", "TLS_V1_2"}
 
 func resourceNsxtPolicyMetadataProxy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyMetadataProxyCreate,
-		Read:   resourceNsxtPolicyMetadataProxyRead,
-		Update: resourceNsxtPolicyMetadataProxyUpdate,
-		Delete: resourceNsxtPolicyMetadataProxyDelete,
+		CreateContext: withContext(resourceNsxtPolicyMetadataProxyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyMetadataProxyRead),
+		UpdateContext: withContext(resourceNsxtPolicyMetadataProxyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyMetadataProxyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
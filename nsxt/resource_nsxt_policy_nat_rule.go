@@ -42,10 +42,10 @@ var policyNATRulePolicyBasedVpnModeTypeValues = []string{
 
 func resourceNsxtPolicyNATRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyNATRuleCreate,
-		Read:   resourceNsxtPolicyNATRuleRead,
-		Update: resourceNsxtPolicyNATRuleUpdate,
-		Delete: resourceNsxtPolicyNATRuleDelete,
+		CreateContext: withContext(resourceNsxtPolicyNATRuleCreate),
+		ReadContext:   withContext(resourceNsxtPolicyNATRuleRead),
+		UpdateContext: withContext(resourceNsxtPolicyNATRuleUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyNATRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyNATRuleImport,
 		},
@@ -27,10 +27,10 @@ var ospfAreaAuthModeValues = []string{
 
 func resourceNsxtPolicyOspfArea() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyOspfAreaCreate,
-		Read:   resourceNsxtPolicyOspfAreaRead,
-		Update: resourceNsxtPolicyOspfAreaUpdate,
-		Delete: resourceNsxtPolicyOspfAreaDelete,
+		CreateContext: withContext(resourceNsxtPolicyOspfAreaCreate),
+		ReadContext:   withContext(resourceNsxtPolicyOspfAreaRead),
+		UpdateContext: withContext(resourceNsxtPolicyOspfAreaUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyOspfAreaDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyOspfAreaImport,
 		},
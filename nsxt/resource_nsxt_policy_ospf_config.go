@@ -15,10 +15,10 @@ import (
 func resourceNsxtPolicyOspfConfig() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyOspfConfigCreate,
-		Read:   resourceNsxtPolicyOspfConfigRead,
-		Update: resourceNsxtPolicyOspfConfigUpdate,
-		Delete: resourceNsxtPolicyOspfConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyOspfConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyOspfConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyOspfConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyOspfConfigDelete),
 
 		Schema: getPolicyOspfConfigSchema(),
 	}
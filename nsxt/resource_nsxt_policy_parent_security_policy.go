@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtPolicyParentSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyParentSecurityPolicyCreate,
-		Read:   resourceNsxtPolicyParentSecurityPolicyRead,
-		Update: resourceNsxtPolicyParentSecurityPolicyUpdate,
-		Delete: resourceNsxtPolicyParentSecurityPolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicyParentSecurityPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyParentSecurityPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyParentSecurityPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyParentSecurityPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -0,0 +1,212 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyPimProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyPimProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyPimProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyPimProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyPimProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"bsm_enabled": {
+				Type:        schema.TypeBool,
+				Description: "Activate bootstrap messaging configuration",
+				Optional:    true,
+				Default:     true,
+			},
+			"rp_address_multicast_range": {
+				Type:        schema.TypeList,
+				Description: "Static rendezvous point address and associated multicast groups",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rp_address": {
+							Type:         schema.TypeString,
+							Description:  "Static rendezvous point IPv4 address",
+							Required:     true,
+							ValidateFunc: validation.IsIPv4Address,
+						},
+						"multicast_ranges": {
+							Type:        schema.TypeList,
+							Description: "Multicast group ranges served by this rendezvous point",
+							Optional:    true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCidr(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyPimProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewPimProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getPolicyPimRpAddressMulticastRangesFromSchema(d *schema.ResourceData) []model.RpAddressMulticastRanges {
+	var ranges []model.RpAddressMulticastRanges
+	for _, item := range d.Get("rp_address_multicast_range").([]interface{}) {
+		data := item.(map[string]interface{})
+		rpAddress := data["rp_address"].(string)
+		ranges = append(ranges, model.RpAddressMulticastRanges{
+			RpAddress:       &rpAddress,
+			MulticastRanges: interfaceListToStringList(data["multicast_ranges"].([]interface{})),
+		})
+	}
+	return ranges
+}
+
+func setPolicyPimRpAddressMulticastRangesInSchema(d *schema.ResourceData, ranges []model.RpAddressMulticastRanges) {
+	var result []map[string]interface{}
+	for _, item := range ranges {
+		elem := make(map[string]interface{})
+		elem["rp_address"] = item.RpAddress
+		elem["multicast_ranges"] = item.MulticastRanges
+		result = append(result, elem)
+	}
+	d.Set("rp_address_multicast_range", result)
+}
+
+func resourceNsxtPolicyPimProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	bsmEnabled := d.Get("bsm_enabled").(bool)
+
+	obj := model.PolicyPimProfile{
+		DisplayName: &displayName,
+		Description: &description,
+		Tags:        tags,
+		BsmEnabled:  &bsmEnabled,
+		// Empty list is sent explicitly in order to clear ranges on update
+		RpAddressMulticastRanges: []model.RpAddressMulticastRanges{},
+	}
+	if ranges := getPolicyPimRpAddressMulticastRangesFromSchema(d); len(ranges) > 0 {
+		obj.RpAddressMulticastRanges = ranges
+	}
+
+	log.Printf("[INFO] Patching PIM Profile with ID %s", id)
+	client := infra.NewPimProfilesClient(connector)
+	return client.Patch(id, obj)
+}
+
+func resourceNsxtPolicyPimProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyPimProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("PIM Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	client := infra.NewPimProfilesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "PIM Profile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("bsm_enabled", obj.BsmEnabled)
+	setPolicyPimRpAddressMulticastRangesInSchema(d, obj.RpAddressMulticastRanges)
+
+	return nil
+}
+
+func resourceNsxtPolicyPimProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	err := resourceNsxtPolicyPimProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("PIM Profile", id, err)
+	}
+
+	return resourceNsxtPolicyPimProfileRead(d, m)
+}
+
+func resourceNsxtPolicyPimProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining PIM Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewPimProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("PIM Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyPimProfileCreateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform created",
+	"bsm_enabled":      "false",
+	"rp_address":       "10.10.10.1",
+	"multicast_ranges": "239.1.0.0/16",
+}
+
+var accTestPolicyPimProfileUpdateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform updated",
+	"bsm_enabled":      "true",
+	"rp_address":       "10.10.10.2",
+	"multicast_ranges": "239.2.0.0/16",
+}
+
+func TestAccResourceNsxtPolicyPimProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_pim_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyPimProfileCheckDestroy(state, accTestPolicyPimProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyPimProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(accTestPolicyPimProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyPimProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyPimProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", accTestPolicyPimProfileCreateAttributes["bsm_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "rp_address_multicast_range.0.rp_address", accTestPolicyPimProfileCreateAttributes["rp_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "rp_address_multicast_range.0.multicast_ranges.0", accTestPolicyPimProfileCreateAttributes["multicast_ranges"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyPimProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(accTestPolicyPimProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyPimProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyPimProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", accTestPolicyPimProfileUpdateAttributes["bsm_enabled"]),
+					resource.TestCheckResourceAttr(testResourceName, "rp_address_multicast_range.0.rp_address", accTestPolicyPimProfileUpdateAttributes["rp_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "rp_address_multicast_range.0.multicast_ranges.0", accTestPolicyPimProfileUpdateAttributes["multicast_ranges"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyPimProfileMinimalistic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyPimProfileExists(accTestPolicyPimProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "bsm_enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "rp_address_multicast_range.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyPimProfile_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_pim_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyPimProfileCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyPimProfileMinimalistic(),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyPimProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy PIM Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy PIM Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyPimProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy PIM Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyPimProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_pim_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyPimProfileExists(resourceID, connector, testAccIsGlobalManager())
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy PIM Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyPimProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyPimProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyPimProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+  description  = "%s"
+  bsm_enabled  = %s
+
+  rp_address_multicast_range {
+    rp_address       = "%s"
+    multicast_ranges = ["%s"]
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["bsm_enabled"], attrMap["rp_address"], attrMap["multicast_ranges"])
+}
+
+func testAccNsxtPolicyPimProfileMinimalistic() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_pim_profile" "test" {
+  display_name = "%s"
+}`, accTestPolicyPimProfileUpdateAttributes["display_name"])
+}
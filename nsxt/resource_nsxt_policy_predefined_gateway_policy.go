@@ -23,10 +23,10 @@ import (
 
 func resourceNsxtPolicyPredefinedGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedGatewayPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedGatewayPolicyRead,
-		Update: resourceNsxtPolicyPredefinedGatewayPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedGatewayPolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicyPredefinedGatewayPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyPredefinedGatewayPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyPredefinedGatewayPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyPredefinedGatewayPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPredefinedPolicyImporter,
 		},
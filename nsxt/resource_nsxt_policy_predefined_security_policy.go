@@ -21,10 +21,10 @@ import (
 
 func resourceNsxtPolicyPredefinedSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyPredefinedSecurityPolicyCreate,
-		Read:   resourceNsxtPolicyPredefinedSecurityPolicyRead,
-		Update: resourceNsxtPolicyPredefinedSecurityPolicyUpdate,
-		Delete: resourceNsxtPolicyPredefinedSecurityPolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicyPredefinedSecurityPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyPredefinedSecurityPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyPredefinedSecurityPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyPredefinedSecurityPolicyDelete),
 
 		Schema: getPolicyPredefinedSecurityPolicySchema(),
 	}
@@ -15,10 +15,10 @@ import (
 
 func resourceNsxtPolicyProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyProjectCreate,
-		Read:   resourceNsxtPolicyProjectRead,
-		Update: resourceNsxtPolicyProjectUpdate,
-		Delete: resourceNsxtPolicyProjectDelete,
+		CreateContext: withContext(resourceNsxtPolicyProjectCreate),
+		ReadContext:   withContext(resourceNsxtPolicyProjectRead),
+		UpdateContext: withContext(resourceNsxtPolicyProjectUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyProjectDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
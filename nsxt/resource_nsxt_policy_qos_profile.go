@@ -22,10 +22,10 @@ var rateLimiterResourceTypes = []string{model.QosBaseRateLimiter_RESOURCE_TYPE_I
 
 func resourceNsxtPolicyQosProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyQosProfileCreate,
-		Read:   resourceNsxtPolicyQosProfileRead,
-		Update: resourceNsxtPolicyQosProfileUpdate,
-		Delete: resourceNsxtPolicyQosProfileDelete,
+		CreateContext: withContext(resourceNsxtPolicyQosProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyQosProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyQosProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyQosProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -23,10 +23,10 @@ var featurePermissionTypes = [](string){
 
 func resourceNsxtPolicyUserManagementRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyUserManagementRoleCreate,
-		Read:   resourceNsxtPolicyUserManagementRoleRead,
-		Update: resourceNsxtPolicyUserManagementRoleUpdate,
-		Delete: resourceNsxtPolicyUserManagementRoleDelete,
+		CreateContext: withContext(resourceNsxtPolicyUserManagementRoleCreate),
+		ReadContext:   withContext(resourceNsxtPolicyUserManagementRoleRead),
+		UpdateContext: withContext(resourceNsxtPolicyUserManagementRoleUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyUserManagementRoleDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -32,10 +32,10 @@ var defaultRoleName = "auditor"
 
 func resourceNsxtPolicyUserManagementRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyUserManagementRoleBindingCreate,
-		Read:   resourceNsxtPolicyUserManagementRoleBindingRead,
-		Update: resourceNsxtPolicyUserManagementRoleBindingUpdate,
-		Delete: resourceNsxtPolicyUserManagementRoleBindingDelete,
+		CreateContext: withContext(resourceNsxtPolicyUserManagementRoleBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyUserManagementRoleBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicyUserManagementRoleBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyUserManagementRoleBindingDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtPolicySecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRead,
-		Update: resourceNsxtPolicySecurityPolicyUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyDelete,
+		CreateContext: withContext(resourceNsxtPolicySecurityPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicySecurityPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicySecurityPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySecurityPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtDomainResourceImporter,
 		},
@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicySecurityPolicyRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySecurityPolicyRuleCreate,
-		Read:   resourceNsxtPolicySecurityPolicyRuleRead,
-		Update: resourceNsxtPolicySecurityPolicyRuleUpdate,
-		Delete: resourceNsxtPolicySecurityPolicyRuleDelete,
+		CreateContext: withContext(resourceNsxtPolicySecurityPolicyRuleCreate),
+		ReadContext:   withContext(resourceNsxtPolicySecurityPolicyRuleRead),
+		UpdateContext: withContext(resourceNsxtPolicySecurityPolicyRuleUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySecurityPolicyRuleDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtSecurityPolicyRuleImporter,
 		},
@@ -4,18 +4,23 @@
 package nsxt
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceNsxtPolicySegment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentCreate,
-		Read:   resourceNsxtPolicySegmentRead,
-		Update: resourceNsxtPolicySegmentUpdate,
-		Delete: resourceNsxtPolicySegmentDelete,
+		CreateContext: withContext(resourceNsxtPolicySegmentCreate),
+		ReadContext:   withContext(resourceNsxtPolicySegmentRead),
+		UpdateContext: withContext(resourceNsxtPolicySegmentUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySegmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: getPolicyCommonSegmentSchema(false, false),
 	}
@@ -0,0 +1,793 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	gm_segments "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/segments"
+	gm_ports "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/global_infra/segments/ports"
+	gm_model "github.com/vmware/vsphere-automation-sdk-go/services/nsxt-gm/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments"
+	"github.com/vmware/terraform-provider-nsxt/api/infra/segments/ports"
+	t1_segments "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s/segments"
+	t1_ports "github.com/vmware/terraform-provider-nsxt/api/infra/tier_1s/segments/ports"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var segmentPortAttachmentTypeValues = []string{
+	model.PortAttachment_TYPE_PARENT,
+	model.PortAttachment_TYPE_CHILD,
+	model.PortAttachment_TYPE_INDEPENDENT,
+	model.PortAttachment_TYPE_STATIC,
+}
+
+var segmentPortAllocateAddressesValues = []string{
+	model.PortAttachment_ALLOCATE_ADDRESSES_IP_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_MAC_POOL,
+	model.PortAttachment_ALLOCATE_ADDRESSES_BOTH,
+	model.PortAttachment_ALLOCATE_ADDRESSES_NONE,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCP,
+	model.PortAttachment_ALLOCATE_ADDRESSES_DHCPV6,
+	model.PortAttachment_ALLOCATE_ADDRESSES_SLAAC,
+}
+
+var segmentPortHyperbusModeValues = []string{
+	model.PortAttachment_HYPERBUS_MODE_ENABLE,
+	model.PortAttachment_HYPERBUS_MODE_DISABLE,
+}
+
+var segmentPortAdminStateValues = []string{
+	model.SegmentPort_ADMIN_STATE_UP,
+	model.SegmentPort_ADMIN_STATE_DOWN,
+}
+
+func resourceNsxtPolicySegmentPort() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicySegmentPortCreate),
+		ReadContext:   withContext(resourceNsxtPolicySegmentPortRead),
+		UpdateContext: withContext(resourceNsxtPolicySegmentPortUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySegmentPortDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtSegmentPortImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(false, false, false),
+			"segment_path": getPolicyPathSchema(true, true, "Policy path of the parent segment"),
+			"attachment": {
+				Type:        schema.TypeList,
+				Description: "VIF attachment of the port",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "VIF UUID on NSX",
+							Optional:    true,
+							Computed:    true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Type of port attachment",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(segmentPortAttachmentTypeValues, false),
+						},
+						"context_id": {
+							Type:        schema.TypeString,
+							Description: "Attachment ID of the parent port, required for child ports",
+							Optional:    true,
+						},
+						"traffic_tag": {
+							Type:         schema.TypeInt,
+							Description:  "VLAN ID to tag traffic of child port",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 4094),
+						},
+						"allocate_addresses": {
+							Type:         schema.TypeString,
+							Description:  "Indicate how IP will be allocated for the port",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(segmentPortAllocateAddressesValues, false),
+						},
+						"app_id": {
+							Type:        schema.TypeString,
+							Description: "ID used to identify or look up a child attachment",
+							Optional:    true,
+						},
+						"hyperbus_mode": {
+							Type:         schema.TypeString,
+							Description:  "Hyperbus mode for the attachment",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(segmentPortHyperbusModeValues, false),
+						},
+					},
+				},
+			},
+			"address_binding": {
+				Type:        schema.TypeList,
+				Description: "Static address bindings for the port",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Description:  "IP address",
+							Optional:     true,
+							ValidateFunc: validateSingleIP(),
+						},
+						"mac_address": {
+							Type:         schema.TypeString,
+							Description:  "MAC address",
+							Optional:     true,
+							ValidateFunc: validation.IsMACAddress,
+						},
+						"vlan_id": {
+							Type:         schema.TypeInt,
+							Description:  "VLAN ID",
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 4094),
+						},
+					},
+				},
+			},
+			"admin_state": {
+				Type:         schema.TypeString,
+				Description:  "Administrative state of the port",
+				Optional:     true,
+				Default:      model.SegmentPort_ADMIN_STATE_UP,
+				ValidateFunc: validation.StringInSlice(segmentPortAdminStateValues, false),
+			},
+			"discovery_profile": {
+				Type:        schema.TypeList,
+				Description: "IP and MAC discovery profiles for this port",
+				Elem:        getPolicySegmentDiscoveryProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"qos_profile": {
+				Type:        schema.TypeList,
+				Description: "QoS profiles for this port",
+				Elem:        getPolicySegmentQosProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+			"security_profile": {
+				Type:        schema.TypeList,
+				Description: "Security profiles for this port",
+				Elem:        getPolicySegmentSecurityProfilesSchema(),
+				Optional:    true,
+				MaxItems:    1,
+			},
+		},
+	}
+}
+
+func nsxtSegmentPortImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return nsxtSegmentChildResourceImporter(d, m, "/ports/")
+}
+
+func parseSegmentPortParentPath(context utl.SessionContext, segmentPath string) (string, string, error) {
+	isT0, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	if segmentID == "" {
+		return "", "", fmt.Errorf("Invalid Segment Path %s", segmentPath)
+	}
+	if isT0 {
+		return "", "", fmt.Errorf("This resource is not applicable to segment %s", segmentPath)
+	}
+	if context.ClientType == utl.Global && gwID != "" {
+		return "", "", fmt.Errorf("This resource is not applicable to segment on Global Manager %s", segmentPath)
+	}
+	return gwID, segmentID, nil
+}
+
+func getPolicySegmentPort(context utl.SessionContext, connector client.Connector, segmentPath string, id string) (model.SegmentPort, error) {
+	gwID, segmentID, err := parseSegmentPortParentPath(context, segmentPath)
+	if err != nil {
+		return model.SegmentPort{}, err
+	}
+
+	if context.ClientType == utl.Global {
+		client := gm_segments.NewPortsClient(connector)
+		gmObj, err := client.Get(segmentID, id)
+		if err != nil {
+			return model.SegmentPort{}, err
+		}
+		lmObj, err := convertModelBindingType(gmObj, gm_model.SegmentPortBindingType(), model.SegmentPortBindingType())
+		if err != nil {
+			return model.SegmentPort{}, err
+		}
+		return lmObj.(model.SegmentPort), nil
+	}
+
+	if gwID == "" {
+		// infra segment
+		client := segments.NewPortsClient(context, connector)
+		if client == nil {
+			return model.SegmentPort{}, policyResourceNotSupportedError()
+		}
+		return client.Get(segmentID, id)
+	}
+
+	// fixed segment
+	client := t1_segments.NewPortsClient(context, connector)
+	if client == nil {
+		return model.SegmentPort{}, policyResourceNotSupportedError()
+	}
+	return client.Get(gwID, segmentID, id)
+}
+
+func resourceNsxtPolicySegmentPortExists(segmentPath string) func(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return func(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
+		_, err := getPolicySegmentPort(context, connector, segmentPath, id)
+		if err == nil {
+			return true, nil
+		}
+
+		if isNotFoundError(err) {
+			return false, nil
+		}
+
+		return false, logAPIError("Error retrieving Segment Port", err)
+	}
+}
+
+func getSegmentPortAttachmentFromSchema(d *schema.ResourceData) *model.PortAttachment {
+	attachments := d.Get("attachment").([]interface{})
+	if len(attachments) == 0 || attachments[0] == nil {
+		return nil
+	}
+
+	data := attachments[0].(map[string]interface{})
+	attachment := model.PortAttachment{}
+	if id := data["id"].(string); len(id) > 0 {
+		attachment.Id = &id
+	}
+	if attachmentType := data["type"].(string); len(attachmentType) > 0 {
+		attachment.Type_ = &attachmentType
+	}
+	if contextID := data["context_id"].(string); len(contextID) > 0 {
+		attachment.ContextId = &contextID
+	}
+	if trafficTag := int64(data["traffic_tag"].(int)); trafficTag > 0 {
+		attachment.TrafficTag = &trafficTag
+	}
+	if allocateAddresses := data["allocate_addresses"].(string); len(allocateAddresses) > 0 {
+		attachment.AllocateAddresses = &allocateAddresses
+	}
+	if appID := data["app_id"].(string); len(appID) > 0 {
+		attachment.AppId = &appID
+	}
+	if hyperbusMode := data["hyperbus_mode"].(string); len(hyperbusMode) > 0 {
+		attachment.HyperbusMode = &hyperbusMode
+	}
+
+	return &attachment
+}
+
+func setSegmentPortAttachmentInSchema(d *schema.ResourceData, attachment *model.PortAttachment) {
+	if attachment == nil {
+		d.Set("attachment", nil)
+		return
+	}
+
+	elem := make(map[string]interface{})
+	elem["id"] = attachment.Id
+	elem["type"] = attachment.Type_
+	elem["context_id"] = attachment.ContextId
+	elem["traffic_tag"] = attachment.TrafficTag
+	elem["allocate_addresses"] = attachment.AllocateAddresses
+	elem["app_id"] = attachment.AppId
+	elem["hyperbus_mode"] = attachment.HyperbusMode
+
+	d.Set("attachment", []interface{}{elem})
+}
+
+func getSegmentPortAddressBindingsFromSchema(d *schema.ResourceData) []model.PortAddressBindingEntry {
+	// Empty list is sent explicitly in order to clear bindings on update
+	bindingList := make([]model.PortAddressBindingEntry, 0)
+	for _, binding := range d.Get("address_binding").([]interface{}) {
+		data := binding.(map[string]interface{})
+		elem := model.PortAddressBindingEntry{}
+		if ipAddress := data["ip_address"].(string); len(ipAddress) > 0 {
+			elem.IpAddress = &ipAddress
+		}
+		if macAddress := data["mac_address"].(string); len(macAddress) > 0 {
+			elem.MacAddress = &macAddress
+		}
+		if vlanID := int64(data["vlan_id"].(int)); vlanID > 0 {
+			elem.VlanId = &vlanID
+		}
+		bindingList = append(bindingList, elem)
+	}
+	return bindingList
+}
+
+func setSegmentPortAddressBindingsInSchema(d *schema.ResourceData, bindings []model.PortAddressBindingEntry) {
+	var bindingList []map[string]interface{}
+	for _, binding := range bindings {
+		elem := make(map[string]interface{})
+		elem["ip_address"] = binding.IpAddress
+		elem["mac_address"] = binding.MacAddress
+		elem["vlan_id"] = binding.VlanId
+		bindingList = append(bindingList, elem)
+	}
+	d.Set("address_binding", bindingList)
+}
+
+// Returns ID and revision of port profile binding map to apply, along with
+// configured profile attributes. Nil profile map means the binding should
+// be removed.
+func getSegmentPortProfileBindingMapChange(d *schema.ResourceData, attrName string) (bool, string, *int64, map[string]interface{}) {
+	oldProfiles, newProfiles := d.GetChange(attrName)
+	if len(newProfiles.([]interface{})) == 0 {
+		if len(oldProfiles.([]interface{})) == 0 {
+			return false, "", nil, nil
+		}
+		mapID, revision := getOldProfileDataForRemoval(oldProfiles)
+		return true, mapID, &revision, nil
+	}
+
+	mapID := "default"
+	profileMap := newProfiles.([]interface{})[0].(map[string]interface{})
+	if len(profileMap["binding_map_path"].(string)) > 0 {
+		mapID = getPolicyIDFromPath(profileMap["binding_map_path"].(string))
+	}
+	if len(oldProfiles.([]interface{})) == 0 {
+		return true, mapID, nil, profileMap
+	}
+
+	// This is an update
+	revision := int64(profileMap["revision"].(int))
+	return true, mapID, &revision, profileMap
+}
+
+func nsxtPolicySegmentPortProfilesSetInStruct(d *schema.ResourceData, port *model.SegmentPort) error {
+	var children []*data.StructValue
+	converter := bindings.NewTypeConverter()
+
+	if ok, mapID, revision, profileMap := getSegmentPortProfileBindingMapChange(d, "discovery_profile"); ok {
+		shouldDelete := profileMap == nil
+		resourceType := "PortDiscoveryProfileBindingMap"
+		discoveryMap := model.PortDiscoveryProfileBindingMap{
+			ResourceType: &resourceType,
+			Id:           &mapID,
+			Revision:     revision,
+		}
+		if !shouldDelete {
+			if ipDiscoveryProfilePath := profileMap["ip_discovery_profile_path"].(string); len(ipDiscoveryProfilePath) > 0 {
+				discoveryMap.IpDiscoveryProfilePath = &ipDiscoveryProfilePath
+			}
+			if macDiscoveryProfilePath := profileMap["mac_discovery_profile_path"].(string); len(macDiscoveryProfilePath) > 0 {
+				discoveryMap.MacDiscoveryProfilePath = &macDiscoveryProfilePath
+			}
+		}
+		childConfig := model.ChildPortDiscoveryProfileBindingMap{
+			ResourceType:                   "ChildPortDiscoveryProfileBindingMap",
+			PortDiscoveryProfileBindingMap: &discoveryMap,
+			Id:                             &mapID,
+			MarkedForDelete:                &shouldDelete,
+		}
+		dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortDiscoveryProfileBindingMapBindingType())
+		if errors != nil {
+			return fmt.Errorf("Error converting child port discovery map: %v", errors[0])
+		}
+		children = append(children, dataValue.(*data.StructValue))
+	}
+
+	if ok, mapID, revision, profileMap := getSegmentPortProfileBindingMapChange(d, "qos_profile"); ok {
+		shouldDelete := profileMap == nil
+		resourceType := "PortQoSProfileBindingMap"
+		qosMap := model.PortQosProfileBindingMap{
+			ResourceType: &resourceType,
+			Id:           &mapID,
+			Revision:     revision,
+		}
+		if !shouldDelete {
+			qosProfilePath := profileMap["qos_profile_path"].(string)
+			qosMap.QosProfilePath = &qosProfilePath
+		}
+		childConfig := model.ChildPortQosProfileBindingMap{
+			ResourceType:             "ChildPortQoSProfileBindingMap",
+			PortQosProfileBindingMap: &qosMap,
+			Id:                       &mapID,
+			MarkedForDelete:          &shouldDelete,
+		}
+		dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortQosProfileBindingMapBindingType())
+		if errors != nil {
+			return fmt.Errorf("Error converting child port QoS map: %v", errors[0])
+		}
+		children = append(children, dataValue.(*data.StructValue))
+	}
+
+	if ok, mapID, revision, profileMap := getSegmentPortProfileBindingMapChange(d, "security_profile"); ok {
+		shouldDelete := profileMap == nil
+		resourceType := "PortSecurityProfileBindingMap"
+		securityMap := model.PortSecurityProfileBindingMap{
+			ResourceType: &resourceType,
+			Id:           &mapID,
+			Revision:     revision,
+		}
+		if !shouldDelete {
+			if spoofguardProfilePath := profileMap["spoofguard_profile_path"].(string); len(spoofguardProfilePath) > 0 {
+				securityMap.SpoofguardProfilePath = &spoofguardProfilePath
+			}
+			if securityProfilePath := profileMap["security_profile_path"].(string); len(securityProfilePath) > 0 {
+				securityMap.SegmentSecurityProfilePath = &securityProfilePath
+			}
+		}
+		childConfig := model.ChildPortSecurityProfileBindingMap{
+			ResourceType:                  "ChildPortSecurityProfileBindingMap",
+			PortSecurityProfileBindingMap: &securityMap,
+			Id:                            &mapID,
+			MarkedForDelete:               &shouldDelete,
+		}
+		dataValue, errors := converter.ConvertToVapi(childConfig, model.ChildPortSecurityProfileBindingMapBindingType())
+		if errors != nil {
+			return fmt.Errorf("Error converting child port security map: %v", errors[0])
+		}
+		children = append(children, dataValue.(*data.StructValue))
+	}
+
+	port.Children = children
+	return nil
+}
+
+// Wrap segment port child into H-API structure, referencing parent segment
+// and gateway for fixed segments
+func policySegmentPortChildToInfraStruct(segmentPath string, childPort *model.ChildSegmentPort) (model.Infra, error) {
+	converter := bindings.NewTypeConverter()
+	dataValue, errors := converter.ConvertToVapi(*childPort, model.ChildSegmentPortBindingType())
+	if errors != nil {
+		return model.Infra{}, fmt.Errorf("Error converting Segment Port Child: %v", errors[0])
+	}
+
+	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+	segmentTargetType := "Segment"
+	childSegment := model.ChildResourceReference{
+		Id:           &segmentID,
+		ResourceType: "ChildResourceReference",
+		TargetType:   &segmentTargetType,
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+	}
+	dataValue, errors = converter.ConvertToVapi(childSegment, model.ChildResourceReferenceBindingType())
+	if errors != nil {
+		return model.Infra{}, fmt.Errorf("Error converting Segment Child: %v", errors[0])
+	}
+
+	if gwID != "" {
+		gwTargetType := "Tier1"
+		childGW := model.ChildResourceReference{
+			Id:           &gwID,
+			ResourceType: "ChildResourceReference",
+			TargetType:   &gwTargetType,
+			Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+		}
+		dataValue, errors = converter.ConvertToVapi(childGW, model.ChildResourceReferenceBindingType())
+		if errors != nil {
+			return model.Infra{}, fmt.Errorf("Error converting Gateway Child: %v", errors[0])
+		}
+	}
+
+	infraType := "Infra"
+	return model.Infra{
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+		ResourceType: &infraType,
+	}, nil
+}
+
+func policySegmentPortResourceToInfraStruct(d *schema.ResourceData, id string, segmentPath string, isUpdate bool) (model.Infra, error) {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	adminState := d.Get("admin_state").(string)
+	resourceType := "SegmentPort"
+
+	obj := model.SegmentPort{
+		Id:              &id,
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		AdminState:      &adminState,
+		Attachment:      getSegmentPortAttachmentFromSchema(d),
+		AddressBindings: getSegmentPortAddressBindingsFromSchema(d),
+		ResourceType:    &resourceType,
+	}
+
+	if isUpdate {
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+
+	if err := nsxtPolicySegmentPortProfilesSetInStruct(d, &obj); err != nil {
+		return model.Infra{}, err
+	}
+
+	childPort := model.ChildSegmentPort{
+		SegmentPort:  &obj,
+		ResourceType: "ChildSegmentPort",
+	}
+
+	return policySegmentPortChildToInfraStruct(segmentPath, &childPort)
+}
+
+func resourceNsxtPolicySegmentPortCreate(d *schema.ResourceData, m interface{}) error {
+	segmentPath := d.Get("segment_path").(string)
+	if _, _, err := parseSegmentPortParentPath(getSessionContext(d, m), segmentPath); err != nil {
+		return err
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySegmentPortExists(segmentPath))
+	if err != nil {
+		return err
+	}
+
+	obj, err := policySegmentPortResourceToInfraStruct(d, id, segmentPath, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Using H-API to create Segment Port with ID %s on segment %s", id, segmentPath)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), false)
+	if err != nil {
+		return handleCreateError("Segment Port", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func listPolicySegmentPortDiscoveryProfileBindingMaps(context utl.SessionContext, connector client.Connector, gwID string, segmentID string, portID string) ([]model.PortDiscoveryProfileBindingMap, error) {
+	if context.ClientType == utl.Global {
+		client := gm_ports.NewPortDiscoveryProfileBindingMapsClient(connector)
+		gmResults, err := client.List(segmentID, portID, nil, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		lmResults, err := convertModelBindingType(gmResults, gm_model.PortDiscoveryProfileBindingMapListResultBindingType(), model.PortDiscoveryProfileBindingMapListResultBindingType())
+		if err != nil {
+			return nil, err
+		}
+		return lmResults.(model.PortDiscoveryProfileBindingMapListResult).Results, nil
+	}
+
+	var results model.PortDiscoveryProfileBindingMapListResult
+	var err error
+	if gwID == "" {
+		client := ports.NewPortDiscoveryProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(segmentID, portID, nil, nil, nil, nil, nil, nil)
+	} else {
+		client := t1_ports.NewPortDiscoveryProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(gwID, segmentID, portID, nil, nil, nil, nil, nil, nil)
+	}
+	return results.Results, err
+}
+
+func listPolicySegmentPortQosProfileBindingMaps(context utl.SessionContext, connector client.Connector, gwID string, segmentID string, portID string) ([]model.PortQosProfileBindingMap, error) {
+	if context.ClientType == utl.Global {
+		client := gm_ports.NewPortQosProfileBindingMapsClient(connector)
+		gmResults, err := client.List(segmentID, portID, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		lmResults, err := convertModelBindingType(gmResults, gm_model.PortQosProfileBindingMapListResultBindingType(), model.PortQosProfileBindingMapListResultBindingType())
+		if err != nil {
+			return nil, err
+		}
+		return lmResults.(model.PortQosProfileBindingMapListResult).Results, nil
+	}
+
+	var results model.PortQosProfileBindingMapListResult
+	var err error
+	if gwID == "" {
+		client := ports.NewPortQosProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(segmentID, portID, nil, nil, nil, nil, nil)
+	} else {
+		client := t1_ports.NewPortQosProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(gwID, segmentID, portID, nil, nil, nil, nil, nil)
+	}
+	return results.Results, err
+}
+
+func listPolicySegmentPortSecurityProfileBindingMaps(context utl.SessionContext, connector client.Connector, gwID string, segmentID string, portID string) ([]model.PortSecurityProfileBindingMap, error) {
+	if context.ClientType == utl.Global {
+		client := gm_ports.NewPortSecurityProfileBindingMapsClient(connector)
+		gmResults, err := client.List(segmentID, portID, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		lmResults, err := convertModelBindingType(gmResults, gm_model.PortSecurityProfileBindingMapListResultBindingType(), model.PortSecurityProfileBindingMapListResultBindingType())
+		if err != nil {
+			return nil, err
+		}
+		return lmResults.(model.PortSecurityProfileBindingMapListResult).Results, nil
+	}
+
+	var results model.PortSecurityProfileBindingMapListResult
+	var err error
+	if gwID == "" {
+		client := ports.NewPortSecurityProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(segmentID, portID, nil, nil, nil, nil, nil)
+	} else {
+		client := t1_ports.NewPortSecurityProfileBindingMapsClient(context, connector)
+		if client == nil {
+			return nil, policyResourceNotSupportedError()
+		}
+		results, err = client.List(gwID, segmentID, portID, nil, nil, nil, nil, nil)
+	}
+	return results.Results, err
+}
+
+func nsxtPolicySegmentPortProfilesRead(d *schema.ResourceData, m interface{}, segmentPath string, portID string) error {
+	errorMessage := "Failed to read %s Profile Map for segment port %s: %v"
+	context := getSessionContext(d, m)
+	connector := getPolicyConnector(m)
+	_, gwID, segmentID := parseSegmentPolicyPath(segmentPath)
+
+	discoveryMaps, err := listPolicySegmentPortDiscoveryProfileBindingMaps(context, connector, gwID, segmentID, portID)
+	if err != nil {
+		return fmt.Errorf(errorMessage, "Discovery", portID, err)
+	}
+	var discoveryList []map[string]interface{}
+	if len(discoveryMaps) > 0 {
+		obj := discoveryMaps[0]
+		config := make(map[string]interface{})
+		config["ip_discovery_profile_path"] = obj.IpDiscoveryProfilePath
+		config["mac_discovery_profile_path"] = obj.MacDiscoveryProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		discoveryList = append(discoveryList, config)
+	}
+	d.Set("discovery_profile", discoveryList)
+
+	qosMaps, err := listPolicySegmentPortQosProfileBindingMaps(context, connector, gwID, segmentID, portID)
+	if err != nil {
+		return fmt.Errorf(errorMessage, "QoS", portID, err)
+	}
+	var qosList []map[string]interface{}
+	if len(qosMaps) > 0 {
+		obj := qosMaps[0]
+		config := make(map[string]interface{})
+		config["qos_profile_path"] = obj.QosProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		qosList = append(qosList, config)
+	}
+	d.Set("qos_profile", qosList)
+
+	securityMaps, err := listPolicySegmentPortSecurityProfileBindingMaps(context, connector, gwID, segmentID, portID)
+	if err != nil {
+		return fmt.Errorf(errorMessage, "Security", portID, err)
+	}
+	var securityList []map[string]interface{}
+	if len(securityMaps) > 0 {
+		obj := securityMaps[0]
+		config := make(map[string]interface{})
+		config["spoofguard_profile_path"] = obj.SpoofguardProfilePath
+		config["security_profile_path"] = obj.SegmentSecurityProfilePath
+		config["binding_map_path"] = obj.Path
+		config["revision"] = obj.Revision
+		securityList = append(securityList, config)
+	}
+	d.Set("security_profile", securityList)
+
+	return nil
+}
+
+func resourceNsxtPolicySegmentPortRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+
+	segmentPath := d.Get("segment_path").(string)
+	obj, err := getPolicySegmentPort(getSessionContext(d, m), connector, segmentPath, id)
+	if err != nil {
+		return handleReadError(d, "Segment Port", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("admin_state", obj.AdminState)
+	setSegmentPortAttachmentInSchema(d, obj.Attachment)
+	setSegmentPortAddressBindingsInSchema(d, obj.AddressBindings)
+
+	return nsxtPolicySegmentPortProfilesRead(d, m, segmentPath, id)
+}
+
+func resourceNsxtPolicySegmentPortUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+	segmentPath := d.Get("segment_path").(string)
+
+	obj, err := policySegmentPortResourceToInfraStruct(d, id, segmentPath, true)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Using H-API to update Segment Port with ID %s", id)
+	err = policyInfraPatch(getSessionContext(d, m), obj, getPolicyConnector(m), true)
+	if err != nil {
+		return handleUpdateError("Segment Port", id, err)
+	}
+
+	return resourceNsxtPolicySegmentPortRead(d, m)
+}
+
+func resourceNsxtPolicySegmentPortDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Segment Port ID")
+	}
+	segmentPath := d.Get("segment_path").(string)
+
+	boolTrue := true
+	resourceType := "SegmentPort"
+	childPort := model.ChildSegmentPort{
+		MarkedForDelete: &boolTrue,
+		SegmentPort: &model.SegmentPort{
+			Id:           &id,
+			ResourceType: &resourceType,
+		},
+		ResourceType: "ChildSegmentPort",
+	}
+
+	infraObj, err := policySegmentPortChildToInfraStruct(segmentPath, &childPort)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Using H-API to delete Segment Port with ID %s", id)
+	err = policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+	if err != nil {
+		return handleDeleteError("Segment Port", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySegmentPortCreateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform created",
+	"admin_state":  "UP",
+	"ip_address":   "12.12.2.10",
+	"mac_address":  "00:50:56:00:11:22",
+	"vlan_id":      "10",
+}
+
+var accTestPolicySegmentPortUpdateAttributes = map[string]string{
+	"display_name": getAccTestResourceName(),
+	"description":  "terraform updated",
+	"admin_state":  "DOWN",
+	"ip_address":   "12.12.2.11",
+	"mac_address":  "00:50:56:00:11:33",
+	"vlan_id":      "20",
+}
+
+var testAccPolicySegmentPortResourceName = "nsxt_policy_segment_port.test"
+
+func TestAccResourceNsxtPolicySegmentPort_basic(t *testing.T) {
+	testAccResourceNsxtPolicySegmentPortBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicySegmentPortBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicySegmentPortBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := testAccPolicySegmentPortResourceName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, accTestPolicySegmentPortUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySegmentPortCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySegmentPortCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicySegmentPortCreateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", accTestPolicySegmentPortCreateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.mac_address", accTestPolicySegmentPortCreateAttributes["mac_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.vlan_id", accTestPolicySegmentPortCreateAttributes["vlan_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "attachment.0.type", "INDEPENDENT"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "security_profile.0.spoofguard_profile_path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "security_profile.0.binding_map_path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySegmentPortUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySegmentPortUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "admin_state", accTestPolicySegmentPortUpdateAttributes["admin_state"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.ip_address", accTestPolicySegmentPortUpdateAttributes["ip_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.mac_address", accTestPolicySegmentPortUpdateAttributes["mac_address"]),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.0.vlan_id", accTestPolicySegmentPortUpdateAttributes["vlan_id"]),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "1"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySegmentPortMinimalistic(withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySegmentPortExists(accTestPolicySegmentPortUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "description", ""),
+					resource.TestCheckResourceAttr(testResourceName, "address_binding.#", "0"),
+					resource.TestCheckResourceAttr(testResourceName, "security_profile.#", "0"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_importBasic(t *testing.T) {
+	name := accTestPolicySegmentPortUpdateAttributes["display_name"]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortMinimalistic(false),
+			},
+			{
+				ResourceName:      testAccPolicySegmentPortResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testAccPolicySegmentPortResourceName),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySegmentPort_importBasic_multitenancy(t *testing.T) {
+	name := accTestPolicySegmentPortUpdateAttributes["display_name"]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t); testAccOnlyMultitenancy(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySegmentPortCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySegmentPortMinimalistic(true),
+			},
+			{
+				ResourceName:      testAccPolicySegmentPortResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testAccPolicySegmentPortResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySegmentPortExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Segment Port resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		segmentPath := rs.Primary.Attributes["segment_path"]
+		if resourceID == "" {
+			return fmt.Errorf("Policy Segment Port resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySegmentPortExists(segmentPath)(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Segment Port %s does not exist", displayName)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySegmentPortCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_segment_port" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		segmentPath := rs.Primary.Attributes["segment_path"]
+		exists, err := resourceNsxtPolicySegmentPortExists(segmentPath)(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Segment Port %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySegmentPortPrerequisites(withContext bool) string {
+	return testAccNsxtPolicySegmentImportTemplate(getOverlayTransportZoneName(), getAccTestResourceName(), withContext) + `
+data "nsxt_policy_spoofguard_profile" "test" {
+  display_name = "default-spoofguard-profile"
+}`
+}
+
+func testAccNsxtPolicySegmentPortTemplate(createFlow, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySegmentPortCreateAttributes
+	} else {
+		attrMap = accTestPolicySegmentPortUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return testAccNsxtPolicySegmentPortPrerequisites(withContext) + fmt.Sprintf(`
+
+resource "nsxt_policy_segment_port" "test" {
+%s
+  segment_path = nsxt_policy_segment.test.path
+  display_name = "%s"
+  description  = "%s"
+  admin_state  = "%s"
+
+  attachment {
+    type = "INDEPENDENT"
+  }
+
+  address_binding {
+    ip_address  = "%s"
+    mac_address = "%s"
+    vlan_id     = %s
+  }
+
+  security_profile {
+    spoofguard_profile_path = data.nsxt_policy_spoofguard_profile.test.path
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["admin_state"], attrMap["ip_address"], attrMap["mac_address"], attrMap["vlan_id"])
+}
+
+func testAccNsxtPolicySegmentPortMinimalistic(withContext bool) string {
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return testAccNsxtPolicySegmentPortPrerequisites(withContext) + fmt.Sprintf(`
+
+resource "nsxt_policy_segment_port" "test" {
+%s
+  segment_path = nsxt_policy_segment.test.path
+  display_name = "%s"
+}`, context, accTestPolicySegmentPortUpdateAttributes["display_name"])
+}
+
+func TestPolicySegmentPortCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	srv.Seed("/infra/segments/seg1", map[string]interface{}{"resource_type": "Segment"})
+	srv.Seed("/infra/tier-1s/t1/segments/seg2", map[string]interface{}{"resource_type": "Segment"})
+	srv.Seed("/orgs/default/projects/proj1/infra/segments/seg3", map[string]interface{}{"resource_type": "Segment"})
+
+	tests := []struct {
+		name         string
+		segmentPath  string
+		context      []interface{}
+		expectedPath string
+	}{
+		{
+			name:         "infra segment",
+			segmentPath:  "/infra/segments/seg1",
+			expectedPath: "/infra/segments/seg1/ports/port1",
+		},
+		{
+			name:         "fixed segment",
+			segmentPath:  "/infra/tier-1s/t1/segments/seg2",
+			expectedPath: "/infra/tier-1s/t1/segments/seg2/ports/port1",
+		},
+		{
+			name:         "project segment",
+			segmentPath:  "/orgs/default/projects/proj1/infra/segments/seg3",
+			context:      []interface{}{map[string]interface{}{"project_id": "proj1"}},
+			expectedPath: "/orgs/default/projects/proj1/infra/segments/seg3/ports/port1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := resourceNsxtPolicySegmentPort()
+			config := map[string]interface{}{
+				"nsx_id":       "port1",
+				"display_name": test.name,
+				"segment_path": test.segmentPath,
+				"attachment": []interface{}{map[string]interface{}{
+					"id":          "vif1",
+					"type":        "CHILD",
+					"context_id":  "parent-vif",
+					"traffic_tag": 100,
+				}},
+				"address_binding": []interface{}{map[string]interface{}{
+					"ip_address":  "10.0.0.10",
+					"mac_address": "00:50:56:00:11:22",
+				}},
+				"qos_profile":      []interface{}{map[string]interface{}{"qos_profile_path": "/infra/qos-profiles/qos1"}},
+				"security_profile": []interface{}{map[string]interface{}{"spoofguard_profile_path": "/infra/spoofguard-profiles/sg1"}},
+			}
+			if test.context != nil {
+				config["context"] = test.context
+			}
+			qosMapPath := test.expectedPath + "/port-qos-profile-binding-maps/default"
+
+			testSimulatorCrud(t, srv, m, []testSimulatorResource{{
+				name:     "port",
+				resource: r,
+				config:   config,
+				path:     test.expectedPath,
+				checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+					if d.Get("path").(string) != test.expectedPath {
+						t.Errorf("expected path %s, got %s", test.expectedPath, d.Get("path"))
+					}
+					attachment := obj["attachment"].(map[string]interface{})
+					if attachment["context_id"] != "parent-vif" || fmt.Sprint(attachment["traffic_tag"]) != "100" || obj["admin_state"] != "UP" {
+						t.Errorf("unexpected port on NSX: %v", obj)
+					}
+					if len(obj["address_bindings"].([]interface{})) != 1 {
+						t.Errorf("expected single address binding, got %v", obj["address_bindings"])
+					}
+					if _, ok := srv.Get(qosMapPath); !ok {
+						t.Errorf("QoS binding map was not created on NSX")
+					}
+					if d.Get("qos_profile.0.binding_map_path") != qosMapPath || d.Get("security_profile.0.spoofguard_profile_path") != "/infra/spoofguard-profiles/sg1" {
+						t.Errorf("unexpected profiles in state: %v", d.State())
+					}
+				},
+				// remove QoS profile and address bindings
+				update: map[string]interface{}{
+					"display_name":    "updated",
+					"qos_profile":     nil,
+					"address_binding": nil,
+				},
+				checkUpdate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+					if obj["display_name"] != "updated" || len(obj["address_bindings"].([]interface{})) != 0 {
+						t.Errorf("unexpected port on NSX after update: %v", obj)
+					}
+					if _, ok := srv.Get(qosMapPath); ok {
+						t.Errorf("QoS binding map was not removed on NSX")
+					}
+
+					// import by path restores segment path and context
+					imported := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+					imported.SetId(test.expectedPath)
+					if _, err := r.Importer.State(imported, m); err != nil {
+						t.Fatalf("import failed: %v", err)
+					}
+					if diags := r.ReadContext(context.Background(), imported, m); diags.HasError() {
+						t.Fatalf("read after import failed: %v", diags)
+					}
+					if imported.Get("segment_path") != test.segmentPath || imported.Get("attachment.0.id") != "vif1" || imported.Get("security_profile.#") != 1 {
+						t.Errorf("unexpected state after import: %v", imported.State())
+					}
+				},
+			}})
+		})
+	}
+}
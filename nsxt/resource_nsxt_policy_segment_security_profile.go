@@ -4,8 +4,6 @@
 package nsxt
 
 import (
-	"fmt"
-	"log"
 	"reflect"
 
 	"github.com/vmware/terraform-provider-nsxt/api/infra"
@@ -237,148 +235,25 @@ var segmentSecurityProfileSchema = map[string]*metadata.ExtendedSchema{
 	},
 }
 
-func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
-	return &schema.Resource{
-		Create: resourceNsxtPolicySegmentSecurityProfileCreate,
-		Read:   resourceNsxtPolicySegmentSecurityProfileRead,
-		Update: resourceNsxtPolicySegmentSecurityProfileUpdate,
-		Delete: resourceNsxtPolicySegmentSecurityProfileDelete,
-		Importer: &schema.ResourceImporter{
-			State: nsxtPolicyPathResourceImporter,
-		},
-
-		Schema: metadata.GetSchemaFromExtendedSchema(segmentSecurityProfileSchema),
-	}
-}
-
-func resourceNsxtPolicySegmentSecurityProfileExists(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
-	client := infra.NewSegmentSecurityProfilesClient(context, connector)
-	if client == nil {
-		return false, policyResourceNotSupportedError()
-	}
-	_, err := client.Get(id)
-	if err == nil {
-		return true, nil
-	}
-
-	if isNotFoundError(err) {
-		return false, nil
-	}
-
-	return false, logAPIError("Error retrieving resource", err)
-}
-
-func resourceNsxtPolicySegmentSecurityProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
-	connector := getPolicyConnector(m)
-
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	tags := getPolicyTagsFromSchema(d)
+var segmentSecurityProfileResource = policyMetadataResource[model.SegmentSecurityProfile]{
+	name:      "SegmentSecurityProfile",
+	schema:    segmentSecurityProfileSchema,
+	newClient: newPolicyResourceClientFactory[model.SegmentSecurityProfile](infra.NewSegmentSecurityProfilesClient),
 	// example of attribute that we prefer to handle manually in the code
 	// it is marked with `skip` in metadata
-	bpduFilterAllow := getStringListFromSchemaSet(d, "bpdu_filter_allow")
-
-	obj := model.SegmentSecurityProfile{
-		DisplayName:     &displayName,
-		Description:     &description,
-		Tags:            tags,
-		BpduFilterAllow: bpduFilterAllow,
-	}
-	elem := reflect.ValueOf(&obj).Elem()
-	if err := metadata.SchemaToStruct(elem, d, segmentSecurityProfileSchema, "", nil); err != nil {
-		return err
-	}
-
-	log.Printf("[INFO] Sending SegmentSecurityProfile with ID %s", id)
-	client := infra.NewSegmentSecurityProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	return client.Patch(id, obj, nil)
-}
-
-func resourceNsxtPolicySegmentSecurityProfileCreate(d *schema.ResourceData, m interface{}) error {
-
-	// Initialize resource Id and verify this ID is not yet used
-	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySegmentSecurityProfileExists)
-	if err != nil {
-		return err
-	}
-
-	err = resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
-	if err != nil {
-		return handleCreateError("SegmentSecurityProfile", id, err)
-	}
-
-	d.SetId(id)
-	d.Set("nsx_id", id)
-
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
-}
-
-func resourceNsxtPolicySegmentSecurityProfileRead(d *schema.ResourceData, m interface{}) error {
-	connector := getPolicyConnector(m)
-
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
-	}
-
-	client := infra.NewSegmentSecurityProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	obj, err := client.Get(id)
-	if err != nil {
-		return handleReadError(d, "SegmentSecurityProfile", id, err)
-	}
-
-	elem := reflect.ValueOf(&obj).Elem()
-	if err := metadata.StructToSchema(elem, d, segmentSecurityProfileSchema, "", nil); err != nil {
-		return err
-	}
-
-	d.Set("display_name", obj.DisplayName)
-	d.Set("description", obj.Description)
-	setPolicyTagsInSchema(d, obj.Tags)
-	d.Set("nsx_id", id)
-	d.Set("path", obj.Path)
-	d.Set("revision", obj.Revision)
-
-	return nil
+	schemaToModel: func(d *schema.ResourceData, obj *model.SegmentSecurityProfile) error {
+		obj.BpduFilterAllow = getStringListFromSchemaSet(d, "bpdu_filter_allow")
+		return nil
+	},
+	modelToSchema: func(d *schema.ResourceData, obj *model.SegmentSecurityProfile) error {
+		return d.Set("bpdu_filter_allow", obj.BpduFilterAllow)
+	},
 }
 
-func resourceNsxtPolicySegmentSecurityProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	log.Printf("[INFO] updating SegmentSecurityProfile")
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
-	}
-
-	err := resourceNsxtPolicySegmentSecurityProfilePatch(d, m, id)
-	if err != nil {
-		return handleUpdateError("SegmentSecurityProfile", id, err)
-	}
-
-	return resourceNsxtPolicySegmentSecurityProfileRead(d, m)
+func resourceNsxtPolicySegmentSecurityProfile() *schema.Resource {
+	return segmentSecurityProfileResource.resource()
 }
 
-func resourceNsxtPolicySegmentSecurityProfileDelete(d *schema.ResourceData, m interface{}) error {
-	id := d.Id()
-	if id == "" {
-		return fmt.Errorf("Error obtaining SegmentSecurityProfile ID")
-	}
-
-	connector := getPolicyConnector(m)
-	client := infra.NewSegmentSecurityProfilesClient(getSessionContext(d, m), connector)
-	if client == nil {
-		return policyResourceNotSupportedError()
-	}
-	err := client.Delete(id, nil)
-
-	if err != nil {
-		return handleDeleteError("SegmentSecurityProfile", id, err)
-	}
-
-	return nil
+func resourceNsxtPolicySegmentSecurityProfileExists(context utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return segmentSecurityProfileResource.exists(context, id, connector)
 }
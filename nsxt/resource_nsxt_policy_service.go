@@ -21,10 +21,10 @@ import (
 
 func resourceNsxtPolicyService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyServiceCreate,
-		Read:   resourceNsxtPolicyServiceRead,
-		Update: resourceNsxtPolicyServiceUpdate,
-		Delete: resourceNsxtPolicyServiceDelete,
+		CreateContext: withContext(resourceNsxtPolicyServiceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyServiceRead),
+		UpdateContext: withContext(resourceNsxtPolicyServiceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyServiceDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -0,0 +1,210 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func getSessionTimerSchema(description string, defaultValue int) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  description,
+		Optional:     true,
+		Default:      defaultValue,
+		ValidateFunc: validation.IntBetween(10, 4320000),
+	}
+}
+
+func resourceNsxtPolicySessionTimerProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicySessionTimerProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicySessionTimerProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicySessionTimerProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySessionTimerProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":            getNsxIDSchema(),
+			"path":              getPathSchema(),
+			"display_name":      getDisplayNameSchema(),
+			"description":       getDescriptionSchema(),
+			"revision":          getRevisionSchema(),
+			"tag":               getTagsSchema(),
+			"context":           getContextSchema(false, false, false),
+			"icmp_error_reply":  getSessionTimerSchema("Timeout in seconds after ICMP error reply is received", 10),
+			"icmp_first_packet": getSessionTimerSchema("Timeout in seconds after first ICMP packet", 20),
+			"tcp_closed":        getSessionTimerSchema("Timeout in seconds after TCP connection is closed", 20),
+			"tcp_closing":       getSessionTimerSchema("Timeout in seconds after TCP connection starts closing", 120),
+			"tcp_established":   getSessionTimerSchema("Timeout in seconds after TCP connection is established", 43200),
+			"tcp_finwait":       getSessionTimerSchema("Timeout in seconds after both FINs are exchanged", 45),
+			"tcp_first_packet":  getSessionTimerSchema("Timeout in seconds after first TCP packet", 120),
+			"tcp_opening":       getSessionTimerSchema("Timeout in seconds after second TCP packet", 30),
+			"udp_first_packet":  getSessionTimerSchema("Timeout in seconds after first UDP packet", 60),
+			"udp_multiple":      getSessionTimerSchema("Timeout in seconds after both hosts have sent UDP packets", 60),
+			"udp_single":        getSessionTimerSchema("Timeout in seconds after source host sends more than one UDP packet", 30),
+		},
+	}
+}
+
+func resourceNsxtPolicySessionTimerProfileExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewFirewallSessionTimerProfilesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicySessionTimerProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	icmpErrorReply := int64(d.Get("icmp_error_reply").(int))
+	icmpFirstPacket := int64(d.Get("icmp_first_packet").(int))
+	tcpClosed := int64(d.Get("tcp_closed").(int))
+	tcpClosing := int64(d.Get("tcp_closing").(int))
+	tcpEstablished := int64(d.Get("tcp_established").(int))
+	tcpFinwait := int64(d.Get("tcp_finwait").(int))
+	tcpFirstPacket := int64(d.Get("tcp_first_packet").(int))
+	tcpOpening := int64(d.Get("tcp_opening").(int))
+	udpFirstPacket := int64(d.Get("udp_first_packet").(int))
+	udpMultiple := int64(d.Get("udp_multiple").(int))
+	udpSingle := int64(d.Get("udp_single").(int))
+
+	obj := model.PolicyFirewallSessionTimerProfile{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		IcmpErrorReply:  &icmpErrorReply,
+		IcmpFirstPacket: &icmpFirstPacket,
+		TcpClosed:       &tcpClosed,
+		TcpClosing:      &tcpClosing,
+		TcpEstablished:  &tcpEstablished,
+		TcpFinwait:      &tcpFinwait,
+		TcpFirstPacket:  &tcpFirstPacket,
+		TcpOpening:      &tcpOpening,
+		UdpFirstPacket:  &udpFirstPacket,
+		UdpMultiple:     &udpMultiple,
+		UdpSingle:       &udpSingle,
+	}
+
+	log.Printf("[INFO] Patching SessionTimerProfile with ID %s", id)
+	client := infra.NewFirewallSessionTimerProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	return client.Patch(id, obj, nil)
+}
+
+func resourceNsxtPolicySessionTimerProfileCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicySessionTimerProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicySessionTimerProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("SessionTimerProfile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySessionTimerProfileRead(d, m)
+}
+
+func resourceNsxtPolicySessionTimerProfileRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfile ID")
+	}
+
+	client := infra.NewFirewallSessionTimerProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "SessionTimerProfile", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("icmp_error_reply", obj.IcmpErrorReply)
+	d.Set("icmp_first_packet", obj.IcmpFirstPacket)
+	d.Set("tcp_closed", obj.TcpClosed)
+	d.Set("tcp_closing", obj.TcpClosing)
+	d.Set("tcp_established", obj.TcpEstablished)
+	d.Set("tcp_finwait", obj.TcpFinwait)
+	d.Set("tcp_first_packet", obj.TcpFirstPacket)
+	d.Set("tcp_opening", obj.TcpOpening)
+	d.Set("udp_first_packet", obj.UdpFirstPacket)
+	d.Set("udp_multiple", obj.UdpMultiple)
+	d.Set("udp_single", obj.UdpSingle)
+
+	return nil
+}
+
+func resourceNsxtPolicySessionTimerProfileUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfile ID")
+	}
+
+	err := resourceNsxtPolicySessionTimerProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("SessionTimerProfile", id, err)
+	}
+
+	return resourceNsxtPolicySessionTimerProfileRead(d, m)
+}
+
+func resourceNsxtPolicySessionTimerProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewFirewallSessionTimerProfilesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id, nil)
+	if err != nil {
+		return handleDeleteError("SessionTimerProfile", id, err)
+	}
+	return nil
+}
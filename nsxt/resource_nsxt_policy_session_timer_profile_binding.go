@@ -0,0 +1,221 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/domains/groups"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicySessionTimerProfileBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicySessionTimerProfileBindingCreate),
+		ReadContext:   withContext(resourceNsxtPolicySessionTimerProfileBindingRead),
+		UpdateContext: withContext(resourceNsxtPolicySessionTimerProfileBindingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySessionTimerProfileBindingDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtSessionTimerProfileBindingImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(false, false, false),
+			"profile_path": {
+				Type:         schema.TypeString,
+				Description:  "The path of the session timer profile",
+				Required:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"group_path": {
+				Type:         schema.TypeString,
+				Description:  "The path of the group to bind with the session timer profile",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"sequence_number": {
+				Type:        schema.TypeInt,
+				Description: "Sequence number of this profile binding",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingPatch(d *schema.ResourceData, m interface{}, id string, isCreate bool) error {
+	connector := getPolicyConnector(m)
+	bindingClient := groups.NewFirewallSessionTimerProfileBindingMapsClient(getSessionContext(d, m), connector)
+	if bindingClient == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	profilePath := d.Get("profile_path").(string)
+	seqNum := int64(d.Get("sequence_number").(int))
+	obj := model.PolicyFirewallSessionTimerProfileBindingMap{
+		DisplayName:                     &displayName,
+		Description:                     &description,
+		Tags:                            tags,
+		FirewallSessionTimerProfilePath: &profilePath,
+		SequenceNumber:                  &seqNum,
+	}
+
+	groupPath := d.Get("group_path").(string)
+	groupID := getPolicyIDFromPath(groupPath)
+	domain := getDomainFromResourcePath(groupPath)
+
+	if !isCreate {
+		revision := int64(d.Get("revision").(int))
+		obj.Revision = &revision
+	}
+	return bindingClient.Patch(domain, groupID, id, obj)
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingExists(sessionContext utl.SessionContext, connector client.Connector, groupPath, id string) (bool, error) {
+	bindingClient := groups.NewFirewallSessionTimerProfileBindingMapsClient(sessionContext, connector)
+	if bindingClient == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getPolicyIDFromPath(groupPath)
+	_, err := bindingClient.Get(domain, groupID, id)
+
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingCreate(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	}
+
+	groupPath := d.Get("group_path").(string)
+	exist, err := resourceNsxtPolicySessionTimerProfileBindingExists(getSessionContext(d, m), getPolicyConnector(m), groupPath, id)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return fmt.Errorf("Resource with id %s already exists", id)
+	}
+
+	err = resourceNsxtPolicySessionTimerProfileBindingPatch(d, m, id, true)
+	if err != nil {
+		return handleCreateError("SessionTimerProfileBinding", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySessionTimerProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfileBinding ID")
+	}
+
+	connector := getPolicyConnector(m)
+	bindingClient := groups.NewFirewallSessionTimerProfileBindingMapsClient(getSessionContext(d, m), connector)
+	if bindingClient == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	groupPath := d.Get("group_path").(string)
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getPolicyIDFromPath(groupPath)
+
+	binding, err := bindingClient.Get(domain, groupID, id)
+	if err != nil {
+		return handleReadError(d, "SessionTimerProfileBinding", id, err)
+	}
+
+	d.Set("display_name", binding.DisplayName)
+	d.Set("description", binding.Description)
+	setPolicyTagsInSchema(d, binding.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", binding.Path)
+	d.Set("revision", binding.Revision)
+
+	d.Set("profile_path", binding.FirewallSessionTimerProfilePath)
+	d.Set("sequence_number", binding.SequenceNumber)
+
+	return nil
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfileBinding ID")
+	}
+
+	err := resourceNsxtPolicySessionTimerProfileBindingPatch(d, m, id, false)
+	if err != nil {
+		return handleUpdateError("SessionTimerProfileBinding", id, err)
+	}
+
+	return resourceNsxtPolicySessionTimerProfileBindingRead(d, m)
+}
+
+func resourceNsxtPolicySessionTimerProfileBindingDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SessionTimerProfileBinding ID")
+	}
+
+	connector := getPolicyConnector(m)
+	bindingClient := groups.NewFirewallSessionTimerProfileBindingMapsClient(getSessionContext(d, m), connector)
+	if bindingClient == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	groupPath := d.Get("group_path").(string)
+	domain := getDomainFromResourcePath(groupPath)
+	groupID := getPolicyIDFromPath(groupPath)
+
+	err := bindingClient.Delete(domain, groupID, id)
+	if err != nil {
+		return handleDeleteError("SessionTimerProfileBinding", id, err)
+	}
+	return nil
+}
+
+func nsxtSessionTimerProfileBindingImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	_, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return nil, err
+	}
+	targetSection := "/firewall-session-timer-profile-binding-maps/"
+	splitIdx := strings.LastIndex(importID, targetSection)
+	if splitIdx == -1 {
+		return nil, fmt.Errorf("invalid importID for SessionTimerProfileBinding: %s", importID)
+	}
+	parentPath := importID[:splitIdx]
+	id := importID[splitIdx+len(targetSection):]
+	d.Set("group_path", parentPath)
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
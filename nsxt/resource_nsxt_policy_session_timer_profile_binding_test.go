@@ -0,0 +1,198 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySessionTimerProfileBindingCreateAttributes = map[string]string{
+	"description":      "terraform created",
+	"profile_res_name": "test1",
+	"seq_num":          "10",
+}
+
+var accTestPolicySessionTimerProfileBindingUpdateAttributes = map[string]string{
+	"description":      "terraform updated",
+	"profile_res_name": "test2",
+	"seq_num":          "12",
+}
+
+func TestAccResourceNsxtPolicySessionTimerProfileBinding_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_session_timer_profile_binding.test"
+	name := getAccTestResourceName()
+	updatedName := fmt.Sprintf("%s-updated", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySessionTimerProfileBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySessionTimerProfileBindingTemplate(true, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySessionTimerProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySessionTimerProfileBindingCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", accTestPolicySessionTimerProfileBindingCreateAttributes["seq_num"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_session_timer_profile.test1", "path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySessionTimerProfileBindingTemplate(false, updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySessionTimerProfileBindingExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySessionTimerProfileBindingUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", accTestPolicySessionTimerProfileBindingUpdateAttributes["seq_num"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "profile_path", "nsxt_policy_session_timer_profile.test2", "path"),
+
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySessionTimerProfileBinding_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_session_timer_profile_binding.test"
+	name := getAccTestResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySessionTimerProfileBindingCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySessionTimerProfileBindingTemplate(true, name),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySessionTimerProfileBindingExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy SessionTimerProfileBinding resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy SessionTimerProfileBinding resource ID not set in resources")
+		}
+		groupPath := rs.Primary.Attributes["group_path"]
+		if groupPath == "" {
+			return fmt.Errorf("Policy SessionTimerProfileBinding resource group_path not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySessionTimerProfileBindingExists(testAccGetSessionContext(), connector, groupPath, resourceID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy SessionTimerProfileBinding %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySessionTimerProfileBindingCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_session_timer_profile_binding" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		groupPath := rs.Primary.Attributes["group_path"]
+		exists, err := resourceNsxtPolicySessionTimerProfileBindingExists(testAccGetSessionContext(), connector, groupPath, resourceID)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy SessionTimerProfileBinding %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySessionTimerProfileBindingTemplate(createFlow bool, name string) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySessionTimerProfileBindingCreateAttributes
+	} else {
+		attrMap = accTestPolicySessionTimerProfileBindingUpdateAttributes
+	}
+	return testAccNsxtPolicySessionTimerProfileBindingDeps() + fmt.Sprintf(`
+resource "nsxt_policy_session_timer_profile_binding" "test" {
+  display_name    = "%s"
+  description     = "%s"
+  profile_path    = nsxt_policy_session_timer_profile.%s.path
+  group_path      = nsxt_policy_group.test.path
+  sequence_number = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}
+`, name, attrMap["description"], attrMap["profile_res_name"], attrMap["seq_num"])
+}
+
+func testAccNsxtPolicySessionTimerProfileBindingDeps() string {
+	return `
+resource "nsxt_policy_group" "test" {
+  display_name = "testgroup"
+  description  = "Acceptance Test"
+
+  criteria {
+    condition {
+      key         = "OSName"
+      member_type = "VirtualMachine"
+      operator    = "CONTAINS"
+      value       = "Ubuntu"
+    }
+  }
+}
+
+resource "nsxt_policy_session_timer_profile" "test1" {
+  display_name    = "stp1"
+  tcp_established = 3600
+}
+
+resource "nsxt_policy_session_timer_profile" "test2" {
+  display_name    = "stp2"
+  tcp_established = 7200
+}
+`
+}
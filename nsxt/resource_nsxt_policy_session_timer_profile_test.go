@@ -0,0 +1,234 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySessionTimerProfileCreateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform created",
+	"tcp_established":  "3600",
+	"tcp_first_packet": "60",
+	"udp_single":       "20",
+}
+
+var accTestPolicySessionTimerProfileUpdateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform updated",
+	"tcp_established":  "7200",
+	"tcp_first_packet": "90",
+	"udp_single":       "40",
+}
+
+func TestAccResourceNsxtPolicySessionTimerProfile_basic(t *testing.T) {
+	testAccResourceNsxtPolicySessionTimerProfileBasic(t, false, func() {
+		testAccPreCheck(t)
+	})
+}
+
+func TestAccResourceNsxtPolicySessionTimerProfile_multitenancy(t *testing.T) {
+	testAccResourceNsxtPolicySessionTimerProfileBasic(t, true, func() {
+		testAccPreCheck(t)
+		testAccOnlyMultitenancy(t)
+	})
+}
+
+func testAccResourceNsxtPolicySessionTimerProfileBasic(t *testing.T, withContext bool, preCheck func()) {
+	testResourceName := "nsxt_policy_session_timer_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  preCheck,
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySessionTimerProfileCheckDestroy(state, accTestPolicySessionTimerProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySessionTimerProfileTemplate(true, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySessionTimerProfileExists(accTestPolicySessionTimerProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySessionTimerProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySessionTimerProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_established", accTestPolicySessionTimerProfileCreateAttributes["tcp_established"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_first_packet", accTestPolicySessionTimerProfileCreateAttributes["tcp_first_packet"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_single", accTestPolicySessionTimerProfileCreateAttributes["udp_single"]),
+					resource.TestCheckResourceAttr(testResourceName, "icmp_error_reply", "10"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySessionTimerProfileTemplate(false, withContext),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySessionTimerProfileExists(accTestPolicySessionTimerProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySessionTimerProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySessionTimerProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_established", accTestPolicySessionTimerProfileUpdateAttributes["tcp_established"]),
+					resource.TestCheckResourceAttr(testResourceName, "tcp_first_packet", accTestPolicySessionTimerProfileUpdateAttributes["tcp_first_packet"]),
+					resource.TestCheckResourceAttr(testResourceName, "udp_single", accTestPolicySessionTimerProfileUpdateAttributes["udp_single"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySessionTimerProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_session_timer_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySessionTimerProfileCheckDestroy(state, accTestPolicySessionTimerProfileCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySessionTimerProfileTemplate(true, false),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySessionTimerProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy SessionTimerProfile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy SessionTimerProfile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicySessionTimerProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy SessionTimerProfile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySessionTimerProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_session_timer_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicySessionTimerProfileExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy SessionTimerProfile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySessionTimerProfileTemplate(createFlow, withContext bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySessionTimerProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicySessionTimerProfileUpdateAttributes
+	}
+	context := ""
+	if withContext {
+		context = testAccNsxtPolicyMultitenancyContext()
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_session_timer_profile" "test" {
+%s
+  display_name     = "%s"
+  description      = "%s"
+  tcp_established  = %s
+  tcp_first_packet = %s
+  udp_single       = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, attrMap["display_name"], attrMap["description"], attrMap["tcp_established"], attrMap["tcp_first_packet"], attrMap["udp_single"])
+}
+
+func TestPolicySessionTimerProfileCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	srv.Seed("/infra/domains/default/groups/g1", map[string]interface{}{"resource_type": "Group"})
+
+	b := resourceNsxtPolicySessionTimerProfileBinding()
+	bindingPath := "/infra/domains/default/groups/g1/firewall-session-timer-profile-binding-maps/binding1"
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "session timer profile",
+			resource: resourceNsxtPolicySessionTimerProfile(),
+			config: map[string]interface{}{
+				"nsx_id":          "timers1",
+				"display_name":    "timers1",
+				"tcp_established": 3600,
+			},
+			path: "/infra/firewall-session-timer-profiles/timers1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if fmt.Sprint(obj["tcp_established"]) != "3600" || fmt.Sprint(obj["udp_single"]) != "30" {
+					t.Errorf("unexpected session timer profile on NSX: %v", obj)
+				}
+			},
+		},
+		{
+			name:     "session timer profile binding",
+			resource: b,
+			config: map[string]interface{}{
+				"nsx_id":          "binding1",
+				"display_name":    "binding1",
+				"profile_path":    "/infra/firewall-session-timer-profiles/timers1",
+				"group_path":      "/infra/domains/default/groups/g1",
+				"sequence_number": 10,
+			},
+			path: bindingPath,
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["firewall_session_timer_profile_path"] != "/infra/firewall-session-timer-profiles/timers1" || fmt.Sprint(obj["sequence_number"]) != "10" {
+					t.Errorf("unexpected session timer profile binding on NSX: %v", obj)
+				}
+
+				imported := schema.TestResourceDataRaw(t, b.Schema, map[string]interface{}{})
+				imported.SetId(bindingPath)
+				if _, err := nsxtSessionTimerProfileBindingImporter(imported, m); err != nil {
+					t.Fatalf("binding import failed: %v", err)
+				}
+				if imported.Id() != "binding1" || imported.Get("group_path") != "/infra/domains/default/groups/g1" {
+					t.Errorf("unexpected imported binding: %v", imported.State())
+				}
+			},
+		},
+	})
+}
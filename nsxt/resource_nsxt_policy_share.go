@@ -0,0 +1,189 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var shareSharingStrategyValues = []string{
+	model.Share_SHARING_STRATEGY_NONE_DESCENDANTS,
+	model.Share_SHARING_STRATEGY_ALL_DESCENDANTS,
+}
+
+func resourceNsxtPolicyShare() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyShareCreate),
+		ReadContext:   withContext(resourceNsxtPolicyShareRead),
+		UpdateContext: withContext(resourceNsxtPolicyShareUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyShareDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(false, false, false),
+			"shared_with": {
+				Type:        schema.TypeList,
+				Description: "Paths of the contexts (Org or Project) to share resources with",
+				Required:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+			},
+			"sharing_strategy": {
+				Type:         schema.TypeString,
+				Description:  "Sharing strategy, ALL_DESCENDANTS to share with all descendants of shared_with paths",
+				Optional:     true,
+				Default:      model.Share_SHARING_STRATEGY_NONE_DESCENDANTS,
+				ValidateFunc: validation.StringInSlice(shareSharingStrategyValues, false),
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyShareExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	client := infra.NewSharesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicySharePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	sharedWith := getStringListFromSchemaList(d, "shared_with")
+	sharingStrategy := d.Get("sharing_strategy").(string)
+
+	obj := model.Share{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		SharedWith:      sharedWith,
+		SharingStrategy: &sharingStrategy,
+	}
+
+	log.Printf("[INFO] Patching Share with ID %s", id)
+	client := infra.NewSharesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Patch(id, obj)
+	if err == nil {
+		// Objects shared with projects are looked up again on next search
+		getPolicySharedObjectsCache(connector).reset()
+	}
+	return err
+}
+
+func resourceNsxtPolicyShareCreate(d *schema.ResourceData, m interface{}) error {
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID2(d, m, resourceNsxtPolicyShareExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicySharePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("Share", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyShareRead(d, m)
+}
+
+func resourceNsxtPolicyShareRead(d *schema.ResourceData, m interface{}) error {
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Share ID")
+	}
+
+	client := infra.NewSharesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "Share", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	d.Set("shared_with", obj.SharedWith)
+	d.Set("sharing_strategy", obj.SharingStrategy)
+
+	return nil
+}
+
+func resourceNsxtPolicyShareUpdate(d *schema.ResourceData, m interface{}) error {
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Share ID")
+	}
+
+	err := resourceNsxtPolicySharePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("Share", id, err)
+	}
+
+	return resourceNsxtPolicyShareRead(d, m)
+}
+
+func resourceNsxtPolicyShareDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining Share ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewSharesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("Share", id, err)
+	}
+	getPolicySharedObjectsCache(connector).reset()
+	return nil
+}
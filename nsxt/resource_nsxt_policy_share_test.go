@@ -0,0 +1,356 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+var accTestPolicyShareCreateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform created",
+	"sharing_strategy": "NONE_DESCENDANTS",
+}
+
+var accTestPolicyShareUpdateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform updated",
+	"sharing_strategy": "ALL_DESCENDANTS",
+}
+
+func TestAccResourceNsxtPolicyShare_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.1")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyShareCheckDestroy(state, accTestPolicyShareUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyShareTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyShareExists(accTestPolicyShareCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyShareCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyShareCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sharing_strategy", accTestPolicyShareCreateAttributes["sharing_strategy"]),
+					resource.TestCheckResourceAttr(testResourceName, "shared_with.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "shared_with.0", "nsxt_policy_project.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyShareTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyShareExists(accTestPolicyShareUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyShareUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyShareUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "sharing_strategy", accTestPolicyShareUpdateAttributes["sharing_strategy"]),
+					resource.TestCheckResourceAttr(testResourceName, "shared_with.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyShare_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_share.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.1")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyShareCheckDestroy(state, accTestPolicyShareCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyShareTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyShareExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Share resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Share resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyShareExists(testAccGetSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Share %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyShareCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_share" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyShareExists(testAccGetSessionContext(), resourceID, connector)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Share %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyShareProjectTemplate() string {
+	return fmt.Sprintf(`
+resource "nsxt_policy_project" "test" {
+  display_name = "%s"
+}
+`, getAccTestResourceName())
+}
+
+func testAccNsxtPolicyShareTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyShareCreateAttributes
+	} else {
+		attrMap = accTestPolicyShareUpdateAttributes
+	}
+	return testAccNsxtPolicyShareProjectTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_share" "test" {
+  display_name     = "%s"
+  description      = "%s"
+  shared_with      = [nsxt_policy_project.test.path]
+  sharing_strategy = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["sharing_strategy"])
+}
+
+func TestPolicyShareCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	srv.Seed("/infra/segments/shared-segment", map[string]interface{}{
+		"display_name":  "test-segment",
+		"resource_type": "Segment",
+	})
+	srv.Seed("/infra/segments/private-segment", map[string]interface{}{
+		"display_name":  "private-segment",
+		"resource_type": "Segment",
+	})
+
+	ds := dataSourceNsxtPolicySegment()
+	sr := resourceNsxtPolicySharedResource()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "share",
+			resource: resourceNsxtPolicyShare(),
+			config: map[string]interface{}{
+				"nsx_id":       "share1",
+				"display_name": "share1",
+				"shared_with":  []interface{}{"/orgs/default/projects/proj1"},
+			},
+			path: "/infra/shares/share1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["sharing_strategy"] != "NONE_DESCENDANTS" {
+					t.Errorf("unexpected share on NSX: %v", obj)
+				}
+			},
+			// Sharing with all descendants of the org exposes objects to all projects
+			update: map[string]interface{}{
+				"shared_with":      []interface{}{"/orgs/default"},
+				"sharing_strategy": "ALL_DESCENDANTS",
+			},
+			checkUpdate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				dsData := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+					"display_name": "test-segment",
+					"context":      []interface{}{map[string]interface{}{"project_id": "proj2"}},
+				})
+				if diags := ds.ReadContext(context.Background(), dsData, m); diags.HasError() {
+					t.Fatalf("read failed: %v", diags)
+				}
+				if dsData.Get("path") != "/infra/segments/shared-segment" {
+					t.Errorf("unexpected shared segment path %s", dsData.Get("path"))
+				}
+			},
+		},
+		{
+			name:     "shared resource",
+			resource: sr,
+			config: map[string]interface{}{
+				"nsx_id":       "segments",
+				"display_name": "segments",
+				"share_path":   "/infra/shares/share1",
+				"resource_object": []interface{}{
+					map[string]interface{}{"resource_path": "/infra/segments/shared-segment"},
+				},
+			},
+			path: "/infra/shares/share1/resources/segments",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if d.Get("resource_object.0.resource_path") != "/infra/segments/shared-segment" || d.Get("resource_object.0.include_children") != false {
+					t.Errorf("unexpected state: %v", d.State())
+				}
+
+				// Shared objects are found by data sources in project context
+				tests := []struct {
+					name    string
+					project string
+					segment string
+					found   bool
+				}{
+					{name: "shared", project: "proj1", segment: "test-segment", found: true},
+					{name: "not shared", project: "proj1", segment: "private-segment", found: false},
+					{name: "other project", project: "proj2", segment: "test-segment", found: false},
+				}
+				for _, test := range tests {
+					t.Run(test.name, func(t *testing.T) {
+						dsData := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+							"display_name": test.segment,
+							"context":      []interface{}{map[string]interface{}{"project_id": test.project}},
+						})
+						diags := ds.ReadContext(context.Background(), dsData, m)
+						if test.found && diags.HasError() {
+							t.Fatalf("read failed: %v", diags)
+						}
+						if !test.found && !diags.HasError() {
+							t.Errorf("expected %s not to be found in %s, got %s", test.segment, test.project, dsData.Get("path"))
+						}
+					})
+				}
+
+				importer := schema.TestResourceDataRaw(t, sr.Schema, map[string]interface{}{})
+				importer.SetId("/infra/shares/share1/resources/segments")
+				if _, err := nsxtSharedResourceImporter(importer, m); err != nil {
+					t.Fatalf("import failed: %v", err)
+				}
+				if importer.Id() != "segments" || importer.Get("share_path") != "/infra/shares/share1" {
+					t.Errorf("unexpected imported state: %v", importer.State())
+				}
+			},
+		},
+	})
+}
+
+func TestPolicyShareSearchInProject(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	seedShare := func(shareID string, segmentID string) {
+		srv.Seed("/infra/segments/"+segmentID, map[string]interface{}{
+			"display_name":  segmentID,
+			"resource_type": "Segment",
+		})
+		srv.Seed("/infra/shares/"+shareID, map[string]interface{}{
+			"display_name":     shareID,
+			"resource_type":    "Share",
+			"sharedWith":       []interface{}{"/orgs/default/projects/proj1"},
+			"sharing_strategy": "NONE_DESCENDANTS",
+		})
+		srv.Seed("/infra/shares/"+shareID+"/resources/segments", map[string]interface{}{
+			"display_name":  "segments",
+			"resource_type": "SharedResource",
+			"resource_objects": []interface{}{
+				map[string]interface{}{"resource_path": "/infra/segments/" + segmentID, "include_children": false},
+			},
+		})
+	}
+	seedShare("share1", "web")
+	srv.Seed("/orgs/default/projects/proj1/infra/segments/web", map[string]interface{}{
+		"display_name":  "web",
+		"resource_type": "Segment",
+	})
+
+	ds := dataSourceNsxtPolicySegment()
+	read := func(displayName string) (*schema.ResourceData, bool) {
+		dsData := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+			"display_name": displayName,
+			"context":      []interface{}{map[string]interface{}{"project_id": "proj1"}},
+		})
+		diags := ds.ReadContext(context.Background(), dsData, m)
+		return dsData, !diags.HasError()
+	}
+
+	// Project object takes precedence over shared object with same name
+	dsData, found := read("web")
+	if !found || dsData.Get("path") != "/orgs/default/projects/proj1/infra/segments/web" {
+		t.Errorf("expected project segment to be found, got %s", dsData.Get("path"))
+	}
+
+	// Lookups of multiple objects find both project and shared objects
+	results, err := listPolicyResourcesByQuery(getPolicyConnector(m), utl.SessionContext{ClientType: utl.Multitenancy, ProjectID: "proj1"}, "resource_type:Segment")
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	var paths []string
+	for _, result := range results {
+		path, _ := result.String("path")
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	if strings.Join(paths, ",") != "/infra/segments/web,/orgs/default/projects/proj1/infra/segments/web" {
+		t.Errorf("unexpected search results %v", paths)
+	}
+
+	// Shares are listed once per provider, until cache is reset
+	dsData, found = read("missing")
+	if found {
+		t.Errorf("unexpected segment %s", dsData.Get("path"))
+	}
+	seedShare("share2", "db")
+	if _, found = read("db"); found {
+		t.Errorf("expected shares to be cached")
+	}
+	getPolicySharedObjectsCache(getPolicyConnector(m)).reset()
+	dsData, found = read("db")
+	if !found || dsData.Get("path") != "/infra/segments/db" {
+		t.Errorf("expected shared segment to be found, got %s", dsData.Get("path"))
+	}
+}
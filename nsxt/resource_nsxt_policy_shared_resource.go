@@ -0,0 +1,241 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	"github.com/vmware/terraform-provider-nsxt/api/infra/shares"
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func resourceNsxtPolicySharedResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicySharedResourceCreate),
+		ReadContext:   withContext(resourceNsxtPolicySharedResourceRead),
+		UpdateContext: withContext(resourceNsxtPolicySharedResourceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySharedResourceDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtSharedResourceImporter,
+		},
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"context":      getContextSchema(false, false, false),
+			"share_path": {
+				Type:         schema.TypeString,
+				Description:  "Path of the share this resource belongs to",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePolicyPath(),
+			},
+			"resource_object": {
+				Type:        schema.TypeList,
+				Description: "Objects to be shared",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_path": {
+							Type:         schema.TypeString,
+							Description:  "Path of the object to be shared",
+							Required:     true,
+							ValidateFunc: validatePolicyPath(),
+						},
+						"include_children": {
+							Type:        schema.TypeBool,
+							Description: "Whether children of the object are shared as well",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getPolicyResourceObjectsFromSchema(d *schema.ResourceData) []model.ResourceObject {
+	var objects []model.ResourceObject
+	for _, item := range d.Get("resource_object").([]interface{}) {
+		data := item.(map[string]interface{})
+		resourcePath := data["resource_path"].(string)
+		includeChildren := data["include_children"].(bool)
+		objects = append(objects, model.ResourceObject{
+			ResourcePath:    &resourcePath,
+			IncludeChildren: &includeChildren,
+		})
+	}
+	return objects
+}
+
+func setPolicyResourceObjectsInSchema(d *schema.ResourceData, objects []model.ResourceObject) error {
+	var objectList []map[string]interface{}
+	for _, object := range objects {
+		elem := make(map[string]interface{})
+		elem["resource_path"] = object.ResourcePath
+		elem["include_children"] = object.IncludeChildren
+		objectList = append(objectList, elem)
+	}
+	return d.Set("resource_object", objectList)
+}
+
+func resourceNsxtPolicySharedResourceExists(sessionContext utl.SessionContext, connector client.Connector, sharePath, id string) (bool, error) {
+	client := shares.NewResourcesClient(sessionContext, connector)
+	if client == nil {
+		return false, policyResourceNotSupportedError()
+	}
+	shareID := getPolicyIDFromPath(sharePath)
+	_, err := client.Get(shareID, id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func resourceNsxtPolicySharedResourcePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	client := shares.NewResourcesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	obj := model.SharedResource{
+		DisplayName:     &displayName,
+		Description:     &description,
+		Tags:            tags,
+		ResourceObjects: getPolicyResourceObjectsFromSchema(d),
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	log.Printf("[INFO] Patching SharedResource with ID %s under Share %s", id, shareID)
+	err := client.Patch(shareID, id, obj)
+	if err == nil {
+		// Objects shared with projects are looked up again on next search
+		getPolicySharedObjectsCache(connector).reset()
+	}
+	return err
+}
+
+func resourceNsxtPolicySharedResourceCreate(d *schema.ResourceData, m interface{}) error {
+	id := d.Get("nsx_id").(string)
+	if id == "" {
+		id = newUUID()
+	}
+
+	sharePath := d.Get("share_path").(string)
+	exist, err := resourceNsxtPolicySharedResourceExists(getSessionContext(d, m), getPolicyConnector(m), sharePath, id)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return fmt.Errorf("Resource with id %s already exists", id)
+	}
+
+	err = resourceNsxtPolicySharedResourcePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("SharedResource", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicySharedResourceRead(d, m)
+}
+
+func resourceNsxtPolicySharedResourceRead(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SharedResource ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := shares.NewResourcesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	obj, err := client.Get(shareID, id)
+	if err != nil {
+		return handleReadError(d, "SharedResource", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+
+	return setPolicyResourceObjectsInSchema(d, obj.ResourceObjects)
+}
+
+func resourceNsxtPolicySharedResourceUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SharedResource ID")
+	}
+
+	err := resourceNsxtPolicySharedResourcePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("SharedResource", id, err)
+	}
+
+	return resourceNsxtPolicySharedResourceRead(d, m)
+}
+
+func resourceNsxtPolicySharedResourceDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining SharedResource ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := shares.NewResourcesClient(getSessionContext(d, m), connector)
+	if client == nil {
+		return policyResourceNotSupportedError()
+	}
+
+	shareID := getPolicyIDFromPath(d.Get("share_path").(string))
+	err := client.Delete(shareID, id)
+	if err != nil {
+		return handleDeleteError("SharedResource", id, err)
+	}
+	getPolicySharedObjectsCache(connector).reset()
+	return nil
+}
+
+func nsxtSharedResourceImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	_, err := nsxtPolicyPathResourceImporterHelper(d, m)
+	if err != nil {
+		return nil, err
+	}
+	targetSection := "/resources/"
+	splitIdx := strings.LastIndex(importID, targetSection)
+	if splitIdx == -1 {
+		return nil, fmt.Errorf("invalid importID for SharedResource: %s", importID)
+	}
+	d.Set("share_path", importID[:splitIdx])
+	d.SetId(importID[splitIdx+len(targetSection):])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,182 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicySharedResourceCreateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform created",
+	"include_children": "false",
+}
+
+var accTestPolicySharedResourceUpdateAttributes = map[string]string{
+	"display_name":     getAccTestResourceName(),
+	"description":      "terraform updated",
+	"include_children": "true",
+}
+
+func TestAccResourceNsxtPolicySharedResource_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_shared_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.1")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySharedResourceCheckDestroy(state, accTestPolicySharedResourceUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySharedResourceExists(accTestPolicySharedResourceCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySharedResourceCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySharedResourceCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "resource_object.0.resource_path", "nsxt_policy_group.test", "path"),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.0.include_children", accTestPolicySharedResourceCreateAttributes["include_children"]),
+					resource.TestCheckResourceAttrPair(testResourceName, "share_path", "nsxt_policy_share.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicySharedResourceExists(accTestPolicySharedResourceUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicySharedResourceUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicySharedResourceUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "resource_object.0.include_children", accTestPolicySharedResourceUpdateAttributes["include_children"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicySharedResource_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_shared_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.1.1")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicySharedResourceCheckDestroy(state, accTestPolicySharedResourceCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicySharedResourceTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicySharedResourceExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy Shared Resource resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy Shared Resource resource ID not set in resources")
+		}
+
+		sharePath := rs.Primary.Attributes["share_path"]
+		exists, err := resourceNsxtPolicySharedResourceExists(testAccGetSessionContext(), connector, sharePath, resourceID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy Shared Resource %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicySharedResourceCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_shared_resource" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		sharePath := rs.Primary.Attributes["share_path"]
+		exists, err := resourceNsxtPolicySharedResourceExists(testAccGetSessionContext(), connector, sharePath, resourceID)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy Shared Resource %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicySharedResourceTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicySharedResourceCreateAttributes
+	} else {
+		attrMap = accTestPolicySharedResourceUpdateAttributes
+	}
+	return testAccNsxtPolicyShareProjectTemplate() + fmt.Sprintf(`
+resource "nsxt_policy_group" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_share" "test" {
+  display_name = "%s"
+  shared_with  = [nsxt_policy_project.test.path]
+}
+
+resource "nsxt_policy_shared_resource" "test" {
+  display_name = "%s"
+  description  = "%s"
+  share_path   = nsxt_policy_share.test.path
+
+  resource_object {
+    resource_path    = nsxt_policy_group.test.path
+    include_children = %s
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["display_name"], attrMap["display_name"], attrMap["description"], attrMap["include_children"])
+}
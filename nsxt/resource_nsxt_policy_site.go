@@ -20,10 +20,10 @@ var siteTypeValues = []string{
 
 func resourceNsxtPolicySite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicySiteCreate,
-		Read:   resourceNsxtPolicySiteRead,
-		Update: resourceNsxtPolicySiteUpdate,
-		Delete: resourceNsxtPolicySiteDelete,
+		CreateContext: withContext(resourceNsxtPolicySiteCreate),
+		ReadContext:   withContext(resourceNsxtPolicySiteRead),
+		UpdateContext: withContext(resourceNsxtPolicySiteUpdate),
+		DeleteContext: withContext(resourceNsxtPolicySiteDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
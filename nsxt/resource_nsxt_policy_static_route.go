@@ -22,10 +22,10 @@ import (
 
 func resourceNsxtPolicyStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteCreate,
-		Read:   resourceNsxtPolicyStaticRouteRead,
-		Update: resourceNsxtPolicyStaticRouteUpdate,
-		Delete: resourceNsxtPolicyStaticRouteDelete,
+		CreateContext: withContext(resourceNsxtPolicyStaticRouteCreate),
+		ReadContext:   withContext(resourceNsxtPolicyStaticRouteRead),
+		UpdateContext: withContext(resourceNsxtPolicyStaticRouteUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyStaticRouteDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyStaticRouteImport,
 		},
@@ -18,10 +18,10 @@ import (
 
 func resourceNsxtPolicyStaticRouteBfdPeer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyStaticRouteBfdPeerCreate,
-		Read:   resourceNsxtPolicyStaticRouteBfdPeerRead,
-		Update: resourceNsxtPolicyStaticRouteBfdPeerUpdate,
-		Delete: resourceNsxtPolicyStaticRouteBfdPeerDelete,
+		CreateContext: withContext(resourceNsxtPolicyStaticRouteBfdPeerCreate),
+		ReadContext:   withContext(resourceNsxtPolicyStaticRouteBfdPeerRead),
+		UpdateContext: withContext(resourceNsxtPolicyStaticRouteBfdPeerUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyStaticRouteBfdPeerDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayImporter,
 		},
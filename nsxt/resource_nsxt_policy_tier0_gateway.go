@@ -44,10 +44,10 @@ var policyBGPGracefulRestartStaleRouteTimerDefault = 600
 func resourceNsxtPolicyTier0Gateway() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayCreate,
-		Read:   resourceNsxtPolicyTier0GatewayRead,
-		Update: resourceNsxtPolicyTier0GatewayUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier0GatewayCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier0GatewayRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier0GatewayUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier0GatewayDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -19,10 +19,10 @@ import (
 
 func resourceNsxtPolicyTier0GatewayGRETunnel() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayGRETunnelCreate,
-		Read:   resourceNsxtPolicyTier0GatewayGRETunnelRead,
-		Update: resourceNsxtPolicyTier0GatewayGRETunnelUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayGRETunnelDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier0GatewayGRETunnelCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier0GatewayGRETunnelRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier0GatewayGRETunnelUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier0GatewayGRETunnelDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayGRETunnelImport,
 		},
@@ -21,10 +21,10 @@ var interfaceLocaleSrvLocation = 5
 
 func resourceNsxtPolicyTier0GatewayHAVipConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayHAVipConfigCreate,
-		Read:   resourceNsxtPolicyTier0GatewayHAVipConfigRead,
-		Update: resourceNsxtPolicyTier0GatewayHAVipConfigUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayHAVipConfigDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier0GatewayHAVipConfigCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier0GatewayHAVipConfigRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier0GatewayHAVipConfigUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier0GatewayHAVipConfigDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayHAVipConfigImport,
 		},
@@ -34,10 +34,10 @@ var gatewayInterfaceOspfNetworkTypeValues = []string{
 
 func resourceNsxtPolicyTier0GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier0GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier0GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier0GatewayInterfaceDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier0GatewayInterfaceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier0GatewayInterfaceRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier0GatewayInterfaceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier0GatewayInterfaceDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0GatewayInterfaceImport,
 		},
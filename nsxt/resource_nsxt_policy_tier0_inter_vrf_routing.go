@@ -29,10 +29,10 @@ var routeAdvertisementTypesValues = []string{
 
 func resourceNsxtPolicyTier0InterVRFRouting() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier0InterVRFRoutingCreate,
-		Read:   resourceNsxtPolicyTier0InterVRFRoutingRead,
-		Update: resourceNsxtPolicyTier0InterVRFRoutingUpdate,
-		Delete: resourceNsxtPolicyTier0InterVRFRoutingDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier0InterVRFRoutingCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier0InterVRFRoutingRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier0InterVRFRoutingUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier0InterVRFRoutingDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier0InterVRFRoutingImport,
 		},
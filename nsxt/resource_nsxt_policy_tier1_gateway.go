@@ -57,10 +57,10 @@ var t1TypeValues = []string{
 
 func resourceNsxtPolicyTier1Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayCreate,
-		Read:   resourceNsxtPolicyTier1GatewayRead,
-		Update: resourceNsxtPolicyTier1GatewayUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier1GatewayCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier1GatewayRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier1GatewayUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier1GatewayDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -23,10 +23,10 @@ import (
 
 func resourceNsxtPolicyTier1GatewayInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTier1GatewayInterfaceCreate,
-		Read:   resourceNsxtPolicyTier1GatewayInterfaceRead,
-		Update: resourceNsxtPolicyTier1GatewayInterfaceUpdate,
-		Delete: resourceNsxtPolicyTier1GatewayInterfaceDelete,
+		CreateContext: withContext(resourceNsxtPolicyTier1GatewayInterfaceCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTier1GatewayInterfaceRead),
+		UpdateContext: withContext(resourceNsxtPolicyTier1GatewayInterfaceUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTier1GatewayInterfaceDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTier1GatewayInterfaceImport,
 		},
@@ -0,0 +1,447 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+const (
+	tlsDecryptionProfileTypeExternal = "EXTERNAL"
+	tlsDecryptionProfileTypeInternal = "INTERNAL"
+)
+
+var tlsDecryptionProfileTypeValues = []string{
+	tlsDecryptionProfileTypeExternal,
+	tlsDecryptionProfileTypeInternal,
+}
+
+var tlsDecryptionProfileVersionValues = []string{
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_0,
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_1,
+	model.TlsInspectionInternalProfile_CLIENT_MIN_TLS_VERSION_2,
+}
+
+var tlsDecryptionProfileCryptoEnforcementValues = []string{
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_ENFORCE,
+	model.TlsInspectionInternalProfile_CRYPTO_ENFORCEMENT_TRANSPARENT,
+}
+
+var tlsDecryptionProfileFailActionValues = []string{
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BLOCK,
+	model.TlsInspectionInternalProfile_DECRYPTION_FAIL_ACTION_BYPASS,
+}
+
+var tlsDecryptionProfileConfigSettingValues = []string{
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_BALANCED,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_FIDELITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_HIGH_SECURITY,
+	model.TlsInspectionInternalProfile_TLS_CONFIG_SETTING_CUSTOM,
+}
+
+var tlsDecryptionProfileInvalidCertActionValues = []string{
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_BLOCK,
+	model.TlsInspectionExternalProfile_INVALID_CERT_ACTION_ALLOW,
+}
+
+// Attributes that are only applicable to one of the decryption profile types
+var tlsDecryptionProfileExternalOnlyAttrs = []string{"invalid_cert_action", "proxy_trusted_ca_cert", "proxy_untrusted_ca_cert"}
+var tlsDecryptionProfileInternalOnlyAttrs = []string{"certificate_validation", "default_cert_key", "server_certs_key"}
+
+func getTlsDecryptionProfileEnumSchema(description string, values []string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  description,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice(values, false),
+	}
+}
+
+func getTlsDecryptionProfilePathSetSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validatePolicyPath(),
+		},
+	}
+}
+
+func getTlsDecryptionProfileCipherSuiteSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func resourceNsxtPolicyTlsDecryptionProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyTlsDecryptionProfileCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTlsDecryptionProfileRead),
+		UpdateContext: withContext(resourceNsxtPolicyTlsDecryptionProfileUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTlsDecryptionProfileDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nsx_id":       getNsxIDSchema(),
+			"path":         getPathSchema(),
+			"display_name": getDisplayNameSchema(),
+			"description":  getDescriptionSchema(),
+			"revision":     getRevisionSchema(),
+			"tag":          getTagsSchema(),
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "Decryption profile type, EXTERNAL for traffic to external servers and INTERNAL for traffic to servers owned by the enterprise",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(tlsDecryptionProfileTypeValues, false),
+			},
+			"client_cipher_suite":    getTlsDecryptionProfileCipherSuiteSchema("Cipher suites used on the client side of the connection"),
+			"client_max_tls_version": getTlsDecryptionProfileEnumSchema("Maximum TLS version on the client side of the connection", tlsDecryptionProfileVersionValues),
+			"client_min_tls_version": getTlsDecryptionProfileEnumSchema("Minimum TLS version on the client side of the connection", tlsDecryptionProfileVersionValues),
+			"server_cipher_suite":    getTlsDecryptionProfileCipherSuiteSchema("Cipher suites used on the server side of the connection"),
+			"server_max_tls_version": getTlsDecryptionProfileEnumSchema("Maximum TLS version on the server side of the connection", tlsDecryptionProfileVersionValues),
+			"server_min_tls_version": getTlsDecryptionProfileEnumSchema("Minimum TLS version on the server side of the connection", tlsDecryptionProfileVersionValues),
+			"crypto_enforcement":     getTlsDecryptionProfileEnumSchema("Enforcement of TLS version and cipher suites", tlsDecryptionProfileCryptoEnforcementValues),
+			"decryption_fail_action": getTlsDecryptionProfileEnumSchema("Action to take when TLS handshake fails", tlsDecryptionProfileFailActionValues),
+			"tls_config_setting":     getTlsDecryptionProfileEnumSchema("Pre-defined TLS version and cipher suite settings", tlsDecryptionProfileConfigSettingValues),
+			"ocsp_must_staple": {
+				Type:        schema.TypeBool,
+				Description: "Flag to activate OCSP must staple",
+				Optional:    true,
+				Default:     false,
+			},
+			"idle_connection_timeout": {
+				Type:         schema.TypeInt,
+				Description:  "Timeout in minutes for idle connections",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"crls":               getTlsDecryptionProfilePathSetSchema("Policy paths of certificate revocation lists"),
+			"trusted_ca_bundles": getTlsDecryptionProfilePathSetSchema("Policy paths of trusted CA bundles"),
+			"invalid_cert_action": {
+				Type:         schema.TypeString,
+				Description:  "Action to take when server presents invalid certificate, applicable to EXTERNAL type only",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(tlsDecryptionProfileInvalidCertActionValues, false),
+			},
+			"proxy_trusted_ca_cert": {
+				Type:        schema.TypeString,
+				Description: "Proxy CA certificate used to issue valid certificates, applicable to EXTERNAL type only",
+				Optional:    true,
+			},
+			"proxy_untrusted_ca_cert": {
+				Type:        schema.TypeString,
+				Description: "Proxy CA certificate used to issue invalid certificates, applicable to EXTERNAL type only",
+				Optional:    true,
+			},
+			"certificate_validation": {
+				Type:        schema.TypeBool,
+				Description: "Flag to activate server certificate validation, applicable to INTERNAL type only",
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_cert_key": {
+				Type:        schema.TypeString,
+				Description: "Default server certificate presented to the client, applicable to INTERNAL type only",
+				Optional:    true,
+			},
+			"server_certs_key": {
+				Type:        schema.TypeSet,
+				Description: "Server certificates presented to the client, applicable to INTERNAL type only",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNsxtPolicyTlsDecryptionProfileExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving resource", err)
+}
+
+func getOptionalStringFromSchema(d *schema.ResourceData, attr string) *string {
+	value, ok := d.GetOk(attr)
+	if !ok {
+		return nil
+	}
+	result := value.(string)
+	return &result
+}
+
+func validateTlsDecryptionProfileAttributes(d *schema.ResourceData, profileType string) error {
+	unsupported := tlsDecryptionProfileInternalOnlyAttrs
+	if profileType == tlsDecryptionProfileTypeInternal {
+		unsupported = tlsDecryptionProfileExternalOnlyAttrs
+	}
+	for _, attr := range unsupported {
+		if _, ok := d.GetOkExists(attr); ok {
+			return fmt.Errorf("%s is not applicable to %s decryption profile", attr, profileType)
+		}
+	}
+	return nil
+}
+
+func resourceNsxtPolicyTlsDecryptionProfilePatch(d *schema.ResourceData, m interface{}, id string) error {
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	profileType := d.Get("type").(string)
+	if err := validateTlsDecryptionProfileAttributes(d, profileType); err != nil {
+		return err
+	}
+
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	clientCipherSuite := getStringListFromSchemaSet(d, "client_cipher_suite")
+	serverCipherSuite := getStringListFromSchemaSet(d, "server_cipher_suite")
+	ocspMustStaple := d.Get("ocsp_must_staple").(bool)
+	crls := getStringListFromSchemaSet(d, "crls")
+	trustedCaBundles := getStringListFromSchemaSet(d, "trusted_ca_bundles")
+	var idleConnectionTimeout *int64
+	if value, ok := d.GetOk("idle_connection_timeout"); ok {
+		timeout := int64(value.(int))
+		idleConnectionTimeout = &timeout
+	}
+
+	var dataValue data.DataValue
+	var errs []error
+	if profileType == tlsDecryptionProfileTypeExternal {
+		obj := model.TlsInspectionExternalProfile{
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			ResourceType:          model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONEXTERNALPROFILE,
+			ClientCipherSuite:     clientCipherSuite,
+			ClientMaxTlsVersion:   getOptionalStringFromSchema(d, "client_max_tls_version"),
+			ClientMinTlsVersion:   getOptionalStringFromSchema(d, "client_min_tls_version"),
+			ServerCipherSuite:     serverCipherSuite,
+			ServerMaxTlsVersion:   getOptionalStringFromSchema(d, "server_max_tls_version"),
+			ServerMinTlsVersion:   getOptionalStringFromSchema(d, "server_min_tls_version"),
+			CryptoEnforcement:     getOptionalStringFromSchema(d, "crypto_enforcement"),
+			DecryptionFailAction:  getOptionalStringFromSchema(d, "decryption_fail_action"),
+			TlsConfigSetting:      getOptionalStringFromSchema(d, "tls_config_setting"),
+			OcspMustStaple:        &ocspMustStaple,
+			IdleConnectionTimeout: idleConnectionTimeout,
+			Crls:                  crls,
+			TrustedCaBundles:      trustedCaBundles,
+			InvalidCertAction:     getOptionalStringFromSchema(d, "invalid_cert_action"),
+			ProxyTrustedCaCert:    getOptionalStringFromSchema(d, "proxy_trusted_ca_cert"),
+			ProxyUntrustedCaCert:  getOptionalStringFromSchema(d, "proxy_untrusted_ca_cert"),
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.TlsInspectionExternalProfileBindingType())
+	} else {
+		obj := model.TlsInspectionInternalProfile{
+			DisplayName:           &displayName,
+			Description:           &description,
+			Tags:                  tags,
+			ResourceType:          model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONINTERNALPROFILE,
+			ClientCipherSuite:     clientCipherSuite,
+			ClientMaxTlsVersion:   getOptionalStringFromSchema(d, "client_max_tls_version"),
+			ClientMinTlsVersion:   getOptionalStringFromSchema(d, "client_min_tls_version"),
+			ServerCipherSuite:     serverCipherSuite,
+			ServerMaxTlsVersion:   getOptionalStringFromSchema(d, "server_max_tls_version"),
+			ServerMinTlsVersion:   getOptionalStringFromSchema(d, "server_min_tls_version"),
+			CryptoEnforcement:     getOptionalStringFromSchema(d, "crypto_enforcement"),
+			DecryptionFailAction:  getOptionalStringFromSchema(d, "decryption_fail_action"),
+			TlsConfigSetting:      getOptionalStringFromSchema(d, "tls_config_setting"),
+			OcspMustStaple:        &ocspMustStaple,
+			IdleConnectionTimeout: idleConnectionTimeout,
+			Crls:                  crls,
+			TrustedCaBundles:      trustedCaBundles,
+			DefaultCertKey:        getOptionalStringFromSchema(d, "default_cert_key"),
+			ServerCertsKey:        getStringListFromSchemaSet(d, "server_certs_key"),
+		}
+		if value, ok := d.GetOkExists("certificate_validation"); ok {
+			certificateValidation := value.(bool)
+			obj.CertificateValidation = &certificateValidation
+		}
+		dataValue, errs = converter.ConvertToVapi(obj, model.TlsInspectionInternalProfileBindingType())
+	}
+	if errs != nil {
+		return errs[0]
+	}
+
+	log.Printf("[INFO] Patching TLS Decryption Profile with ID %s", id)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	_, err := client.Patch(id, dataValue.(*data.StructValue))
+	return err
+}
+
+func resourceNsxtPolicyTlsDecryptionProfileCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTlsDecryptionProfileExists)
+	if err != nil {
+		return err
+	}
+
+	err = resourceNsxtPolicyTlsDecryptionProfilePatch(d, m, id)
+	if err != nil {
+		return handleCreateError("TLS Decryption Profile", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTlsDecryptionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTlsDecryptionProfileRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+	converter := bindings.NewTypeConverter()
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Decryption Profile ID")
+	}
+
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	dataValue, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Decryption Profile", id, err)
+	}
+
+	baseObj, errs := converter.ConvertToGolang(dataValue, model.TlsProfileBindingType())
+	if len(errs) > 0 {
+		return fmt.Errorf("Error converting TLS Decryption Profile %s", errs[0])
+	}
+	resourceType := baseObj.(model.TlsProfile).ResourceType
+
+	switch resourceType {
+	case model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONEXTERNALPROFILE:
+		rawObj, errs := converter.ConvertToGolang(dataValue, model.TlsInspectionExternalProfileBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting TLS Decryption Profile %s", errs[0])
+		}
+		obj := rawObj.(model.TlsInspectionExternalProfile)
+		d.Set("type", tlsDecryptionProfileTypeExternal)
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("client_cipher_suite", obj.ClientCipherSuite)
+		d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+		d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+		d.Set("server_cipher_suite", obj.ServerCipherSuite)
+		d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+		d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+		d.Set("crypto_enforcement", obj.CryptoEnforcement)
+		d.Set("decryption_fail_action", obj.DecryptionFailAction)
+		d.Set("tls_config_setting", obj.TlsConfigSetting)
+		d.Set("ocsp_must_staple", obj.OcspMustStaple)
+		d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+		d.Set("crls", obj.Crls)
+		d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+		d.Set("invalid_cert_action", obj.InvalidCertAction)
+		d.Set("proxy_trusted_ca_cert", obj.ProxyTrustedCaCert)
+		d.Set("proxy_untrusted_ca_cert", obj.ProxyUntrustedCaCert)
+	case model.TlsProfile_RESOURCE_TYPE_TLSINSPECTIONINTERNALPROFILE:
+		rawObj, errs := converter.ConvertToGolang(dataValue, model.TlsInspectionInternalProfileBindingType())
+		if len(errs) > 0 {
+			return fmt.Errorf("Error converting TLS Decryption Profile %s", errs[0])
+		}
+		obj := rawObj.(model.TlsInspectionInternalProfile)
+		d.Set("type", tlsDecryptionProfileTypeInternal)
+		d.Set("display_name", obj.DisplayName)
+		d.Set("description", obj.Description)
+		setPolicyTagsInSchema(d, obj.Tags)
+		d.Set("path", obj.Path)
+		d.Set("revision", obj.Revision)
+		d.Set("client_cipher_suite", obj.ClientCipherSuite)
+		d.Set("client_max_tls_version", obj.ClientMaxTlsVersion)
+		d.Set("client_min_tls_version", obj.ClientMinTlsVersion)
+		d.Set("server_cipher_suite", obj.ServerCipherSuite)
+		d.Set("server_max_tls_version", obj.ServerMaxTlsVersion)
+		d.Set("server_min_tls_version", obj.ServerMinTlsVersion)
+		d.Set("crypto_enforcement", obj.CryptoEnforcement)
+		d.Set("decryption_fail_action", obj.DecryptionFailAction)
+		d.Set("tls_config_setting", obj.TlsConfigSetting)
+		d.Set("ocsp_must_staple", obj.OcspMustStaple)
+		d.Set("idle_connection_timeout", obj.IdleConnectionTimeout)
+		d.Set("crls", obj.Crls)
+		d.Set("trusted_ca_bundles", obj.TrustedCaBundles)
+		d.Set("certificate_validation", obj.CertificateValidation)
+		d.Set("default_cert_key", obj.DefaultCertKey)
+		d.Set("server_certs_key", obj.ServerCertsKey)
+	default:
+		return fmt.Errorf("TLS profile %s is of unsupported type %s", id, resourceType)
+	}
+
+	d.Set("nsx_id", id)
+
+	return nil
+}
+
+func resourceNsxtPolicyTlsDecryptionProfileUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Decryption Profile ID")
+	}
+
+	err := resourceNsxtPolicyTlsDecryptionProfilePatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("TLS Decryption Profile", id, err)
+	}
+
+	return resourceNsxtPolicyTlsDecryptionProfileRead(d, m)
+}
+
+func resourceNsxtPolicyTlsDecryptionProfileDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Decryption Profile ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionActionProfilesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Decryption Profile", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var accTestPolicyTlsDecryptionProfileCreateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform created",
+	"decryption_fail_action": "BLOCK",
+	"invalid_cert_action":    "BLOCK",
+	"tls_config_setting":     "HIGH_SECURITY",
+}
+
+var accTestPolicyTlsDecryptionProfileUpdateAttributes = map[string]string{
+	"display_name":           getAccTestResourceName(),
+	"description":            "terraform updated",
+	"decryption_fail_action": "BYPASS",
+	"invalid_cert_action":    "ALLOW",
+	"tls_config_setting":     "BALANCED",
+}
+
+func TestAccResourceNsxtPolicyTlsDecryptionProfile_basic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_decryption_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTlsDecryptionProfileCheckDestroy(state, accTestPolicyTlsDecryptionProfileUpdateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTlsDecryptionProfileTemplate(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTlsDecryptionProfileExists(accTestPolicyTlsDecryptionProfileCreateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTlsDecryptionProfileCreateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTlsDecryptionProfileCreateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "type", "EXTERNAL"),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTlsDecryptionProfileCreateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "invalid_cert_action", accTestPolicyTlsDecryptionProfileCreateAttributes["invalid_cert_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTlsDecryptionProfileCreateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTlsDecryptionProfileTemplate(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTlsDecryptionProfileExists(accTestPolicyTlsDecryptionProfileUpdateAttributes["display_name"], testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", accTestPolicyTlsDecryptionProfileUpdateAttributes["display_name"]),
+					resource.TestCheckResourceAttr(testResourceName, "description", accTestPolicyTlsDecryptionProfileUpdateAttributes["description"]),
+					resource.TestCheckResourceAttr(testResourceName, "type", "EXTERNAL"),
+					resource.TestCheckResourceAttr(testResourceName, "decryption_fail_action", accTestPolicyTlsDecryptionProfileUpdateAttributes["decryption_fail_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "invalid_cert_action", accTestPolicyTlsDecryptionProfileUpdateAttributes["invalid_cert_action"]),
+					resource.TestCheckResourceAttr(testResourceName, "tls_config_setting", accTestPolicyTlsDecryptionProfileUpdateAttributes["tls_config_setting"]),
+					resource.TestCheckResourceAttrSet(testResourceName, "nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTlsDecryptionProfile_importBasic(t *testing.T) {
+	testResourceName := "nsxt_policy_tls_decryption_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTlsDecryptionProfileCheckDestroy(state, accTestPolicyTlsDecryptionProfileCreateAttributes["display_name"])
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTlsDecryptionProfileTemplate(true),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTlsDecryptionProfileExists(displayName string, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy TLS Decryption Profile resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy TLS Decryption Profile resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTlsDecryptionProfileExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TLS Decryption Profile %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTlsDecryptionProfileCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tls_decryption_profile" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTlsDecryptionProfileExists(resourceID, connector, false)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy TLS Decryption Profile %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTlsDecryptionProfileTemplate(createFlow bool) string {
+	var attrMap map[string]string
+	if createFlow {
+		attrMap = accTestPolicyTlsDecryptionProfileCreateAttributes
+	} else {
+		attrMap = accTestPolicyTlsDecryptionProfileUpdateAttributes
+	}
+	return fmt.Sprintf(`
+resource "nsxt_policy_tls_decryption_profile" "test" {
+  display_name           = "%s"
+  description            = "%s"
+  type                   = "EXTERNAL"
+  decryption_fail_action = "%s"
+  invalid_cert_action    = "%s"
+  tls_config_setting     = "%s"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, attrMap["display_name"], attrMap["description"], attrMap["decryption_fail_action"], attrMap["invalid_cert_action"], attrMap["tls_config_setting"])
+}
+
+func TestPolicyTlsDecryptionProfileCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+
+	r := resourceNsxtPolicyTlsDecryptionProfile()
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{
+		{
+			name:     "TLS decryption profile",
+			resource: r,
+			config: map[string]interface{}{
+				"nsx_id":                 "internal1",
+				"display_name":           "internal1",
+				"type":                   "INTERNAL",
+				"decryption_fail_action": "BLOCK",
+				"certificate_validation": true,
+				"default_cert_key":       "/infra/certificates/web",
+			},
+			path: "/infra/tls-inspection-action-profiles/internal1",
+			checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+				if obj["resource_type"] != "TlsInspectionInternalProfile" || obj["decryption_fail_action"] != "BLOCK" ||
+					obj["certificate_validation"] != true || obj["default_cert_key"] != "/infra/certificates/web" {
+					t.Errorf("unexpected TLS decryption profile on NSX: %v", obj)
+				}
+				if d.Get("type") != "INTERNAL" || d.Get("default_cert_key") != "/infra/certificates/web" {
+					t.Errorf("unexpected state: %v", d.State())
+				}
+			},
+		},
+		{
+			// Attributes of external profile are rejected for internal type
+			name:     "TLS decryption profile with invalid_cert_action",
+			resource: r,
+			config: map[string]interface{}{
+				"nsx_id":              "internal2",
+				"display_name":        "internal2",
+				"type":                "INTERNAL",
+				"invalid_cert_action": "ALLOW",
+			},
+			path:        "/infra/tls-inspection-action-profiles/internal2",
+			createFails: true,
+		},
+	})
+}
@@ -0,0 +1,387 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+)
+
+func resourceNsxtPolicyTlsInspectionPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withContext(resourceNsxtPolicyTlsInspectionPolicyCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTlsInspectionPolicyRead),
+		UpdateContext: withContext(resourceNsxtPolicyTlsInspectionPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTlsInspectionPolicyDelete),
+		Importer: &schema.ResourceImporter{
+			State: nsxtPolicyPathResourceImporter,
+		},
+		Schema: getPolicyTlsInspectionPolicySchema(),
+	}
+}
+
+func getPolicyTlsInspectionPolicySchema() map[string]*schema.Schema {
+	// TLS inspection rules share most of their attributes with gateway rules,
+	// with decryption profile taking place of the action
+	rulesSchema := getSecurityPolicyAndGatewayRulesSchema(true, false, true)
+	ruleSchema := rulesSchema.Elem.(*schema.Resource).Schema
+	delete(ruleSchema, "action")
+	ruleSchema["decryption_profile_path"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Policy path of TLS decryption profile to apply on matching traffic",
+		Required:     true,
+		ValidateFunc: validatePolicyPath(),
+	}
+
+	return map[string]*schema.Schema{
+		"nsx_id":       getNsxIDSchema(),
+		"path":         getPathSchema(),
+		"display_name": getDisplayNameSchema(),
+		"description":  getDescriptionSchema(),
+		"revision":     getRevisionSchema(),
+		"tag":          getTagsSchema(),
+		"comments": {
+			Type:        schema.TypeString,
+			Description: "Comments for TLS inspection policy lock/unlock",
+			Optional:    true,
+		},
+		"locked": {
+			Type:        schema.TypeBool,
+			Description: "Indicates whether TLS inspection policy should be locked. If locked by a user, no other user would be able to modify this policy",
+			Optional:    true,
+			Default:     false,
+		},
+		"sequence_number": {
+			Type:        schema.TypeInt,
+			Description: "This field is used to resolve conflicts between TLS inspection policies",
+			Optional:    true,
+			Default:     0,
+		},
+		"rule": rulesSchema,
+	}
+}
+
+func resourceNsxtPolicyTlsInspectionPolicyExists(id string, connector client.Connector, isGlobalManager bool) (bool, error) {
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	_, err := client.Get(id)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFoundError(err) {
+		return false, nil
+	}
+
+	return false, logAPIError("Error retrieving TLS Inspection Policy", err)
+}
+
+func setPolicyTlsRulesInSchema(d *schema.ResourceData, rules []model.TlsRule) error {
+	var rulesList []map[string]interface{}
+	for _, rule := range rules {
+		elem := make(map[string]interface{})
+		elem["display_name"] = rule.DisplayName
+		elem["description"] = rule.Description
+		elem["path"] = rule.Path
+		elem["notes"] = rule.Notes
+		elem["logged"] = rule.Logged
+		elem["log_label"] = rule.Tag
+		elem["decryption_profile_path"] = rule.TlsProfile
+		elem["destinations_excluded"] = rule.DestinationsExcluded
+		elem["sources_excluded"] = rule.SourcesExcluded
+		elem["ip_version"] = rule.IpProtocol
+		elem["direction"] = rule.Direction
+		elem["disabled"] = rule.Disabled
+		elem["revision"] = rule.Revision
+		setPathListInMap(elem, "source_groups", rule.SourceGroups)
+		setPathListInMap(elem, "destination_groups", rule.DestinationGroups)
+		setPathListInMap(elem, "profiles", rule.Profiles)
+		setPathListInMap(elem, "services", rule.Services)
+		setPathListInMap(elem, "scope", rule.Scope)
+		elem["sequence_number"] = rule.SequenceNumber
+		elem["nsx_id"] = rule.Id
+		elem["rule_id"] = rule.RuleId
+
+		var tagList []map[string]string
+		for _, tag := range rule.Tags {
+			tags := make(map[string]string)
+			tags["scope"] = *tag.Scope
+			tags["tag"] = *tag.Tag
+			tagList = append(tagList, tags)
+		}
+		elem["tag"] = tagList
+
+		rulesList = append(rulesList, elem)
+	}
+
+	return d.Set("rule", rulesList)
+}
+
+func getPolicyTlsRulesFromSchema(d *schema.ResourceData) []model.TlsRule {
+	rules := d.Get("rule").([]interface{})
+	var ruleList []model.TlsRule
+	lastSequence := int64(0)
+	for _, rule := range rules {
+		data := rule.(map[string]interface{})
+		displayName := data["display_name"].(string)
+		description := data["description"].(string)
+		tlsProfile := data["decryption_profile_path"].(string)
+		logged := data["logged"].(bool)
+		tag := data["log_label"].(string)
+		disabled := data["disabled"].(bool)
+		sourcesExcluded := data["sources_excluded"].(bool)
+		destinationsExcluded := data["destinations_excluded"].(bool)
+		ipProtocol := data["ip_version"].(string)
+		direction := data["direction"].(string)
+		notes := data["notes"].(string)
+		sequenceNumber := int64(data["sequence_number"].(int))
+		tagStructs := getPolicyTagsFromSet(data["tag"].(*schema.Set))
+
+		id := newUUID()
+		nsxID := data["nsx_id"].(string)
+		if nsxID != "" {
+			id = nsxID
+		}
+
+		if sequenceNumber <= lastSequence {
+			// Sequence number is assigned by the provider when not specified
+			// or out of order
+			sequenceNumber = lastSequence + 1
+		}
+		lastSequence = sequenceNumber
+
+		resourceType := "TlsRule"
+		elem := model.TlsRule{
+			ResourceType:         &resourceType,
+			Id:                   &id,
+			DisplayName:          &displayName,
+			Notes:                &notes,
+			Description:          &description,
+			TlsProfile:           &tlsProfile,
+			Logged:               &logged,
+			Tag:                  &tag,
+			Tags:                 tagStructs,
+			Disabled:             &disabled,
+			SourcesExcluded:      &sourcesExcluded,
+			DestinationsExcluded: &destinationsExcluded,
+			IpProtocol:           &ipProtocol,
+			Direction:            &direction,
+			SourceGroups:         getPathListFromMap(data, "source_groups"),
+			DestinationGroups:    getPathListFromMap(data, "destination_groups"),
+			Services:             getPathListFromMap(data, "services"),
+			Scope:                getPathListFromMap(data, "scope"),
+			Profiles:             getPathListFromMap(data, "profiles"),
+			SequenceNumber:       &sequenceNumber,
+		}
+
+		ruleList = append(ruleList, elem)
+	}
+
+	return ruleList
+}
+
+func createPolicyChildTlsRule(ruleID string, rule model.TlsRule, shouldDelete bool) (*data.StructValue, error) {
+	converter := bindings.NewTypeConverter()
+
+	childRule := model.ChildTlsRule{
+		ResourceType:    "ChildTlsRule",
+		Id:              &ruleID,
+		TlsRule:         &rule,
+		MarkedForDelete: &shouldDelete,
+	}
+
+	dataValue, errors := converter.ConvertToVapi(childRule, model.ChildTlsRuleBindingType())
+	if len(errors) > 0 {
+		return nil, errors[0]
+	}
+
+	return dataValue.(*data.StructValue), nil
+}
+
+func getUpdatedTlsRuleChildren(d *schema.ResourceData) ([]*data.StructValue, error) {
+	var policyChildren []*data.StructValue
+
+	if !d.HasChange("rule") {
+		return nil, nil
+	}
+
+	oldRules, _ := d.GetChange("rule")
+	rules := getPolicyTlsRulesFromSchema(d)
+
+	existingRules := make(map[string]bool)
+	for _, rule := range rules {
+		ruleID := *rule.Id
+		existingRules[ruleID] = true
+
+		childRule, err := createPolicyChildTlsRule(ruleID, rule, false)
+		if err != nil {
+			return policyChildren, err
+		}
+		log.Printf("[DEBUG]: Adding child rule with id %s", ruleID)
+		policyChildren = append(policyChildren, childRule)
+	}
+
+	// Rules that are not present in config anymore need to be deleted
+	resourceType := "TlsRule"
+	for _, oldRule := range oldRules.([]interface{}) {
+		oldRuleMap := oldRule.(map[string]interface{})
+		oldRuleID := oldRuleMap["nsx_id"].(string)
+		if _, exists := existingRules[oldRuleID]; exists {
+			continue
+		}
+		rule := model.TlsRule{
+			Id:           &oldRuleID,
+			ResourceType: &resourceType,
+		}
+
+		childRule, err := createPolicyChildTlsRule(oldRuleID, rule, true)
+		if err != nil {
+			return policyChildren, err
+		}
+		log.Printf("[DEBUG]: Deleting child rule with id %s", oldRuleID)
+		policyChildren = append(policyChildren, childRule)
+	}
+
+	return policyChildren, nil
+}
+
+func policyTlsInspectionPolicyBuildAndPatch(d *schema.ResourceData, m interface{}, id string) error {
+	displayName := d.Get("display_name").(string)
+	description := d.Get("description").(string)
+	tags := getPolicyTagsFromSchema(d)
+	comments := d.Get("comments").(string)
+	locked := d.Get("locked").(bool)
+	sequenceNumber := int64(d.Get("sequence_number").(int))
+	resourceType := "TlsPolicy"
+
+	obj := model.TlsPolicy{
+		Id:             &id,
+		DisplayName:    &displayName,
+		Description:    &description,
+		Tags:           tags,
+		Comments:       &comments,
+		Locked:         &locked,
+		SequenceNumber: &sequenceNumber,
+		ResourceType:   &resourceType,
+	}
+
+	policyChildren, err := getUpdatedTlsRuleChildren(d)
+	if err != nil {
+		return err
+	}
+	if len(policyChildren) > 0 {
+		obj.Children = policyChildren
+	}
+
+	converter := bindings.NewTypeConverter()
+	childPolicy := model.ChildTlsPolicy{
+		Id:           &id,
+		ResourceType: "ChildTlsPolicy",
+		TlsPolicy:    &obj,
+	}
+	dataValue, errors := converter.ConvertToVapi(childPolicy, model.ChildTlsPolicyBindingType())
+	if len(errors) > 0 {
+		return fmt.Errorf("Failed to create H-API for TLS Inspection Policy: %s", errors[0])
+	}
+
+	infraType := "Infra"
+	infraObj := model.Infra{
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+		ResourceType: &infraType,
+	}
+
+	log.Printf("[DEBUG]: Updating TLS Inspection Policy %s with %d child rules", id, len(policyChildren))
+	return policyInfraPatch(getSessionContext(d, m), infraObj, getPolicyConnector(m), false)
+}
+
+func resourceNsxtPolicyTlsInspectionPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	// Initialize resource Id and verify this ID is not yet used
+	id, err := getOrGenerateID(d, m, resourceNsxtPolicyTlsInspectionPolicyExists)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Creating TLS Inspection Policy with ID %s", id)
+	err = policyTlsInspectionPolicyBuildAndPatch(d, m, id)
+	if err != nil {
+		return handleCreateError("TLS Inspection Policy", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("nsx_id", id)
+
+	return resourceNsxtPolicyTlsInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTlsInspectionPolicyRead(d *schema.ResourceData, m interface{}) error {
+	if isPolicyGlobalManager(m) {
+		return localManagerOnlyError()
+	}
+
+	connector := getPolicyConnector(m)
+
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	obj, err := client.Get(id)
+	if err != nil {
+		return handleReadError(d, "TLS Inspection Policy", id, err)
+	}
+
+	d.Set("display_name", obj.DisplayName)
+	d.Set("description", obj.Description)
+	setPolicyTagsInSchema(d, obj.Tags)
+	d.Set("nsx_id", id)
+	d.Set("path", obj.Path)
+	d.Set("revision", obj.Revision)
+	d.Set("comments", obj.Comments)
+	d.Set("locked", obj.Locked)
+	d.Set("sequence_number", obj.SequenceNumber)
+
+	return setPolicyTlsRulesInSchema(d, obj.Rules)
+}
+
+func resourceNsxtPolicyTlsInspectionPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	log.Printf("[INFO] Updating TLS Inspection Policy with ID %s", id)
+	err := policyTlsInspectionPolicyBuildAndPatch(d, m, id)
+	if err != nil {
+		return handleUpdateError("TLS Inspection Policy", id, err)
+	}
+
+	return resourceNsxtPolicyTlsInspectionPolicyRead(d, m)
+}
+
+func resourceNsxtPolicyTlsInspectionPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	id := d.Id()
+	if id == "" {
+		return fmt.Errorf("Error obtaining TLS Inspection Policy ID")
+	}
+
+	connector := getPolicyConnector(m)
+	client := infra.NewTlsInspectionPoliciesClient(connector)
+	err := client.Delete(id)
+	if err != nil {
+		return handleDeleteError("TLS Inspection Policy", id, err)
+	}
+
+	return nil
+}
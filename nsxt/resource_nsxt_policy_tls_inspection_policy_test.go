@@ -0,0 +1,230 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceNsxtPolicyTlsInspectionPolicy_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTlsInspectionPolicyCheckDestroy(state, updatedName)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTlsInspectionPolicyTemplate(name, "IN_OUT", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTlsInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "sequence_number", "3"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.display_name", "rule0"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.direction", "IN_OUT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.scope.#", "1"),
+					resource.TestCheckResourceAttrPair(testResourceName, "rule.0.decryption_profile_path", "nsxt_policy_tls_decryption_profile.test", "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "rule.0.nsx_id"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTlsInspectionPolicyTemplate(updatedName, "OUT", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTlsInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.0.direction", "OUT"),
+					resource.TestCheckResourceAttr(testResourceName, "rule.1.display_name", "rule1"),
+				),
+			},
+			{
+				Config: testAccNsxtPolicyTlsInspectionPolicyTemplate(updatedName, "OUT", 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyTlsInspectionPolicyExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceNsxtPolicyTlsInspectionPolicy_importBasic(t *testing.T) {
+	name := getAccTestResourceName()
+	testResourceName := "nsxt_policy_tls_inspection_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyLocalManager(t)
+			testAccNSXVersion(t, "4.0.0")
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyTlsInspectionPolicyCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtPolicyTlsInspectionPolicyTemplate(name, "IN_OUT", 1),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNsxtPolicyTlsInspectionPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Policy TLS Inspection Policy resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("Policy TLS Inspection Policy resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtPolicyTlsInspectionPolicyExists(resourceID, connector, false)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Policy TLS Inspection Policy %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtPolicyTlsInspectionPolicyCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_policy_tls_inspection_policy" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtPolicyTlsInspectionPolicyExists(resourceID, connector, false)
+		if err == nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("Policy TLS Inspection Policy %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtPolicyTlsInspectionPolicyTemplate(name string, direction string, ruleCount int) string {
+	rules := ""
+	for i := 0; i < ruleCount; i++ {
+		rules += fmt.Sprintf(`
+  rule {
+    display_name            = "rule%d"
+    direction               = "%s"
+    scope                   = [nsxt_policy_tier1_gateway.test.path]
+    decryption_profile_path = nsxt_policy_tls_decryption_profile.test.path
+  }
+`, i, direction)
+	}
+
+	return testAccNsxtPolicyGatewayFabricDeps(true) + fmt.Sprintf(`
+resource "nsxt_policy_tier1_gateway" "test" {
+  display_name = "%s"
+}
+
+resource "nsxt_policy_tls_decryption_profile" "test" {
+  display_name = "%s"
+  type         = "EXTERNAL"
+}
+
+resource "nsxt_policy_tls_inspection_policy" "test" {
+  display_name    = "%s"
+  description     = "Acceptance Test"
+  sequence_number = 3
+%s
+}`, name, name, name, rules)
+}
+
+func TestPolicyTlsInspectionPolicyCrud(t *testing.T) {
+	srv, m := newTestSimulator(t)
+	srv.Seed("/infra/tls-inspection-action-profiles/decrypt", map[string]interface{}{
+		"resource_type":          "TlsInspectionExternalProfile",
+		"id":                     "decrypt",
+		"display_name":           "decrypt",
+		"decryption_fail_action": "BLOCK",
+	})
+
+	rule := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"display_name":            name,
+			"scope":                   []interface{}{"/infra/tier-1s/t1"},
+			"decryption_profile_path": "/infra/tls-inspection-action-profiles/decrypt",
+		}
+	}
+
+	var removedID, keptID string
+	testSimulatorCrud(t, srv, m, []testSimulatorResource{{
+		name:     "TLS inspection policy",
+		resource: resourceNsxtPolicyTlsInspectionPolicy(),
+		config: map[string]interface{}{
+			"nsx_id":       "perimeter",
+			"display_name": "perimeter",
+			"rule":         []interface{}{rule("rule1"), rule("rule2")},
+		},
+		path: "/infra/tls-inspection-policies/perimeter",
+		checkCreate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+			first, ok := srv.Get("/infra/tls-inspection-policies/perimeter/rules/" + d.Get("rule.0.nsx_id").(string))
+			if !ok {
+				t.Fatal("TLS inspection rule was not created on NSX")
+			}
+			if first["tls_profile"] != "/infra/tls-inspection-action-profiles/decrypt" || first["resource_type"] != "TlsRule" {
+				t.Errorf("unexpected TLS inspection rule on NSX: %v", first)
+			}
+			if d.Get("rule.#") != 2 || d.Get("rule.1.sequence_number") != 2 ||
+				d.Get("rule.0.decryption_profile_path") != "/infra/tls-inspection-action-profiles/decrypt" {
+				t.Errorf("unexpected state: %v", d.State())
+			}
+			removedID = d.Get("rule.1.nsx_id").(string)
+			keptID = d.Get("rule.0.nsx_id").(string)
+		},
+		// Removing a rule from configuration deletes it on NSX
+		update: map[string]interface{}{"rule": []interface{}{rule("rule1")}},
+		checkUpdate: func(t *testing.T, obj map[string]interface{}, d *schema.ResourceData) {
+			if _, ok := srv.Get("/infra/tls-inspection-policies/perimeter/rules/" + removedID); ok {
+				t.Errorf("TLS inspection rule %s was not deleted on NSX", removedID)
+			}
+			if _, ok := srv.Get("/infra/tls-inspection-policies/perimeter/rules/" + keptID); !ok {
+				t.Errorf("TLS inspection rule %s should not be deleted on NSX", keptID)
+			}
+			if d.Get("rule.#") != 1 || d.Get("rule.0.nsx_id") != keptID {
+				t.Errorf("unexpected state after update: %v", d.State())
+			}
+		},
+	}})
+}
@@ -20,10 +20,10 @@ var defaultInfraSitePath = "/infra/sites/default"
 
 func resourceNsxtPolicyTransportZone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyTransportZoneCreate,
-		Read:   resourceNsxtPolicyTransportZoneRead,
-		Update: resourceNsxtPolicyTransportZoneUpdate,
-		Delete: resourceNsxtPolicyTransportZoneDelete,
+		CreateContext: withContext(resourceNsxtPolicyTransportZoneCreate),
+		ReadContext:   withContext(resourceNsxtPolicyTransportZoneRead),
+		UpdateContext: withContext(resourceNsxtPolicyTransportZoneUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyTransportZoneDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtPolicyTransportZoneImporter,
 		},
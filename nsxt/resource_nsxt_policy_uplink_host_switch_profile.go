@@ -51,10 +51,10 @@ var overlayEncapModes = []string{
 
 func resourceNsxtUplinkHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUplinkHostSwitchProfileCreate,
-		Read:   resourceNsxtUplinkHostSwitchProfileRead,
-		Update: resourceNsxtUplinkHostSwitchProfileUpdate,
-		Delete: resourceNsxtUplinkHostSwitchProfileDelete,
+		CreateContext: withContext(resourceNsxtUplinkHostSwitchProfileCreate),
+		ReadContext:   withContext(resourceNsxtUplinkHostSwitchProfileRead),
+		UpdateContext: withContext(resourceNsxtUplinkHostSwitchProfileUpdate),
+		DeleteContext: withContext(resourceNsxtUplinkHostSwitchProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -4,6 +4,8 @@
 package nsxt
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -13,13 +15,16 @@ func resourceNsxtPolicyVlanSegment() *schema.Resource {
 	delete(segSchema, "connectivity_path")
 
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVlanSegmentCreate,
-		Read:   resourceNsxtPolicyVlanSegmentRead,
-		Update: resourceNsxtPolicyVlanSegmentUpdate,
-		Delete: resourceNsxtPolicyVlanSegmentDelete,
+		CreateContext: withContext(resourceNsxtPolicyVlanSegmentCreate),
+		ReadContext:   withContext(resourceNsxtPolicyVlanSegmentRead),
+		UpdateContext: withContext(resourceNsxtPolicyVlanSegmentUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyVlanSegmentDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: segSchema,
 	}
@@ -31,10 +31,10 @@ var (
 
 func resourceNsxtPolicyVMTags() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVMTagsCreate,
-		Read:   resourceNsxtPolicyVMTagsRead,
-		Update: resourceNsxtPolicyVMTagsUpdate,
-		Delete: resourceNsxtPolicyVMTagsDelete,
+		CreateContext: withContext(resourceNsxtPolicyVMTagsCreate),
+		ReadContext:   withContext(resourceNsxtPolicyVMTagsRead),
+		UpdateContext: withContext(resourceNsxtPolicyVMTagsUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyVMTagsDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
@@ -16,10 +16,10 @@ import (
 
 func resourceNsxtPolicyVniPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPolicyVniPoolCreate,
-		Read:   resourceNsxtPolicyVniPoolRead,
-		Update: resourceNsxtPolicyVniPoolUpdate,
-		Delete: resourceNsxtPolicyVniPoolDelete,
+		CreateContext: withContext(resourceNsxtPolicyVniPoolCreate),
+		ReadContext:   withContext(resourceNsxtPolicyVniPoolRead),
+		UpdateContext: withContext(resourceNsxtPolicyVniPoolUpdate),
+		DeleteContext: withContext(resourceNsxtPolicyVniPoolDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
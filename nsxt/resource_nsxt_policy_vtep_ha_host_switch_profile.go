@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtVtepHAHostSwitchProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVtepHAHostSwitchProfileCreate,
-		Read:   resourceNsxtVtepHAHostSwitchProfileRead,
-		Update: resourceNsxtVtepHAHostSwitchProfileUpdate,
-		Delete: resourceNsxtVtepHAHostSwitchProfileDelete,
+		CreateContext: withContext(resourceNsxtVtepHAHostSwitchProfileCreate),
+		ReadContext:   withContext(resourceNsxtVtepHAHostSwitchProfileRead),
+		UpdateContext: withContext(resourceNsxtVtepHAHostSwitchProfileUpdate),
+		DeleteContext: withContext(resourceNsxtVtepHAHostSwitchProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
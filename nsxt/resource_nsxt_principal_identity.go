@@ -16,9 +16,9 @@ import (
 
 func resourceNsxtPrincipalIdentity() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtPrincipalIdentityCreate,
-		Read:   resourceNsxtPrincipalIdentityRead,
-		Delete: resourceNsxtPrincipalIdentityDelete,
+		CreateContext: withContext(resourceNsxtPrincipalIdentityCreate),
+		ReadContext:   withContext(resourceNsxtPrincipalIdentityRead),
+		DeleteContext: withContext(resourceNsxtPrincipalIdentityDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
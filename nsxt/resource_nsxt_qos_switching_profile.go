@@ -23,10 +23,10 @@ var rateShaperResourceTypes = []string{"IngressRateShaper", "IngressBroadcastRat
 
 func resourceNsxtQosSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtQosSwitchingProfileCreate,
-		Read:   resourceNsxtQosSwitchingProfileRead,
-		Update: resourceNsxtQosSwitchingProfileUpdate,
-		Delete: resourceNsxtQosSwitchingProfileDelete,
+		CreateContext: withContext(resourceNsxtQosSwitchingProfileCreate),
+		ReadContext:   withContext(resourceNsxtQosSwitchingProfileRead),
+		UpdateContext: withContext(resourceNsxtQosSwitchingProfileUpdate),
+		DeleteContext: withContext(resourceNsxtQosSwitchingProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
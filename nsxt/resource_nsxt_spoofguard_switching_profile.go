@@ -14,10 +14,10 @@ import (
 
 func resourceNsxtSpoofGuardSwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtSpoofGuardSwitchingProfileCreate,
-		Read:   resourceNsxtSpoofGuardSwitchingProfileRead,
-		Update: resourceNsxtSpoofGuardSwitchingProfileUpdate,
-		Delete: resourceNsxtSpoofGuardSwitchingProfileDelete,
+		CreateContext: withContext(resourceNsxtSpoofGuardSwitchingProfileCreate),
+		ReadContext:   withContext(resourceNsxtSpoofGuardSwitchingProfileRead),
+		UpdateContext: withContext(resourceNsxtSpoofGuardSwitchingProfileUpdate),
+		DeleteContext: withContext(resourceNsxtSpoofGuardSwitchingProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtStaticRoute() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtStaticRouteCreate,
-		Read:   resourceNsxtStaticRouteRead,
-		Update: resourceNsxtStaticRouteUpdate,
-		Delete: resourceNsxtStaticRouteDelete,
+		CreateContext: withContext(resourceNsxtStaticRouteCreate),
+		ReadContext:   withContext(resourceNsxtStaticRouteRead),
+		UpdateContext: withContext(resourceNsxtStaticRouteUpdate),
+		DeleteContext: withContext(resourceNsxtStaticRouteDelete),
 		Importer: &schema.ResourceImporter{
 			State: resourceNsxtStaticRouteImport,
 		},
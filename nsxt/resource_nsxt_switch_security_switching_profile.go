@@ -28,10 +28,10 @@ var switchSecurityProfileBpduMacs = []string{
 
 func resourceNsxtSwitchSecuritySwitchingProfile() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtSwitchSecuritySwitchingProfileCreate,
-		Read:   resourceNsxtSwitchSecuritySwitchingProfileRead,
-		Update: resourceNsxtSwitchSecuritySwitchingProfileUpdate,
-		Delete: resourceNsxtSwitchSecuritySwitchingProfileDelete,
+		CreateContext: withContext(resourceNsxtSwitchSecuritySwitchingProfileCreate),
+		ReadContext:   withContext(resourceNsxtSwitchSecuritySwitchingProfileRead),
+		UpdateContext: withContext(resourceNsxtSwitchSecuritySwitchingProfileUpdate),
+		DeleteContext: withContext(resourceNsxtSwitchSecuritySwitchingProfileDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -13,10 +13,10 @@ import (
 
 func resourceNsxtUpgradePrecheckAcknowledge() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUpgradePrecheckAcknowledgeCreate,
-		Read:   resourceNsxtUpgradePrecheckAcknowledgeRead,
-		Update: resourceNsxtUpgradePrecheckAcknowledgeUpdate,
-		Delete: resourceNsxtUpgradePrecheckAcknowledgeDelete,
+		CreateContext: withContext(resourceNsxtUpgradePrecheckAcknowledgeCreate),
+		ReadContext:   withContext(resourceNsxtUpgradePrecheckAcknowledgeRead),
+		UpdateContext: withContext(resourceNsxtUpgradePrecheckAcknowledgeUpdate),
+		DeleteContext: withContext(resourceNsxtUpgradePrecheckAcknowledgeDelete),
 
 		Schema: map[string]*schema.Schema{
 			"precheck_ids": {
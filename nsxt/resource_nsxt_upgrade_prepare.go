@@ -27,13 +27,18 @@ var precheckComponentTypes = []string{"EDGE", "HOST", "MP"}
 const bundleUploadTimeout int = 3600
 const ucUpgradeTimeout int = 3600
 const precheckTimeout int = 3600
+const defaultUpgradePrepareTimeout = 1 * time.Hour
 
 func resourceNsxtUpgradePrepare() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUpgradePrepareCreate,
-		Read:   resourceNsxtUpgradePrepareRead,
-		Update: resourceNsxtUpgradePrepareUpdate,
-		Delete: resourceNsxtUpgradePrepareDelete,
+		CreateContext: withContext(resourceNsxtUpgradePrepareCreate),
+		ReadContext:   withContext(resourceNsxtUpgradePrepareRead),
+		UpdateContext: withContext(resourceNsxtUpgradePrepareUpdate),
+		DeleteContext: withContext(resourceNsxtUpgradePrepareDelete),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultUpgradePrepareTimeout),
+			Update: schema.DefaultTimeout(defaultUpgradePrepareTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"version": {
@@ -263,7 +268,7 @@ func uploadUpgradeBundle(d *schema.ResourceData, m interface{}, bundleType strin
 	upgradeBundleURL := d.Get("upgrade_bundle_url").(string)
 	precheckBundleURL := d.Get("precheck_bundle_url").(string)
 	var url string
-	timeout := d.Get("bundle_upload_timeout").(int)
+	timeout := getWaitTimeout(d, getCreateOrUpdateTimeoutKey(d), "bundle_upload_timeout", bundleUploadTimeout)
 	c := m.(nsxtClients)
 	userName := c.NsxtClientConfig.UserName
 	password := c.NsxtClientConfig.Password
@@ -343,7 +348,7 @@ func upgradeUc(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	timeout := d.Get("uc_upgrade_timeout").(int)
+	timeout := getWaitTimeout(d, getCreateOrUpdateTimeoutKey(d), "uc_upgrade_timeout", ucUpgradeTimeout)
 	return waitForUcUpgrade(m, timeout)
 }
 
@@ -354,7 +359,7 @@ func executePreupgradeChecks(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	timeout := d.Get("precheck_timeout").(int)
+	timeout := getWaitTimeout(d, getCreateOrUpdateTimeoutKey(d), "precheck_timeout", precheckTimeout)
 	for _, componentType := range precheckComponentTypes {
 		log.Printf("Execute pre-upgrade check on %s", componentType)
 		err = waitForPrecheckComplete(m, componentType, timeout)
@@ -391,7 +396,7 @@ func setFailedPrechecksInSchema(d *schema.ResourceData, precheckErrors []nsxMode
 	return d.Set("failed_prechecks", failedPrechecksList)
 }
 
-func waitForBundleUpload(m interface{}, bundleID string, timeout int) error {
+func waitForBundleUpload(m interface{}, bundleID string, timeout time.Duration) error {
 	connector := getPolicyConnector(m)
 	client := bundles.NewUploadStatusClient(connector)
 	pendingStates := []string{
@@ -416,18 +421,18 @@ func waitForBundleUpload(m interface{}, bundleID string, timeout int) error {
 
 			return state, *state.Status, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to upload bundle %s: %s", bundleID, err)
 	}
 	return nil
 }
 
-func waitForUcUpgrade(m interface{}, timeout int) error {
+func waitForUcUpgrade(m interface{}, timeout time.Duration) error {
 	connector := getPolicyConnector(m)
 	client := upgrade.NewUcUpgradeStatusClient(connector)
 	pendingStates := []string{
@@ -456,18 +461,18 @@ func waitForUcUpgrade(m interface{}, timeout int) error {
 			}
 			return state, nsxModel.UcUpgradeStatus_STATE_IN_PROGRESS, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Failed to upgrade UC: %s", err)
 	}
 	return nil
 }
 
-func waitForPrecheckComplete(m interface{}, componentType string, timeout int) error {
+func waitForPrecheckComplete(m interface{}, componentType string, timeout time.Duration) error {
 	connector := getPolicyConnector(m)
 	client := upgrade.NewStatusSummaryClient(connector)
 	pendingStates := []string{
@@ -493,11 +498,11 @@ func waitForPrecheckComplete(m interface{}, componentType string, timeout int) e
 			}
 			return state, *componentStatus[0].PreUpgradeStatus.Status, nil
 		},
-		Timeout:    time.Duration(timeout) * time.Second,
+		Timeout:    timeout,
 		MinTimeout: 1 * time.Second,
 		Delay:      1 * time.Second,
 	}
-	_, err := stateConf.WaitForState()
+	_, err := stateConf.WaitForStateContext(getProviderContext(m))
 	if err != nil {
 		return fmt.Errorf("Encounter error while running precheck on component type %s: %s", componentType, err)
 	}
@@ -4,6 +4,7 @@
 package nsxt
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -49,6 +50,8 @@ var (
 	defaultUpgradeStatusCheckInterval = 30
 	defaultUpgradeStatusCheckTimeout  = 3600
 	defaultUpgradeStatusCheckDelay    = 30
+	// Default timeout for each upgrade status wait
+	defaultUpgradeRunTimeout = time.Duration(defaultUpgradeStatusCheckTimeout) * time.Second
 )
 
 var staticComponentUpgradeStatus = []string{
@@ -70,12 +73,14 @@ type upgradeClientSet struct {
 	UpgradeClient     nsx.UpgradeClient
 	GroupStatusClient upgrade.UpgradeUnitGroupsStatusClient
 
-	Timeout  int
+	Timeout  time.Duration
 	Delay    int
 	Interval int
+
+	Context context.Context
 }
 
-func newUpgradeClientSet(connector client.Connector, d *schema.ResourceData) *upgradeClientSet {
+func newUpgradeClientSet(connector client.Connector, d *schema.ResourceData, m interface{}) *upgradeClientSet {
 	return &upgradeClientSet{
 		GroupClient:       upgrade.NewUpgradeUnitGroupsClient(connector),
 		SettingClient:     plan.NewSettingsClient(connector),
@@ -84,18 +89,24 @@ func newUpgradeClientSet(connector client.Connector, d *schema.ResourceData) *up
 		UpgradeClient:     nsx.NewUpgradeClient(connector),
 		GroupStatusClient: upgrade.NewUpgradeUnitGroupsStatusClient(connector),
 
-		Timeout:  d.Get("timeout").(int),
+		Timeout:  getWaitTimeout(d, getCreateOrUpdateTimeoutKey(d), "timeout", defaultUpgradeStatusCheckTimeout),
 		Delay:    d.Get("delay").(int),
 		Interval: d.Get("interval").(int),
+
+		Context: getProviderContext(m),
 	}
 }
 
 func resourceNsxtUpgradeRun() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtUpgradeRunCreate,
-		Read:   resourceNsxtUpgradeRunRead,
-		Update: resourceNsxtUpgradeRunUpdate,
-		Delete: resourceNsxtUpgradeRunDelete,
+		CreateContext: withContext(resourceNsxtUpgradeRunCreate),
+		ReadContext:   withContext(resourceNsxtUpgradeRunRead),
+		UpdateContext: withContext(resourceNsxtUpgradeRunUpdate),
+		DeleteContext: withContext(resourceNsxtUpgradeRunDelete),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultUpgradeRunTimeout),
+			Update: schema.DefaultTimeout(defaultUpgradeRunTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"upgrade_prepare_ready_id": {
@@ -113,6 +124,7 @@ func resourceNsxtUpgradeRun() *schema.Resource {
 				Description:  "Upgrade status check timeout in seconds",
 				Optional:     true,
 				Default:      defaultUpgradeStatusCheckTimeout,
+				Deprecated:   "Use timeouts block instead",
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"interval": {
@@ -358,7 +370,7 @@ func upgradeRunCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
 		id = newUUID()
 	}
 	connector := getPolicyConnectorWithHeaders(m, nil, false, false)
-	upgradeClientSet := newUpgradeClientSet(connector, d)
+	upgradeClientSet := newUpgradeClientSet(connector, d, m)
 
 	log.Printf("[INFO] Updating UpgradeUnitGroup and UpgradePlanSetting.")
 	err := prepareUpgrade(upgradeClientSet, d, m)
@@ -495,11 +507,11 @@ func waitUpgradeForStatus(upgradeClientSet *upgradeClientSet, component *string,
 			log.Printf("[DEBUG] Current upgrade status: %s", status.Status)
 			return status, status.Status, nil
 		},
-		Timeout:      time.Duration(upgradeClientSet.Timeout) * time.Second,
+		Timeout:      upgradeClientSet.Timeout,
 		PollInterval: time.Duration(upgradeClientSet.Interval) * time.Second,
 		Delay:        time.Duration(upgradeClientSet.Delay) * time.Second,
 	}
-	statusI, err := stateConf.WaitForState()
+	statusI, err := stateConf.WaitForStateContext(upgradeClientSet.Context)
 	if err != nil {
 		statusDetail := ""
 		if statusI != nil {
@@ -838,7 +850,7 @@ func setUpgradeRunOutput(upgradeClientSet *upgradeClientSet, d *schema.ResourceD
 func resourceNsxtUpgradeRunRead(d *schema.ResourceData, m interface{}) error {
 	id := d.Id()
 	connector := getPolicyConnector(m)
-	upgradeClientSet := newUpgradeClientSet(connector, d)
+	upgradeClientSet := newUpgradeClientSet(connector, d, m)
 	err := setUpgradeRunOutput(upgradeClientSet, d)
 	if err != nil {
 		return handleReadError(d, "NsxtUpgradeRun", id, err)
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vmware-nsxt/manager"
@@ -14,13 +15,17 @@ import (
 
 func resourceNsxtVlanLogicalSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVlanLogicalSwitchCreate,
-		Read:   resourceNsxtVlanLogicalSwitchRead,
-		Update: resourceNsxtVlanLogicalSwitchUpdate,
-		Delete: resourceNsxtVlanLogicalSwitchDelete,
+		CreateContext: withContext(resourceNsxtVlanLogicalSwitchCreate),
+		ReadContext:   withContext(resourceNsxtVlanLogicalSwitchRead),
+		UpdateContext: withContext(resourceNsxtVlanLogicalSwitchUpdate),
+		DeleteContext: withContext(resourceNsxtVlanLogicalSwitchDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(20 * time.Minute),
+		},
 		DeprecationMessage: mpObjectResourceDeprecationMessage,
 		Schema: map[string]*schema.Schema{
 			"revision": getRevisionSchema(),
@@ -105,8 +110,7 @@ func resourceNsxtVlanLogicalSwitchCreate(d *schema.ResourceData, m interface{})
 		return fmt.Errorf("Unexpected status returned during LogicalSwitch create: %v", resp.StatusCode)
 	}
 
-	toleratePartialSuccess := getCommonProviderConfig(m.(nsxtClients)).ToleratePartialSuccess
-	err = resourceNsxtLogicalSwitchVerifyRealization(d, nsxClient, &logicalSwitch, toleratePartialSuccess)
+	err = resourceNsxtLogicalSwitchVerifyRealization(d, m, nsxClient, &logicalSwitch)
 
 	if err != nil {
 		return err
@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtVMTags() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVMTagsCreate,
-		Read:   resourceNsxtVMTagsRead,
-		Update: resourceNsxtVMTagsUpdate,
-		Delete: resourceNsxtVMTagsDelete,
+		CreateContext: withContext(resourceNsxtVMTagsCreate),
+		ReadContext:   withContext(resourceNsxtVMTagsRead),
+		UpdateContext: withContext(resourceNsxtVMTagsUpdate),
+		DeleteContext: withContext(resourceNsxtVMTagsDelete),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
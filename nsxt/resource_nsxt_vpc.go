@@ -0,0 +1,322 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+var vpcIPAddressTypeValues = []string{
+	model.Vpc_IP_ADDRESS_TYPE_IPV4,
+}
+
+func getVpcPolicyPathListSchema(sdkName string, computed bool) *metadata.ExtendedSchema {
+	return &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type: schema.TypeList,
+			Elem: &metadata.ExtendedSchema{
+				Schema: schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validatePolicyPath(),
+				},
+				Metadata: metadata.Metadata{
+					SchemaType: "string",
+				},
+			},
+			Optional: true,
+			Computed: computed,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "list",
+			SdkFieldName: sdkName,
+		},
+	}
+}
+
+func getVpcPolicyPathSchema(sdkName string, computed bool) *metadata.ExtendedSchema {
+	return &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     computed,
+			ValidateFunc: validatePolicyPath(),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: sdkName,
+			OmitIfEmpty:  true,
+		},
+	}
+}
+
+func getVpcDNSClientConfigSchema() *metadata.ExtendedSchema {
+	return &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"dns_server_ips": {
+						Schema: schema.Schema{
+							Type: schema.TypeList,
+							Elem: &metadata.ExtendedSchema{
+								Schema: schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validateSingleIP(),
+								},
+								Metadata: metadata.Metadata{
+									SchemaType: "string",
+								},
+							},
+							Optional: true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "list",
+							SdkFieldName: "DnsServerIps",
+						},
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "DnsClientConfig",
+			ReflectType:  reflect.TypeOf(model.DnsClientConfig{}),
+		},
+	}
+}
+
+var vpcSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(true, false, false)),
+	"short_id": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "ShortId",
+			OmitIfEmpty:  true,
+		},
+	},
+	"private_ipv4_blocks":  getVpcPolicyPathListSchema("PrivateIpv4Blocks", false),
+	"external_ipv4_blocks": getVpcPolicyPathListSchema("ExternalIpv4Blocks", false),
+	"ipv6_profile_paths":   getVpcPolicyPathListSchema("Ipv6ProfilePaths", true),
+	"default_gateway_path": getVpcPolicyPathSchema("DefaultGatewayPath", true),
+	"ip_address_type": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  model.Vpc_IP_ADDRESS_TYPE_IPV4,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "IpAddressType",
+			EnumValues:   vpcIPAddressTypeValues,
+			TestData: metadata.Testdata{
+				CreateValue: model.Vpc_IP_ADDRESS_TYPE_IPV4,
+				UpdateValue: model.Vpc_IP_ADDRESS_TYPE_IPV4,
+			},
+		},
+	},
+	"dhcp_config": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"enable_dhcp": {
+						Schema: schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "bool",
+							SdkFieldName: "EnableDhcp",
+							TestData: metadata.Testdata{
+								CreateValue: "true",
+								UpdateValue: "false",
+							},
+						},
+					},
+					"dhcp_relay_config_path": getVpcPolicyPathSchema("DhcpRelayConfigPath", false),
+					"dns_client_config":      getVpcDNSClientConfigSchema(),
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "DhcpConfig",
+			ReflectType:  reflect.TypeOf(model.DhcpConfig{}),
+		},
+	},
+	"service_gateway": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"disable": {
+						Schema: schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "bool",
+							SdkFieldName: "Disable",
+						},
+					},
+					"auto_snat": {
+						Schema: schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "bool",
+							SdkFieldName: "AutoSnat",
+							TestData: metadata.Testdata{
+								CreateValue: "true",
+								UpdateValue: "false",
+							},
+						},
+					},
+					"qos_config": {
+						Schema: schema.Schema{
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Elem: &metadata.ExtendedResource{
+								Schema: map[string]*metadata.ExtendedSchema{
+									"ingress_qos_profile_path": getVpcPolicyPathSchema("IngressQosProfilePath", false),
+									"egress_qos_profile_path":  getVpcPolicyPathSchema("EgressQosProfilePath", false),
+								},
+							},
+							Optional: true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "struct",
+							SdkFieldName: "QosConfig",
+							ReflectType:  reflect.TypeOf(model.GatewayQosProfileConfig{}),
+						},
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "ServiceGateway",
+			ReflectType:  reflect.TypeOf(model.ServiceGateway{}),
+		},
+	},
+	"load_balancer_vpc_endpoint": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"enabled": {
+						Schema: schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "bool",
+							SdkFieldName: "Enabled",
+							TestData: metadata.Testdata{
+								CreateValue: "false",
+								UpdateValue: "false",
+							},
+						},
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "LoadBalancerVpcEndpoint",
+			ReflectType:  reflect.TypeOf(model.LoadBalancerVPCEndpoint{}),
+		},
+	},
+	"site_info": {
+		Schema: schema.Schema{
+			Type: schema.TypeList,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"edge_cluster_paths": getVpcPolicyPathListSchema("EdgeClusterPaths", false),
+					"site_path":          getVpcPolicyPathSchema("SitePath", false),
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "list",
+			SdkFieldName: "SiteInfos",
+			ReflectType:  reflect.TypeOf(model.SiteInfo{}),
+		},
+	},
+	"subnet_profiles": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"ip_discovery":     getVpcPolicyPathSchema("IpDiscovery", true),
+					"mac_discovery":    getVpcPolicyPathSchema("MacDiscovery", true),
+					"qos":              getVpcPolicyPathSchema("Qos", true),
+					"segment_security": getVpcPolicyPathSchema("SegmentSecurity", true),
+					"spoof_guard":      getVpcPolicyPathSchema("SpoofGuard", true),
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "SubnetProfiles",
+			ReflectType:  reflect.TypeOf(model.SubnetProfiles{}),
+		},
+	},
+}
+
+var vpcResource = policyMetadataResource[model.Vpc]{
+	name:      "Vpc",
+	schema:    vpcSchema,
+	newClient: newProjectResourceClientFactory[model.Vpc](projects.NewVpcsClient),
+}
+
+func resourceNsxtVPC() *schema.Resource {
+	return vpcResource.resource()
+}
+
+func resourceNsxtVPCExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return vpcResource.exists(sessionContext, id, connector)
+}
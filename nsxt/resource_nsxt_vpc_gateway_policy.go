@@ -17,10 +17,10 @@ import (
 
 func resourceNsxtVPCGatewayPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVPCGatewayPolicyCreate,
-		Read:   resourceNsxtVPCGatewayPolicyRead,
-		Update: resourceNsxtVPCGatewayPolicyUpdate,
-		Delete: resourceNsxtVPCGatewayPolicyDelete,
+		CreateContext: withContext(resourceNsxtVPCGatewayPolicyCreate),
+		ReadContext:   withContext(resourceNsxtVPCGatewayPolicyRead),
+		UpdateContext: withContext(resourceNsxtVPCGatewayPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtVPCGatewayPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
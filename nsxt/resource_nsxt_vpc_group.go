@@ -7,10 +7,10 @@ import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 func resourceNsxtVPCGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVPCGroupCreate,
-		Read:   resourceNsxtVPCGroupRead,
-		Update: resourceNsxtVPCGroupUpdate,
-		Delete: resourceNsxtVPCGroupDelete,
+		CreateContext: withContext(resourceNsxtVPCGroupCreate),
+		ReadContext:   withContext(resourceNsxtVPCGroupRead),
+		UpdateContext: withContext(resourceNsxtVPCGroupUpdate),
+		DeleteContext: withContext(resourceNsxtVPCGroupDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
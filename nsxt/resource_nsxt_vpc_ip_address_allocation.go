@@ -0,0 +1,96 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+var vpcIPAddressAllocationBlockVisibilityValues = []string{
+	model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+	model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_PRIVATE,
+}
+
+var vpcIPAddressAllocationTypeValues = []string{
+	model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+	model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV6,
+}
+
+var vpcIPAddressAllocationSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(true, false, true)),
+	"allocation_ip": {
+		Schema: schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateSingleIP(),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "AllocationIp",
+			OmitIfEmpty:  true,
+		},
+	},
+	"ip_address_block_visibility": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "IpAddressBlockVisibility",
+			EnumValues:   vpcIPAddressAllocationBlockVisibilityValues,
+			TestData: metadata.Testdata{
+				CreateValue: model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+				UpdateValue: model.VpcIpAddressAllocation_IP_ADDRESS_BLOCK_VISIBILITY_EXTERNAL,
+			},
+		},
+	},
+	"ip_address_type": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "IpAddressType",
+			EnumValues:   vpcIPAddressAllocationTypeValues,
+			TestData: metadata.Testdata{
+				CreateValue: model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+				UpdateValue: model.VpcIpAddressAllocation_IP_ADDRESS_TYPE_IPV4,
+			},
+		},
+	},
+}
+
+var vpcIPAddressAllocationResource = policyMetadataResource[model.VpcIpAddressAllocation]{
+	name:      "VpcIpAddressAllocation",
+	schema:    vpcIPAddressAllocationSchema,
+	newClient: newVpcResourceClientFactory[model.VpcIpAddressAllocation](vpcs.NewIpAddressAllocationsClient),
+}
+
+func resourceNsxtVPCIPAddressAllocation() *schema.Resource {
+	return vpcIPAddressAllocationResource.resource()
+}
+
+func resourceNsxtVPCIPAddressAllocationExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return vpcIPAddressAllocationResource.exists(sessionContext, id, connector)
+}
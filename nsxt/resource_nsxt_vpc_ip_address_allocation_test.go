@@ -0,0 +1,77 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var testAccVPCIPAddressAllocationResource = testMetadataResource{
+	resourceType: "nsxt_vpc_ip_address_allocation",
+	exists:       resourceNsxtVPCIPAddressAllocationExists,
+}
+
+func TestAccResourceNsxtVPCIPAddressAllocation_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_ip_address_allocation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataResourceCheckDestroy(testAccVPCIPAddressAllocationResource, state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVPCIPAddressAllocationTemplate(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCIPAddressAllocationResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_block_visibility", "EXTERNAL"),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttrSet(testResourceName, "allocation_ip"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtVPCIPAddressAllocationTemplate(updatedName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCIPAddressAllocationResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttrSet(testResourceName, "allocation_ip"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVPCIPAddressAllocationTemplate(name string) string {
+	return fmt.Sprintf(`
+resource "nsxt_vpc_ip_address_allocation" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, testAccNsxtPolicyMultitenancyContext(), name)
+}
@@ -0,0 +1,142 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+var vpcNatRuleActionValues = []string{
+	model.PolicyVpcNatRule_ACTION_SNAT,
+	model.PolicyVpcNatRule_ACTION_DNAT,
+	model.PolicyVpcNatRule_ACTION_REFLEXIVE,
+}
+
+var vpcNatRuleFirewallMatchValues = []string{
+	model.PolicyVpcNatRule_FIREWALL_MATCH_MATCH_EXTERNAL_ADDRESS,
+	model.PolicyVpcNatRule_FIREWALL_MATCH_MATCH_INTERNAL_ADDRESS,
+	model.PolicyVpcNatRule_FIREWALL_MATCH_BYPASS,
+}
+
+func getVpcNatRuleNetworkSchema(sdkName string) *metadata.ExtendedSchema {
+	return &metadata.ExtendedSchema{
+		Schema: schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateCidrOrIPOrRange(),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: sdkName,
+			OmitIfEmpty:  true,
+		},
+	}
+}
+
+var vpcNatRuleSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(true, false, true)),
+	"action": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "Action",
+			EnumValues:   vpcNatRuleActionValues,
+			TestData: metadata.Testdata{
+				CreateValue: model.PolicyVpcNatRule_ACTION_DNAT,
+				UpdateValue: model.PolicyVpcNatRule_ACTION_DNAT,
+			},
+		},
+	},
+	"source_network":      getVpcNatRuleNetworkSchema("SourceNetwork"),
+	"destination_network": getVpcNatRuleNetworkSchema("DestinationNetwork"),
+	"translated_network":  getVpcNatRuleNetworkSchema("TranslatedNetwork"),
+	"enabled": {
+		Schema: schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "Enabled",
+			TestData: metadata.Testdata{
+				CreateValue: "true",
+				UpdateValue: "false",
+			},
+		},
+	},
+	"logging": {
+		Schema: schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "bool",
+			SdkFieldName: "Logging",
+			TestData: metadata.Testdata{
+				CreateValue: "false",
+				UpdateValue: "true",
+			},
+		},
+	},
+	"firewall_match": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "FirewallMatch",
+			EnumValues:   vpcNatRuleFirewallMatchValues,
+			OmitIfEmpty:  true,
+			TestData: metadata.Testdata{
+				CreateValue: model.PolicyVpcNatRule_FIREWALL_MATCH_MATCH_EXTERNAL_ADDRESS,
+				UpdateValue: model.PolicyVpcNatRule_FIREWALL_MATCH_MATCH_EXTERNAL_ADDRESS,
+			},
+		},
+	},
+	"sequence_number": {
+		Schema: schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "SequenceNumber",
+			OmitIfEmpty:  true,
+		},
+	},
+}
+
+// NAT rules are created in user NAT section of the VPC
+var vpcNatRuleResource = policyMetadataResource[model.PolicyVpcNatRule]{
+	name:      "VpcNatRule",
+	schema:    vpcNatRuleSchema,
+	newClient: newVpcResourceClientFactory[model.PolicyVpcNatRule](newVpcUserNatRulesClient),
+}
+
+func resourceNsxtVPCNatRule() *schema.Resource {
+	return vpcNatRuleResource.resource()
+}
+
+func resourceNsxtVPCNatRuleExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return vpcNatRuleResource.exists(sessionContext, id, connector)
+}
@@ -0,0 +1,96 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var testAccVPCNatRuleResource = testMetadataResource{
+	resourceType: "nsxt_vpc_nat_rule",
+	exists:       resourceNsxtVPCNatRuleExists,
+}
+
+func TestAccResourceNsxtVPCNatRule_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_nat_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataResourceCheckDestroy(testAccVPCNatRuleResource, state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVPCNatRuleTemplate(name, "true", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCNatRuleResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "action", "DNAT"),
+					resource.TestCheckResourceAttr(testResourceName, "translated_network", "192.168.1.10"),
+					resource.TestCheckResourceAttr(testResourceName, "firewall_match", "MATCH_EXTERNAL_ADDRESS"),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "logging", "false"),
+					resource.TestCheckResourceAttrSet(testResourceName, "destination_network"),
+					resource.TestCheckResourceAttrSet(testResourceName, "sequence_number"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtVPCNatRuleTemplate(updatedName, "false", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCNatRuleResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "logging", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVPCNatRuleTemplate(name string, enabled string, logging string) string {
+	context := testAccNsxtPolicyMultitenancyContext()
+	return fmt.Sprintf(`
+resource "nsxt_vpc_ip_address_allocation" "test" {
+%s
+  display_name = "%s"
+}
+
+resource "nsxt_vpc_nat_rule" "test" {
+%s
+  display_name        = "%s"
+  description         = "Acceptance Test"
+  action              = "DNAT"
+  destination_network = nsxt_vpc_ip_address_allocation.test.allocation_ip
+  translated_network  = "192.168.1.10"
+  firewall_match      = "MATCH_EXTERNAL_ADDRESS"
+  enabled             = %s
+  logging             = %s
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, context, name, context, name, enabled, logging)
+}
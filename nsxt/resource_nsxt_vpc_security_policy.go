@@ -13,10 +13,10 @@ import (
 
 func resourceNsxtVPCSecurityPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNsxtVPCSecurityPolicyCreate,
-		Read:   resourceNsxtVPCSecurityPolicyRead,
-		Update: resourceNsxtVPCSecurityPolicyUpdate,
-		Delete: resourceNsxtVPCSecurityPolicyDelete,
+		CreateContext: withContext(resourceNsxtVPCSecurityPolicyCreate),
+		ReadContext:   withContext(resourceNsxtVPCSecurityPolicyRead),
+		UpdateContext: withContext(resourceNsxtVPCSecurityPolicyUpdate),
+		DeleteContext: withContext(resourceNsxtVPCSecurityPolicyDelete),
 		Importer: &schema.ResourceImporter{
 			State: nsxtPolicyPathResourceImporter,
 		},
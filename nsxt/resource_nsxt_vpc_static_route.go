@@ -0,0 +1,104 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+var vpcStaticRouteSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(true, false, true)),
+	"network": {
+		Schema: schema.Schema{
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateCidr(),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "Network",
+			TestData: metadata.Testdata{
+				CreateValue: "10.10.0.0/16",
+				UpdateValue: "10.20.0.0/16",
+			},
+		},
+	},
+	"next_hop": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MinItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"ip_address": {
+						Schema: schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateSingleIP(),
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "string",
+							SdkFieldName: "IpAddress",
+							OmitIfEmpty:  true,
+							TestData: metadata.Testdata{
+								CreateValue: "192.168.10.1",
+								UpdateValue: "192.168.10.1",
+							},
+						},
+					},
+					"admin_distance": {
+						Schema: schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 255),
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "int",
+							SdkFieldName: "AdminDistance",
+							TestData: metadata.Testdata{
+								CreateValue: "1",
+								UpdateValue: "4",
+							},
+						},
+					},
+				},
+			},
+			Required: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "list",
+			SdkFieldName: "NextHops",
+			ReflectType:  reflect.TypeOf(model.RouterNexthop{}),
+		},
+	},
+}
+
+var vpcStaticRouteResource = policyMetadataResource[model.StaticRoutes]{
+	name:      "VpcStaticRoute",
+	schema:    vpcStaticRouteSchema,
+	newClient: newVpcResourceClientFactory[model.StaticRoutes](vpcs.NewStaticRoutesClient),
+}
+
+func resourceNsxtVPCStaticRoute() *schema.Resource {
+	return vpcStaticRouteResource.resource()
+}
+
+func resourceNsxtVPCStaticRouteExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return vpcStaticRouteResource.exists(sessionContext, id, connector)
+}
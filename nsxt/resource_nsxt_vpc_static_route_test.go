@@ -0,0 +1,87 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var testAccVPCStaticRouteResource = testMetadataResource{
+	resourceType: "nsxt_vpc_static_route",
+	exists:       resourceNsxtVPCStaticRouteExists,
+}
+
+func TestAccResourceNsxtVPCStaticRoute_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_static_route.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataResourceCheckDestroy(testAccVPCStaticRouteResource, state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVPCStaticRouteTemplate(name, "10.10.0.0/16", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCStaticRouteResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "network", "10.10.0.0/16"),
+					resource.TestCheckResourceAttr(testResourceName, "next_hop.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "next_hop.0.ip_address", "192.168.10.1"),
+					resource.TestCheckResourceAttr(testResourceName, "next_hop.0.admin_distance", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtVPCStaticRouteTemplate(updatedName, "10.20.0.0/16", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCStaticRouteResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "network", "10.20.0.0/16"),
+					resource.TestCheckResourceAttr(testResourceName, "next_hop.0.admin_distance", "4"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVPCStaticRouteTemplate(name string, network string, distance int) string {
+	return fmt.Sprintf(`
+resource "nsxt_vpc_static_route" "test" {
+%s
+  display_name = "%s"
+  description  = "Acceptance Test"
+  network      = "%s"
+
+  next_hop {
+    ip_address     = "192.168.10.1"
+    admin_distance = %d
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, testAccNsxtPolicyMultitenancyContext(), name, network, distance)
+}
@@ -0,0 +1,211 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+	"github.com/vmware/terraform-provider-nsxt/nsxt/metadata"
+)
+
+var vpcSubnetAccessModeValues = []string{
+	model.VpcSubnet_ACCESS_MODE_PRIVATE,
+	model.VpcSubnet_ACCESS_MODE_PUBLIC,
+	model.VpcSubnet_ACCESS_MODE_ISOLATED,
+}
+
+var vpcSubnetSchema = map[string]*metadata.ExtendedSchema{
+	"nsx_id":       metadata.GetExtendedSchema(getNsxIDSchema()),
+	"path":         metadata.GetExtendedSchema(getPathSchema()),
+	"display_name": metadata.GetExtendedSchema(getDisplayNameSchema()),
+	"description":  metadata.GetExtendedSchema(getDescriptionSchema()),
+	"revision":     metadata.GetExtendedSchema(getRevisionSchema()),
+	"tag":          metadata.GetExtendedSchema(getTagsSchema()),
+	"context":      metadata.GetExtendedSchema(getContextSchema(true, false, true)),
+	"ip_addresses": {
+		Schema: schema.Schema{
+			Type: schema.TypeList,
+			Elem: &metadata.ExtendedSchema{
+				Schema: schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateCidr(),
+				},
+				Metadata: metadata.Metadata{
+					SchemaType: "string",
+				},
+			},
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "list",
+			SdkFieldName: "IpAddresses",
+		},
+	},
+	"ipv4_subnet_size": {
+		Schema: schema.Schema{
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validatePowerOf2(false, 0),
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "int",
+			SdkFieldName: "Ipv4SubnetSize",
+			OmitIfEmpty:  true,
+			TestData: metadata.Testdata{
+				CreateValue: "16",
+				UpdateValue: "16",
+			},
+		},
+	},
+	"access_mode": {
+		Schema: schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  model.VpcSubnet_ACCESS_MODE_PRIVATE,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "string",
+			SdkFieldName: "AccessMode",
+			EnumValues:   vpcSubnetAccessModeValues,
+			TestData: metadata.Testdata{
+				CreateValue: model.VpcSubnet_ACCESS_MODE_PRIVATE,
+				UpdateValue: model.VpcSubnet_ACCESS_MODE_ISOLATED,
+			},
+		},
+	},
+	"dhcp_config": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"enable_dhcp": {
+						Schema: schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "bool",
+							SdkFieldName: "EnableDhcp",
+						},
+					},
+					"dhcp_relay_config_path": getVpcPolicyPathSchema("DhcpRelayConfigPath", false),
+					"dns_client_config":      getVpcDNSClientConfigSchema(),
+					"static_pool_config": {
+						Schema: schema.Schema{
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Elem: &metadata.ExtendedResource{
+								Schema: map[string]*metadata.ExtendedSchema{
+									"ipv4_pool_size": {
+										Schema: schema.Schema{
+											Type:         schema.TypeInt,
+											Optional:     true,
+											Computed:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+										Metadata: metadata.Metadata{
+											SchemaType:   "int",
+											SdkFieldName: "Ipv4PoolSize",
+											OmitIfEmpty:  true,
+										},
+									},
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "struct",
+							SdkFieldName: "StaticPoolConfig",
+							ReflectType:  reflect.TypeOf(model.StaticPoolConfig{}),
+						},
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "DhcpConfig",
+			ReflectType:  reflect.TypeOf(model.VpcSubnetDhcpConfig{}),
+		},
+	},
+	"advanced_config": {
+		Schema: schema.Schema{
+			Type:     schema.TypeList,
+			MaxItems: 1,
+			Elem: &metadata.ExtendedResource{
+				Schema: map[string]*metadata.ExtendedSchema{
+					"static_ip_allocation": {
+						Schema: schema.Schema{
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Elem: &metadata.ExtendedResource{
+								Schema: map[string]*metadata.ExtendedSchema{
+									"enabled": {
+										Schema: schema.Schema{
+											Type:     schema.TypeBool,
+											Optional: true,
+											Default:  false,
+										},
+										Metadata: metadata.Metadata{
+											SchemaType:   "bool",
+											SdkFieldName: "Enabled",
+											TestData: metadata.Testdata{
+												CreateValue: "false",
+												UpdateValue: "true",
+											},
+										},
+									},
+								},
+							},
+							Optional: true,
+							Computed: true,
+						},
+						Metadata: metadata.Metadata{
+							SchemaType:   "struct",
+							SdkFieldName: "StaticIpAllocation",
+							ReflectType:  reflect.TypeOf(model.StaticIpAllocation{}),
+						},
+					},
+				},
+			},
+			Optional: true,
+			Computed: true,
+		},
+		Metadata: metadata.Metadata{
+			SchemaType:   "struct",
+			SdkFieldName: "AdvancedConfig",
+			ReflectType:  reflect.TypeOf(model.SubnetAdvancedConfig{}),
+		},
+	},
+}
+
+var vpcSubnetResource = policyMetadataResource[model.VpcSubnet]{
+	name:      "VpcSubnet",
+	schema:    vpcSubnetSchema,
+	newClient: newVpcResourceClientFactory[model.VpcSubnet](vpcs.NewSubnetsClient),
+}
+
+func resourceNsxtVPCSubnet() *schema.Resource {
+	return vpcSubnetResource.resource()
+}
+
+func resourceNsxtVPCSubnetExists(sessionContext utl.SessionContext, id string, connector client.Connector) (bool, error) {
+	return vpcSubnetResource.exists(sessionContext, id, connector)
+}
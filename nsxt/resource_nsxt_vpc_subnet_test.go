@@ -0,0 +1,89 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var testAccVPCSubnetResource = testMetadataResource{
+	resourceType: "nsxt_vpc_subnet",
+	exists:       resourceNsxtVPCSubnetExists,
+}
+
+func TestAccResourceNsxtVPCSubnet_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc_subnet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtPolicyMetadataResourceCheckDestroy(testAccVPCSubnetResource, state)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVPCSubnetTemplate(name, "Private", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCSubnetResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "ipv4_subnet_size", "16"),
+					resource.TestCheckResourceAttr(testResourceName, "access_mode", "Private"),
+					resource.TestCheckResourceAttr(testResourceName, "advanced_config.0.static_ip_allocation.0.enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtVPCSubnetTemplate(updatedName, "Isolated", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtPolicyMetadataResourceExists(testAccVPCSubnetResource, testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "access_mode", "Isolated"),
+					resource.TestCheckResourceAttr(testResourceName, "advanced_config.0.static_ip_allocation.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccNsxtVPCSubnetTemplate(name string, accessMode string, staticAllocation string) string {
+	return fmt.Sprintf(`
+resource "nsxt_vpc_subnet" "test" {
+%s
+  display_name     = "%s"
+  description      = "Acceptance Test"
+  ipv4_subnet_size = 16
+  access_mode      = "%s"
+
+  advanced_config {
+    static_ip_allocation {
+      enabled = %s
+    }
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, testAccNsxtPolicyMultitenancyContext(), name, accessMode, staticAllocation)
+}
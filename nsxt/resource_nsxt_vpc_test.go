@@ -0,0 +1,163 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tf_api "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+func TestAccResourceNsxtVPC_basic(t *testing.T) {
+	name := getAccTestResourceName()
+	updatedName := getAccTestResourceName()
+	testResourceName := "nsxt_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccOnlyVPC(t)
+		},
+		Providers: testAccProviders,
+		CheckDestroy: func(state *terraform.State) error {
+			return testAccNsxtVPCCheckDestroy(state, name)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNsxtVPCTemplate(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVPCExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", name),
+					resource.TestCheckResourceAttr(testResourceName, "description", "Acceptance Test"),
+					resource.TestCheckResourceAttr(testResourceName, "context.0.project_id", os.Getenv("NSXT_VPC_PROJECT_ID")),
+					resource.TestCheckResourceAttr(testResourceName, "ip_address_type", "IPV4"),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.0.disable", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.0.auto_snat", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.0.enable_dhcp", "true"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.0.dns_client_config.0.dns_server_ips.#", "1"),
+					resource.TestCheckResourceAttr(testResourceName, "load_balancer_vpc_endpoint.0.enabled", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccNsxtVPCTemplate(updatedName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccNsxtVPCExists(testResourceName),
+					resource.TestCheckResourceAttr(testResourceName, "display_name", updatedName),
+					resource.TestCheckResourceAttr(testResourceName, "service_gateway.0.auto_snat", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.0.enable_dhcp", "false"),
+					resource.TestCheckResourceAttr(testResourceName, "dhcp_config.0.dns_client_config.0.dns_server_ips.#", "2"),
+					resource.TestCheckResourceAttr(testResourceName, "tag.#", "1"),
+					resource.TestCheckResourceAttrSet(testResourceName, "path"),
+					resource.TestCheckResourceAttrSet(testResourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:      testResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccResourceNsxtPolicyImportIDRetriever(testResourceName),
+			},
+		},
+	})
+}
+
+func testAccGetVPCProjectSessionContext() tf_api.SessionContext {
+	return tf_api.SessionContext{ProjectID: os.Getenv("NSXT_VPC_PROJECT_ID"), ClientType: tf_api.Multitenancy}
+}
+
+func testAccNsxtVPCExists(resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("VPC resource %s not found in resources", resourceName)
+		}
+
+		resourceID := rs.Primary.ID
+		if resourceID == "" {
+			return fmt.Errorf("VPC resource ID not set in resources")
+		}
+
+		exists, err := resourceNsxtVPCExists(testAccGetVPCProjectSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("VPC %s does not exist", resourceID)
+		}
+
+		return nil
+	}
+}
+
+func testAccNsxtVPCCheckDestroy(state *terraform.State, displayName string) error {
+	connector := getPolicyConnector(testAccProvider.Meta().(nsxtClients))
+	for _, rs := range state.RootModule().Resources {
+
+		if rs.Type != "nsxt_vpc" {
+			continue
+		}
+
+		resourceID := rs.Primary.Attributes["id"]
+		exists, err := resourceNsxtVPCExists(testAccGetVPCProjectSessionContext(), resourceID, connector)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return fmt.Errorf("VPC %s still exists", displayName)
+		}
+	}
+	return nil
+}
+
+func testAccNsxtVPCTemplate(name string, createFlow bool) string {
+	autoSnat := "true"
+	enableDhcp := "true"
+	dnsServers := `"10.1.1.1"`
+	if !createFlow {
+		autoSnat = "false"
+		enableDhcp = "false"
+		dnsServers = `"10.1.1.1", "10.1.1.2"`
+	}
+	return fmt.Sprintf(`
+resource "nsxt_vpc" "test" {
+  context {
+    project_id = "%s"
+  }
+
+  display_name = "%s"
+  description  = "Acceptance Test"
+
+  service_gateway {
+    disable   = false
+    auto_snat = %s
+  }
+
+  dhcp_config {
+    enable_dhcp = %s
+    dns_client_config {
+      dns_server_ips = [%s]
+    }
+  }
+
+  load_balancer_vpc_endpoint {
+    enabled = false
+  }
+
+  tag {
+    scope = "scope1"
+    tag   = "tag1"
+  }
+}`, os.Getenv("NSXT_VPC_PROJECT_ID"), name, autoSnat, enableDhcp, dnsServers)
+}
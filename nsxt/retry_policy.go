@@ -0,0 +1,260 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	retryStrategyUniform           = "uniform"
+	retryStrategyExponential       = "exponential"
+	retryStrategyExponentialJitter = "exponential_jitter"
+)
+
+var retryStrategyValues = []string{
+	retryStrategyUniform,
+	retryStrategyExponential,
+	retryStrategyExponentialJitter,
+}
+
+// Base delay for exponential strategies when retry_min_delay is not set
+const retryDefaultBaseDelay = 100 * time.Millisecond
+
+// retryPolicy decides whether failed NSX request should be retried, and how
+// long to wait before the next attempt. The policy is shared by MP and Policy
+// clients.
+type retryPolicy struct {
+	strategy    string
+	maxRetries  int
+	minDelay    time.Duration
+	maxDelay    time.Duration
+	statusCodes []int
+}
+
+func newRetryPolicy(config commonProviderConfig) *retryPolicy {
+	strategy := config.RetryStrategy
+	if strategy == "" {
+		strategy = retryStrategyUniform
+	}
+	return &retryPolicy{
+		strategy:    strategy,
+		maxRetries:  config.MaxRetries,
+		minDelay:    time.Duration(config.MinRetryInterval) * time.Millisecond,
+		maxDelay:    time.Duration(config.MaxRetryInterval) * time.Millisecond,
+		statusCodes: config.RetryStatusCodes,
+	}
+}
+
+// isIdempotentMethod returns false for methods that may create objects or
+// trigger actions on NSX if repeated. Unknown method is treated as idempotent.
+func isIdempotentMethod(method string) bool {
+	return method != http.MethodPost
+}
+
+// shouldRetry is evaluated after failed attempt (numbered from 0). statusCode
+// is 0 if no response was received.
+func (p *retryPolicy) shouldRetry(attempt int, method string, statusCode int) bool {
+	if attempt >= p.maxRetries {
+		return false
+	}
+	if statusCode == 0 {
+		// request might have reached NSX before the connection failed
+		return isIdempotentMethod(method)
+	}
+	if !slices.Contains(p.statusCodes, statusCode) {
+		return false
+	}
+	// Non-idempotent request is only retried when NSX explicitly rejected it
+	// before processing
+	return isIdempotentMethod(method) || statusCode == http.StatusTooManyRequests
+}
+
+// delay returns wait interval before the next attempt. Retry-After header of
+// the response, if present, takes precedence over the configured strategy.
+func (p *retryPolicy) delay(attempt int, resp *http.Response) time.Duration {
+	if retryAfter, ok := getRetryAfter(resp); ok {
+		return retryAfter
+	}
+
+	switch p.strategy {
+	case retryStrategyExponential:
+		return p.exponentialDelay(attempt)
+	case retryStrategyExponentialJitter:
+		return randomDuration(p.minDelay, p.exponentialDelay(attempt))
+	default:
+		return randomDuration(p.minDelay, p.maxDelay)
+	}
+}
+
+func (p *retryPolicy) exponentialDelay(attempt int) time.Duration {
+	delay := p.minDelay
+	if delay <= 0 {
+		delay = retryDefaultBaseDelay
+	}
+	for i := 0; i < attempt && delay < p.maxDelay; i++ {
+		delay *= 2
+	}
+	if p.maxDelay > 0 && delay > p.maxDelay {
+		delay = p.maxDelay
+	}
+	return delay
+}
+
+// wait sleeps before the next attempt, and returns false if the context was
+// canceled in the meantime
+func (p *retryPolicy) wait(ctx context.Context, attempt int, resp *http.Response) bool {
+	delay := p.delay(attempt, resp)
+	if delay <= 0 {
+		return ctx.Err() == nil
+	}
+
+	log.Printf("[DEBUG]: Waiting %v before retrying request, attempt %d", delay, attempt+1)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func randomDuration(min time.Duration, max time.Duration) time.Duration {
+	if max <= min {
+		return min
+	}
+	return min + time.Duration(rand.Int63n(int64(max-min)))
+}
+
+// getRetryAfter parses Retry-After header, which holds either number of
+// seconds or HTTP date
+func getRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// retryDecorator applies retry policy to vAPI invocations
+type retryDecorator struct {
+	next   core.APIProvider
+	policy *retryPolicy
+}
+
+func newRetryDecorator(policy *retryPolicy) core.APIProviderDecorator {
+	return func(next core.APIProvider) core.APIProvider {
+		return retryDecorator{
+			next:   next,
+			policy: policy,
+		}
+	}
+}
+
+func getOperationHTTPMethod(ctx *core.ExecutionContext) string {
+	metadata, err := ctx.ConnectionMetadata(core.RESTMetadataKey)
+	if err != nil {
+		return ""
+	}
+	if restMetadata, ok := metadata.(protocol.OperationRestMetadata); ok {
+		return restMetadata.HttpMethod()
+	}
+	return ""
+}
+
+func (d retryDecorator) Invoke(serviceID string, operationID string, input data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	var response *http.Response
+	extendedCtx := ctx.WithResponseAcceptor(func(resp *http.Response) {
+		response = resp
+	})
+	method := getOperationHTTPMethod(ctx)
+
+	for attempt := 0; ; attempt++ {
+		response = nil
+		extendedCtx.WithContext(withRetryAttempt(ctx.Context(), attempt))
+		result := d.next.Invoke(serviceID, operationID, input, extendedCtx)
+
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		} else if result.IsSuccess() {
+			return result
+		}
+
+		if !d.policy.shouldRetry(attempt, method, statusCode) {
+			return result
+		}
+		log.Printf("[DEBUG]: Retrying operation %s of %s due to status %d", operationID, serviceID, statusCode)
+		if !d.policy.wait(ctx.Context(), attempt, response) {
+			return result
+		}
+	}
+}
+
+// retryTransport applies retry policy to HTTP requests of MP client
+type retryTransport struct {
+	next   http.RoundTripper
+	policy *retryPolicy
+}
+
+func newRetryTransport(policy *retryPolicy, next http.RoundTripper) *retryTransport {
+	return &retryTransport{
+		next:   next,
+		policy: policy,
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	getBody, err := getRequestBodyFunc(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := cloneRequestWithBody(req, getBody).WithContext(withRetryAttempt(req.Context(), attempt))
+		resp, err := t.next.RoundTrip(attemptReq)
+
+		statusCode := 0
+		if err == nil {
+			statusCode = resp.StatusCode
+		}
+		if !t.policy.shouldRetry(attempt, req.Method, statusCode) {
+			return resp, err
+		}
+
+		log.Printf("[DEBUG]: Retrying request %s %s due to status %d", req.Method, req.URL, statusCode)
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		if !t.policy.wait(req.Context(), attempt, resp) {
+			return nil, req.Context().Err()
+		}
+	}
+}
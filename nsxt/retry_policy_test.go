@@ -0,0 +1,223 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol"
+)
+
+func getTestRetryPolicy(strategy string, minDelay int, maxDelay int) *retryPolicy {
+	return newRetryPolicy(commonProviderConfig{
+		MaxRetries:       3,
+		MinRetryInterval: minDelay,
+		MaxRetryInterval: maxDelay,
+		RetryStatusCodes: []int{429, 503},
+		RetryStrategy:    strategy,
+	})
+}
+
+func TestRetryPolicyShouldRetry(t *testing.T) {
+	policy := getTestRetryPolicy(retryStrategyUniform, 0, 0)
+	testCases := []struct {
+		attempt    int
+		method     string
+		statusCode int
+		expected   bool
+	}{
+		{0, http.MethodGet, 503, true},
+		{0, http.MethodPatch, 429, true},
+		{0, http.MethodGet, 0, true},
+		{0, http.MethodGet, 404, false},
+		{0, http.MethodGet, 200, false},
+		{3, http.MethodGet, 503, false},
+		{0, http.MethodPost, 503, false},
+		{0, http.MethodPost, 0, false},
+		{0, http.MethodPost, 429, true},
+		{0, "", 503, true},
+	}
+
+	for _, tc := range testCases {
+		if policy.shouldRetry(tc.attempt, tc.method, tc.statusCode) != tc.expected {
+			t.Errorf("attempt %d %s status %d: expected %v", tc.attempt, tc.method, tc.statusCode, tc.expected)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	// equal min and max delay used to panic
+	if delay := getTestRetryPolicy(retryStrategyUniform, 100, 100).delay(0, nil); delay != 100*time.Millisecond {
+		t.Errorf("expected 100ms delay, got %v", delay)
+	}
+	if delay := getTestRetryPolicy("", 0, 0).delay(0, nil); delay != 0 {
+		t.Errorf("expected no delay, got %v", delay)
+	}
+
+	uniform := getTestRetryPolicy(retryStrategyUniform, 10, 20)
+	for i := 0; i < 10; i++ {
+		if delay := uniform.delay(i, nil); delay < 10*time.Millisecond || delay >= 20*time.Millisecond {
+			t.Errorf("uniform delay %v out of range", delay)
+		}
+	}
+
+	exponential := getTestRetryPolicy(retryStrategyExponential, 100, 500)
+	expected := []time.Duration{100, 200, 400, 500, 500}
+	for i, exp := range expected {
+		if delay := exponential.delay(i, nil); delay != exp*time.Millisecond {
+			t.Errorf("attempt %d: expected exponential delay %v, got %v", i, exp*time.Millisecond, delay)
+		}
+	}
+
+	// default base delay is used when minimum is not configured
+	if delay := getTestRetryPolicy(retryStrategyExponential, 0, 1000).delay(1, nil); delay != 2*retryDefaultBaseDelay {
+		t.Errorf("expected exponential delay %v, got %v", 2*retryDefaultBaseDelay, delay)
+	}
+
+	jitter := getTestRetryPolicy(retryStrategyExponentialJitter, 100, 500)
+	for i := 0; i < 5; i++ {
+		if delay := jitter.delay(i, nil); delay < 100*time.Millisecond || delay > exponential.delay(i, nil) {
+			t.Errorf("attempt %d: jitter delay %v out of range", i, delay)
+		}
+	}
+}
+
+func TestRetryPolicyRetryAfter(t *testing.T) {
+	policy := getTestRetryPolicy(retryStrategyExponential, 100, 500)
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if delay := policy.delay(0, resp); delay != 3*time.Second {
+		t.Errorf("expected delay from Retry-After seconds, got %v", delay)
+	}
+
+	resp.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+	if delay := policy.delay(0, resp); delay < 59*time.Minute || delay > time.Hour {
+		t.Errorf("expected delay from Retry-After date, got %v", delay)
+	}
+
+	resp.Header.Set("Retry-After", "invalid")
+	if delay := policy.delay(0, resp); delay != 100*time.Millisecond {
+		t.Errorf("expected strategy delay for invalid Retry-After, got %v", delay)
+	}
+}
+
+type testRetryRoundTripper struct {
+	statuses []int
+	bodies   []string
+	methods  []string
+}
+
+func (t *testRetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		body = string(b)
+	}
+	t.bodies = append(t.bodies, body)
+	t.methods = append(t.methods, req.Method)
+	status := t.statuses[0]
+	if len(t.statuses) > 1 {
+		t.statuses = t.statuses[1:]
+	}
+	resp := &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}
+	if status == http.StatusTooManyRequests {
+		resp.Header.Set("Retry-After", "0")
+	}
+	return resp, nil
+}
+
+func TestRetryTransport(t *testing.T) {
+	next := &testRetryRoundTripper{statuses: []int{429, 503, 200}}
+	tr := newRetryTransport(getTestRetryPolicy(retryStrategyUniform, 0, 0), next)
+
+	req, _ := http.NewRequest(http.MethodPatch, "https://nsx/api/v1/logical-switches/1", strings.NewReader("payload"))
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", resp.StatusCode)
+	}
+	if len(next.bodies) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(next.bodies))
+	}
+	for _, body := range next.bodies {
+		if body != "payload" {
+			t.Errorf("request body was not replayed, got %s", body)
+		}
+	}
+}
+
+func TestRetryTransportPost(t *testing.T) {
+	next := &testRetryRoundTripper{statuses: []int{503, 200}}
+	tr := newRetryTransport(getTestRetryPolicy(retryStrategyUniform, 0, 0), next)
+
+	req, _ := http.NewRequest(http.MethodPost, "https://nsx/api/v1/logical-switches", strings.NewReader("payload"))
+	resp, err := tr.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || len(next.bodies) != 1 {
+		t.Errorf("expected POST not to be retried, got status %d after %d attempts", resp.StatusCode, len(next.bodies))
+	}
+}
+
+type testRetryAPIProvider struct {
+	statuses []int
+	attempts int
+}
+
+func (p *testRetryAPIProvider) Invoke(serviceID string, operationID string, inputValue data.DataValue, ctx *core.ExecutionContext) core.MethodResult {
+	status := p.statuses[p.attempts]
+	p.attempts++
+	for _, acceptor := range ctx.RuntimeData().GetResponseAcceptors() {
+		acceptor(&http.Response{StatusCode: status, Header: http.Header{}})
+	}
+	if status != http.StatusOK {
+		return core.NewMethodResult(nil, data.NewErrorValue("com.vmware.vapi.std.errors.service_unavailable", nil))
+	}
+	return core.NewMethodResult(data.NewStructValue("result", nil), nil)
+}
+
+func newTestRetryExecutionContext(ctx context.Context, method string) *core.ExecutionContext {
+	execCtx := core.NewExecutionContext(nil, nil)
+	execCtx.WithContext(ctx)
+	execCtx.SetConnectionMetadata(core.RESTMetadataKey, protocol.NewOperationRestMetadata(nil, nil, nil, nil, nil, nil, nil, nil, "", "", method, "", "", nil, 0, "", nil, nil))
+	return execCtx
+}
+
+func TestRetryDecorator(t *testing.T) {
+	policy := getTestRetryPolicy(retryStrategyUniform, 0, 0)
+
+	next := &testRetryAPIProvider{statuses: []int{503, 429, 200}}
+	result := newRetryDecorator(policy)(next).Invoke("service", "get", nil, newTestRetryExecutionContext(context.Background(), http.MethodGet))
+	if !result.IsSuccess() || next.attempts != 3 {
+		t.Errorf("expected success after 3 attempts, got %d attempts", next.attempts)
+	}
+
+	next = &testRetryAPIProvider{statuses: []int{503, 200}}
+	result = newRetryDecorator(policy)(next).Invoke("service", "create", nil, newTestRetryExecutionContext(context.Background(), http.MethodPost))
+	if result.IsSuccess() || next.attempts != 1 {
+		t.Errorf("expected POST not to be retried, got %d attempts", next.attempts)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	next = &testRetryAPIProvider{statuses: []int{503, 200}}
+	slowPolicy := getTestRetryPolicy(retryStrategyUniform, 10000, 10000)
+	result = newRetryDecorator(slowPolicy)(next).Invoke("service", "get", nil, newTestRetryExecutionContext(ctx, http.MethodGet))
+	if result.IsSuccess() || next.attempts != 1 {
+		t.Errorf("expected canceled operation not to be retried, got %d attempts", next.attempts)
+	}
+}
@@ -1563,7 +1563,7 @@ func nsxtPolicySegmentDelete(d *schema.ResourceData, m interface{}, isFixed bool
 		Delay:      1 * time.Second,
 	}
 	if !isFixed {
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(getProviderContext(m))
 		if err != nil {
 			return fmt.Errorf("Failed to get port information for segment %s: %v", id, err)
 		}
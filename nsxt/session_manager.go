@@ -0,0 +1,247 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+const (
+	sessionCreatePath = "/api/session/create"
+	sessionXSRFHeader = "X-XSRF-TOKEN"
+	// NSX error code reported for invalid or expired credentials
+	sessionInvalidErrorCode = 403
+)
+
+var sessionCookieRegexp = regexp.MustCompile(`JSESSIONID=[^;]*`)
+
+// sessionManager owns NSX session (JSESSIONID cookie and XSRF token) that is
+// shared between Policy and MP clients. The session is created on first use and
+// re-created once when NSX reports it as expired.
+type sessionManager struct {
+	mu         sync.Mutex
+	host       string
+	hostname   string
+	username   string
+	password   string
+	remoteAuth bool
+	client     *http.Client
+	cookie     string
+	xsrf       string
+	// generation is incremented on every session create attempt, and allows
+	// concurrent requests that hit expired session to re-authenticate only once
+	generation uint64
+}
+
+func newSessionManager(host string, username string, password string, remoteAuth bool, client *http.Client) *sessionManager {
+	if !strings.HasPrefix(host, "https://") {
+		host = fmt.Sprintf("https://%s", host)
+	}
+	hostname := strings.TrimPrefix(host, "https://")
+	hostname = strings.Split(hostname, "/")[0]
+	return &sessionManager{
+		host:       strings.TrimSuffix(host, "/"),
+		hostname:   hostname,
+		username:   username,
+		password:   password,
+		remoteAuth: remoteAuth,
+		client:     client,
+	}
+}
+
+// create issues session/create API, the caller is expected to hold the lock
+func (s *sessionManager) create() error {
+	s.generation++
+	s.cookie = ""
+	s.xsrf = ""
+
+	form := url.Values{}
+	form.Set("j_username", s.username)
+	form.Set("j_password", s.password)
+	req, err := http.NewRequest(http.MethodPost, s.host+sessionCreatePath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("Failed to create session: %v", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if s.remoteAuth {
+		req.Header.Set("Authorization", getRemoteAuthHeader(s.username, s.password))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Failed to create session: %v", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Failed to create session: status code %d", resp.StatusCode)
+	}
+
+	for _, setCookie := range resp.Header.Values("Set-Cookie") {
+		if cookie := sessionCookieRegexp.FindString(setCookie); cookie != "" {
+			s.cookie = cookie
+			break
+		}
+	}
+	if s.cookie == "" {
+		return fmt.Errorf("Failed to create session: session cookie not found in response")
+	}
+	s.xsrf = resp.Header.Get(sessionXSRFHeader)
+	log.Printf("[INFO] NSX session created")
+	return nil
+}
+
+// session returns current session headers, creating the session if needed
+func (s *sessionManager) session() (string, string, uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.generation == 0 {
+		// Failure is not fatal here - the request will fall back to
+		// credentials configured on the client
+		if err := s.create(); err != nil {
+			log.Printf("[WARNING] %v", err)
+		}
+	}
+	return s.cookie, s.xsrf, s.generation
+}
+
+// renew re-creates the session, unless it was already re-created by a
+// concurrent request since the given generation was obtained
+func (s *sessionManager) renew(generation uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.generation != generation {
+		return nil
+	}
+	log.Printf("[INFO] NSX session expired, re-creating")
+	return s.create()
+}
+
+func getRemoteAuthHeader(username string, password string) string {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return fmt.Sprintf("Remote %s", auth)
+}
+
+// sessionTransport injects session headers into every request, and replays the
+// request once with new session if NSX reports the session as expired
+type sessionTransport struct {
+	manager *sessionManager
+	next    http.RoundTripper
+}
+
+func newSessionTransport(manager *sessionManager, next http.RoundTripper) *sessionTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &sessionTransport{
+		manager: manager,
+		next:    next,
+	}
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Session is not applicable to other endpoints, such as new cluster nodes
+	if req.URL.Host != t.manager.hostname || strings.HasSuffix(req.URL.Path, sessionCreatePath) {
+		return t.next.RoundTrip(req)
+	}
+
+	getBody, err := getRequestBodyFunc(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cookie, xsrf, generation := t.manager.session()
+	resp, err := t.next.RoundTrip(newSessionRequest(req, getBody, cookie, xsrf))
+	if err != nil || !isSessionExpiredResponse(resp) {
+		return resp, err
+	}
+
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if err := t.manager.renew(generation); err != nil {
+		return nil, err
+	}
+
+	cookie, xsrf, _ = t.manager.session()
+	return t.next.RoundTrip(newSessionRequest(req, getBody, cookie, xsrf))
+}
+
+// getRequestBodyFunc returns a function that provides fresh copy of request
+// body, so that the request can be replayed
+func getRequestBodyFunc(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}, nil
+}
+
+// cloneRequestWithBody returns copy of the request with fresh body
+func cloneRequestWithBody(req *http.Request, getBody func() (io.ReadCloser, error)) *http.Request {
+	newReq := req.Clone(req.Context())
+	if getBody != nil {
+		// error is not expected for in-memory bodies
+		newReq.Body, _ = getBody()
+		newReq.GetBody = getBody
+	}
+	return newReq
+}
+
+func newSessionRequest(req *http.Request, getBody func() (io.ReadCloser, error), cookie string, xsrf string) *http.Request {
+	newReq := cloneRequestWithBody(req, getBody)
+	if cookie != "" {
+		newReq.Header.Set("Cookie", cookie)
+		newReq.Header.Set(sessionXSRFHeader, xsrf)
+	}
+	return newReq
+}
+
+func isSessionExpiredResponse(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	// 403 is also returned for insufficient permissions, which should not
+	// trigger re-authentication
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	var apiError struct {
+		ErrorCode int `json:"error_code"`
+	}
+	if json.Unmarshal(body, &apiError) != nil {
+		return false
+	}
+	return apiError.ErrorCode == sessionInvalidErrorCode
+}
@@ -0,0 +1,137 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"crypto/tls"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func newTestSessionClient(srv *simulator.Server, password string) (*http.Client, *sessionManager) {
+	tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	manager := newSessionManager(srv.Host(), srv.Username, password, false, &http.Client{Transport: tr})
+	return &http.Client{Transport: newSessionTransport(manager, tr)}, manager
+}
+
+func testSessionRequest(t *testing.T, client *http.Client, method string, url string, body string) int {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode
+}
+
+func TestSessionManagerRenew(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	client, manager := newTestSessionClient(srv, srv.Password)
+
+	url := srv.URL() + "/policy/api/v1/infra/domains/default"
+	if status := testSessionRequest(t, client, http.MethodGet, url, ""); status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", status)
+	}
+	if manager.generation != 1 {
+		t.Fatalf("expected single session create, got %d", manager.generation)
+	}
+
+	// Request with body should be replayed with new session
+	srv.ExpireSessions()
+	groupURL := srv.URL() + "/policy/api/v1/infra/domains/default/groups/test"
+	body := `{"display_name": "test", "resource_type": "Group"}`
+	if status := testSessionRequest(t, client, http.MethodPatch, groupURL, body); status != http.StatusOK {
+		t.Fatalf("expected status 200 after session expiry, got %d", status)
+	}
+	if manager.generation != 2 {
+		t.Fatalf("expected session to be re-created once, got %d creates", manager.generation)
+	}
+	group, ok := srv.Get("/infra/domains/default/groups/test")
+	if !ok || group["display_name"] != "test" {
+		t.Errorf("request body was not replayed, got %v", group)
+	}
+}
+
+func TestSessionManagerConcurrentRenew(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	client, manager := newTestSessionClient(srv, srv.Password)
+
+	url := srv.URL() + "/policy/api/v1/infra/domains/default"
+	testSessionRequest(t, client, http.MethodGet, url, "")
+	srv.ExpireSessions()
+
+	var wg sync.WaitGroup
+	statuses := make([]int, 10)
+	for i := range statuses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			statuses[i] = testSessionRequest(t, client, http.MethodGet, url, "")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, status := range statuses {
+		if status != http.StatusOK {
+			t.Errorf("expected status 200, got %d", status)
+		}
+	}
+	if manager.generation != 2 {
+		t.Errorf("expected session to be re-created once, got %d creates", manager.generation)
+	}
+}
+
+func TestSessionManagerInvalidCredentials(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	client, _ := newTestSessionClient(srv, "wrong")
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL()+"/policy/api/v1/infra", nil)
+	resp, err := client.Do(req)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected session create error")
+	}
+	if !strings.Contains(err.Error(), "Failed to create session: status code 403") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestIsSessionExpiredResponse(t *testing.T) {
+	testCases := []struct {
+		status   int
+		body     string
+		expected bool
+	}{
+		{http.StatusUnauthorized, "", true},
+		{http.StatusForbidden, `{"error_code": 403, "error_message": "The credentials were incorrect"}`, true},
+		{http.StatusForbidden, `{"error_code": 401, "error_message": "The user does not have permission"}`, false},
+		{http.StatusForbidden, "not json", false},
+		{http.StatusNotFound, "", false},
+	}
+
+	for _, tc := range testCases {
+		resp := &http.Response{StatusCode: tc.status, Body: io.NopCloser(strings.NewReader(tc.body))}
+		if isSessionExpiredResponse(resp) != tc.expected {
+			t.Errorf("status %d body %s: expected %v", tc.status, tc.body, tc.expected)
+		}
+		// body should remain readable for the caller
+		body, _ := io.ReadAll(resp.Body)
+		if string(body) != tc.body {
+			t.Errorf("response body was consumed")
+		}
+	}
+}
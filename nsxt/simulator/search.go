@@ -0,0 +1,248 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// searchTerm is a node of parsed search query. Leaf terms match field against
+// value, optionally with trailing (prefix) or leading (suffix) wildcard, other
+// terms combine their operands with AND or OR
+type searchTerm struct {
+	field    string
+	value    string
+	prefix   bool
+	suffix   bool
+	operator string
+	operands []*searchTerm
+}
+
+// splitTopLevel splits the expression by operator, ignoring operators
+// within parenthesis or escaped with backslash
+func splitTopLevel(expr string, operator string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	sep := " " + operator + " "
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+			continue
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 && strings.HasPrefix(expr[i:], sep) {
+			parts = append(parts, expr[start:i])
+			start = i + len(sep)
+			i += len(sep) - 1
+		}
+	}
+	return append(parts, expr[start:])
+}
+
+func unescape(value string) (string, bool, bool) {
+	var b strings.Builder
+	prefix := false
+	suffix := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c == '\\' && i+1 < len(value) {
+			i++
+			b.WriteByte(value[i])
+			continue
+		}
+		if c == '*' && i == len(value)-1 {
+			prefix = true
+			continue
+		}
+		if c == '*' && i == 0 {
+			suffix = true
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return strings.Trim(b.String(), "\""), prefix, suffix
+}
+
+func isWrapped(expr string) bool {
+	if !strings.HasPrefix(expr, "(") || !strings.HasSuffix(expr, ")") {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			i++
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 && i != len(expr)-1 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func parseSearchQuery(query string) (*searchTerm, error) {
+	return parseExpression(strings.TrimSpace(query), "")
+}
+
+func parseExpression(expr string, field string) (*searchTerm, error) {
+	expr = strings.TrimSpace(expr)
+	for isWrapped(expr) {
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	if expr == "" {
+		return nil, fmt.Errorf("empty search expression")
+	}
+	for _, operator := range []string{"OR", "AND"} {
+		parts := splitTopLevel(expr, operator)
+		if len(parts) == 1 {
+			continue
+		}
+		term := &searchTerm{operator: operator}
+		for _, part := range parts {
+			operand, err := parseExpression(part, field)
+			if err != nil {
+				return nil, err
+			}
+			term.operands = append(term.operands, operand)
+		}
+		return term, nil
+	}
+
+	if field == "" {
+		idx := -1
+		for i := 0; i < len(expr); i++ {
+			if expr[i] == '\\' {
+				i++
+				continue
+			}
+			if expr[i] == ':' {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			// free text search on display name
+			value, prefix, suffix := unescape(expr)
+			return &searchTerm{field: "display_name", value: value, prefix: prefix, suffix: suffix}, nil
+		}
+		value := strings.TrimSpace(expr[idx+1:])
+		if strings.HasPrefix(value, "(") {
+			return parseExpression(value, expr[:idx])
+		}
+		field = expr[:idx]
+		expr = value
+	}
+
+	value, prefix, suffix := unescape(expr)
+	return &searchTerm{field: field, value: value, prefix: prefix, suffix: suffix}, nil
+}
+
+// fieldValues collects string representation of all values for dot-separated
+// field in the object, descending into lists
+func fieldValues(value interface{}, field []string) []string {
+	if len(field) == 0 {
+		switch v := value.(type) {
+		case nil:
+			return nil
+		case []interface{}:
+			var values []string
+			for _, item := range v {
+				values = append(values, fieldValues(item, nil)...)
+			}
+			return values
+		default:
+			return []string{fmt.Sprintf("%v", v)}
+		}
+	}
+	switch v := value.(type) {
+	case object:
+		return fieldValues(map[string]interface{}(v), field)
+	case map[string]interface{}:
+		return fieldValues(v[field[0]], field[1:])
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			values = append(values, fieldValues(item, field)...)
+		}
+		return values
+	}
+	return nil
+}
+
+func (t *searchTerm) matches(obj object) bool {
+	switch t.operator {
+	case "AND":
+		for _, operand := range t.operands {
+			if !operand.matches(obj) {
+				return false
+			}
+		}
+		return true
+	case "OR":
+		for _, operand := range t.operands {
+			if operand.matches(obj) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if t.field == "_exists_" {
+		return len(fieldValues(obj, strings.Split(t.value, "."))) > 0
+	}
+	for _, value := range fieldValues(obj, strings.Split(t.field, ".")) {
+		if t.matchesValue(value) {
+			return true
+		}
+	}
+	if (t.prefix || t.suffix) && t.value == "" {
+		return true
+	}
+	return false
+}
+
+func (t *searchTerm) matchesValue(value string) bool {
+	value = strings.ToLower(value)
+	expected := strings.ToLower(t.value)
+	switch {
+	case t.prefix && t.suffix:
+		return strings.Contains(value, expected)
+	case t.prefix:
+		return strings.HasPrefix(value, expected)
+	case t.suffix:
+		return strings.HasSuffix(value, expected)
+	}
+	return value == expected
+}
+
+func (s *store) search(query string) ([]interface{}, error) {
+	term, err := parseSearchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for path, obj := range s.objects {
+		if term.matches(obj) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	results := make([]interface{}, 0, len(paths))
+	for _, path := range paths {
+		obj, _ := s.get(path)
+		results = append(results, obj)
+	}
+	return results, nil
+}
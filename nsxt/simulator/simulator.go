@@ -0,0 +1,523 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+// Package simulator implements an in-process stand-in for NSX Policy API,
+// backed by in-memory object tree. It is meant for running provider
+// acceptance tests without a live NSX manager.
+package simulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+const (
+	policyAPIPrefix  = "/policy/api/v1"
+	DefaultUsername  = "admin"
+	DefaultPassword  = "Simulat0r!Passw0rd"
+	DefaultVersion   = "4.1.2.0.0"
+	sessionCookie    = "JSESSIONID"
+	xsrfHeader       = "X-XSRF-TOKEN"
+	defaultPageSize  = 1000
+	realizedEntities = "/realized-state/realized-entities"
+	realizedStatus   = "/realized-state/status"
+)
+
+// Server is NSX API simulator listening on local TLS endpoint
+type Server struct {
+	// NSX version reported by node version API
+	Version  string
+	Username string
+	Password string
+
+	mu       sync.Mutex
+	store    *store
+	licenses []interface{}
+	sessions map[string]string
+	server   *httptest.Server
+
+	// Manager API objects, served by Manager search API only
+	managerObjects []object
+	// Manager API singleton configurations, keyed by API path
+	managerConfigs map[string]object
+}
+
+// Manager API singleton configurations served by the simulator. Value
+// indicates whether configuration can be updated via API.
+var managerConfigPaths = map[string]bool{
+	"/api/v1/cluster/backups/config":  true,
+	"/api/v1/cluster/backups/history": false,
+}
+
+// NewServer starts the simulator with default credentials and default
+// objects (domain, site and enforcement point) present
+func NewServer() *Server {
+	s := &Server{
+		Version:  DefaultVersion,
+		Username: DefaultUsername,
+		Password: DefaultPassword,
+		store:    newStore(DefaultUsername),
+		sessions: make(map[string]string),
+
+		managerConfigs: make(map[string]object),
+	}
+	s.seedDefaults()
+	s.server = httptest.NewTLSServer(s)
+	return s
+}
+
+// URL returns base URL of the simulator, including scheme
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Host returns host:port of the simulator, in the format expected by provider
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.server.URL, "https://")
+}
+
+// Close shuts down the simulator
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Seed creates or replaces an object at given policy path, regardless of
+// parent presence. Useful for objects that are not managed by terraform,
+// such as transport zones or edge clusters. System attributes such as
+// _system_owned are taken from attrs when specified.
+func (s *Server) Seed(path string, attrs map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body := make(object, len(attrs))
+	for k, v := range attrs {
+		body[k] = v
+	}
+	parent := parentPath(path)
+	if _, ok := s.store.objects[parent]; !ok && !s.store.isImplicitContainer(parent) {
+		s.store.objects[parent] = object{
+			"id":            splitPath(parent)[len(splitPath(parent))-1],
+			"path":          parent,
+			"resource_type": inferResourceType(parent),
+		}
+	}
+	s.store.delete(path)
+	if _, err := s.store.put(path, body, true, false); err != nil {
+		log.Printf("[ERROR] Failed to seed %s: %v", path, err)
+		return
+	}
+	// allow seeding objects owned by system
+	for _, attr := range []string{"_create_user", "_system_owned", "_protection"} {
+		if v, ok := attrs[attr]; ok {
+			s.store.objects[path][attr] = v
+		}
+	}
+}
+
+// SeedManager adds an object to Manager API inventory. Manager objects are
+// not managed by the simulator and are only returned by Manager search API.
+func (s *Server) SeedManager(attrs map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := make(object, len(attrs))
+	for k, v := range attrs {
+		obj[k] = v
+	}
+	s.managerObjects = append(s.managerObjects, obj)
+}
+
+// SeedManagerConfig sets Manager API singleton configuration on given API
+// path, such as /api/v1/cluster/backups/history
+func (s *Server) SeedManagerConfig(path string, attrs map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj := make(object, len(attrs))
+	for k, v := range attrs {
+		obj[k] = v
+	}
+	s.managerConfigs[path] = obj
+}
+
+// GetManagerConfig returns Manager API singleton configuration as stored,
+// including secrets that are not returned by the API
+func (s *Server) GetManagerConfig(path string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.managerConfigs[path]
+	return obj, ok
+}
+
+// Get returns a copy of the object stored on given policy path
+func (s *Server) Get(path string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.store.get(path)
+	return obj, ok
+}
+
+// ExpireSessions invalidates all sessions created via session API
+func (s *Server) ExpireSessions() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sessions = make(map[string]string)
+}
+
+func (s *Server) seedDefaults() {
+	defaults := []string{
+		"/infra/domains/default",
+		"/infra/sites/default",
+		"/infra/sites/default/enforcement-points/default",
+	}
+	for _, path := range defaults {
+		if _, err := s.store.put(path, object{}, true, false); err != nil {
+			log.Printf("[ERROR] Failed to seed %s: %v", path, err)
+			continue
+		}
+		// default objects are created by NSX
+		s.store.objects[path]["_create_user"] = "system"
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeError(w http.ResponseWriter, err *apiError) {
+	writeJSON(w, err.status, map[string]interface{}{
+		"httpStatus":    http.StatusText(err.status),
+		"error_code":    err.code,
+		"module_name":   "simulator",
+		"error_message": err.message,
+	})
+}
+
+func decodeBody(r *http.Request) (object, *apiError) {
+	body := make(object)
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, newAPIError(http.StatusBadRequest, 255, "Failed to parse request body: %v", err)
+	}
+	return body, nil
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	if username, password, ok := r.BasicAuth(); ok {
+		return username == s.Username && password == s.Password
+	}
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return false
+	}
+	xsrf, ok := s.sessions[cookie.Value]
+	return ok && xsrf == r.Header.Get(xsrfHeader)
+}
+
+func (s *Server) createSession(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, newAPIError(http.StatusBadRequest, 255, "Failed to parse form: %v", err))
+		return
+	}
+	if r.PostForm.Get("j_username") != s.Username || r.PostForm.Get("j_password") != s.Password {
+		writeError(w, newAPIError(http.StatusForbidden, 403, "The credentials were incorrect or the account specified has been locked."))
+		return
+	}
+	session := uuid.New().String()
+	xsrf := uuid.New().String()
+	s.sessions[session] = xsrf
+	w.Header().Set("Set-Cookie", fmt.Sprintf("%s=%s; Path=/; Secure; HttpOnly", sessionCookie, session))
+	w.Header().Set(xsrfHeader, xsrf)
+	w.WriteHeader(http.StatusOK)
+}
+
+// ServeHTTP dispatches NSX API request
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log.Printf("[DEBUG] NSX simulator: %s %s", r.Method, r.URL.RequestURI())
+	if r.URL.Path == "/api/session/create" && r.Method == http.MethodPost {
+		s.createSession(w, r)
+		return
+	}
+	if !s.authenticated(r) {
+		writeError(w, newAPIError(http.StatusUnauthorized, 403, "The credentials were incorrect or the account specified has been locked."))
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/api/v1/node/version":
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"node_version":    s.Version,
+			"product_version": s.Version,
+		})
+	case r.URL.Path == "/api/v1/licenses":
+		s.serveLicenses(w, r)
+	case r.URL.Path == "/api/v1/search/query":
+		s.serveManagerSearch(w, r)
+	case isManagerConfigPath(r.URL.Path):
+		s.serveManagerConfig(w, r)
+	case r.URL.Path == policyAPIPrefix+"/search/query" || r.URL.Path == policyAPIPrefix+"/search":
+		s.serveSearch(w, r)
+	case r.URL.Path == policyAPIPrefix+"/org-root":
+		s.serveHierarchy(w, r, "")
+	case strings.HasPrefix(r.URL.Path, policyAPIPrefix+"/"):
+		s.servePolicy(w, r, strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, policyAPIPrefix), "/"))
+	default:
+		writeError(w, notFoundError(r.URL.Path))
+	}
+}
+
+func (s *Server) serveLicenses(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"results":      s.licenses,
+			"result_count": len(s.licenses),
+		})
+	case http.MethodPost:
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.licenses = append(s.licenses, map[string]interface{}(body))
+		writeJSON(w, http.StatusCreated, body)
+	default:
+		writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported", r.Method))
+	}
+}
+
+func pageResults(w http.ResponseWriter, r *http.Request, results []interface{}) {
+	offset := 0
+	if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+		offset, _ = strconv.Atoi(cursor)
+	}
+	pageSize := defaultPageSize
+	if size := r.URL.Query().Get("page_size"); size != "" {
+		pageSize, _ = strconv.Atoi(size)
+	}
+	if offset > len(results) {
+		offset = len(results)
+	}
+	end := offset + pageSize
+	if end > len(results) {
+		end = len(results)
+	}
+	body := map[string]interface{}{
+		"results":      results[offset:end],
+		"result_count": len(results),
+	}
+	if end < len(results) {
+		body["cursor"] = strconv.Itoa(end)
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+func (s *Server) serveSearch(w http.ResponseWriter, r *http.Request) {
+	results, err := s.store.search(r.URL.Query().Get("query"))
+	if err != nil {
+		writeError(w, newAPIError(http.StatusBadRequest, 60506, "Invalid search query: %v", err))
+		return
+	}
+	pageResults(w, r, results)
+}
+
+func (s *Server) serveManagerSearch(w http.ResponseWriter, r *http.Request) {
+	term, err := parseSearchQuery(r.URL.Query().Get("query"))
+	if err != nil {
+		writeError(w, newAPIError(http.StatusBadRequest, 60506, "Invalid search query: %v", err))
+		return
+	}
+	results := make([]interface{}, 0)
+	for _, obj := range s.managerObjects {
+		if term.matches(obj) {
+			results = append(results, obj)
+		}
+	}
+	pageResults(w, r, results)
+}
+
+func isManagerConfigPath(path string) bool {
+	_, ok := managerConfigPaths[path]
+	return ok
+}
+
+// withoutSecrets returns copy of the value with passwords and passphrases
+// removed, since NSX never returns them
+func withoutSecrets(value interface{}) interface{} {
+	switch v := value.(type) {
+	case object:
+		return withoutSecrets(map[string]interface{}(v))
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			if key == "password" || key == "passphrase" {
+				continue
+			}
+			copied[key] = withoutSecrets(item)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, item := range v {
+			copied[i] = withoutSecrets(item)
+		}
+		return copied
+	}
+	return value
+}
+
+func (s *Server) serveManagerConfig(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		obj, ok := s.managerConfigs[r.URL.Path]
+		if !ok {
+			obj = object{}
+		}
+		writeJSON(w, http.StatusOK, withoutSecrets(obj))
+	case http.MethodPut:
+		if !managerConfigPaths[r.URL.Path] {
+			writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported for %s", r.Method, r.URL.Path))
+			return
+		}
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.managerConfigs[r.URL.Path] = body
+		writeJSON(w, http.StatusOK, withoutSecrets(body))
+	default:
+		writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported", r.Method))
+	}
+}
+
+func (s *Server) serveRealizedEntities(w http.ResponseWriter, r *http.Request) {
+	intentPath := r.URL.Query().Get("intent_path")
+	results := make([]interface{}, 0)
+	if obj, ok := s.store.objects[intentPath]; ok {
+		id, _ := obj["id"].(string)
+		rType, _ := obj["resource_type"].(string)
+		results = append(results, map[string]interface{}{
+			"id":                              id,
+			"display_name":                    id,
+			"resource_type":                   "Realized" + rType,
+			"path":                            "/infra/realized-state/enforcement-points/default/" + id,
+			"intent_paths":                    []string{intentPath},
+			"state":                           "REALIZED",
+			"runtime_status":                  "UNINITIALIZED",
+			"realization_specific_identifier": id,
+			"_revision":                       obj["_revision"],
+		})
+	}
+	pageResults(w, r, results)
+}
+
+func (s *Server) serveRealizedStatus(w http.ResponseWriter, r *http.Request) {
+	intentPath := r.URL.Query().Get("intent_path")
+	if _, ok := s.store.objects[intentPath]; !ok {
+		writeError(w, notFoundError(intentPath))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"consolidated_status": map[string]interface{}{"consolidated_status": "SUCCESS"},
+		"intent_path":         intentPath,
+		"publish_status":      "SUCCESS",
+	})
+}
+
+func (s *Server) serveHierarchy(w http.ResponseWriter, r *http.Request, root string) {
+	if r.Method != http.MethodPatch {
+		writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported for %s", r.Method, r.URL.Path))
+		return
+	}
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	enforceRevision := r.URL.Query().Get("enforce_revision_check") == "true"
+	children, _ := body["children"].([]interface{})
+	snapshot := s.store.snapshot()
+	if err := s.store.patchHierarchy(root, children, enforceRevision); err != nil {
+		// hierarchical patch is transactional
+		s.store.objects = snapshot
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, nil)
+}
+
+func (s *Server) servePolicy(w http.ResponseWriter, r *http.Request, path string) {
+	if strings.HasSuffix(path, realizedEntities) && r.Method == http.MethodGet {
+		s.serveRealizedEntities(w, r)
+		return
+	}
+	if strings.HasSuffix(path, realizedStatus) && r.Method == http.MethodGet {
+		s.serveRealizedStatus(w, r)
+		return
+	}
+	if (path == "/infra" || strings.HasSuffix(path, "/infra")) && r.Method == http.MethodPatch {
+		s.serveHierarchy(w, r, path)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if obj, ok := s.store.get(path); ok {
+			writeJSON(w, http.StatusOK, obj)
+			return
+		}
+		if isCollectionPath(path) {
+			parent := parentPath(path + "/x")
+			if _, ok := s.store.objects[parent]; ok || s.store.isImplicitContainer(parent) {
+				pageResults(w, r, s.store.list(path))
+				return
+			}
+		}
+		writeError(w, notFoundError(path))
+	case http.MethodPatch, http.MethodPut:
+		if isCollectionPath(path) {
+			writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported for %s", r.Method, path))
+			return
+		}
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		replace := r.Method == http.MethodPut
+		obj, err := s.store.put(path, body, replace, true)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		// Some PATCH APIs return the updated object, those that do not
+		// ignore the response body
+		obj, _ = s.store.get(path)
+		writeJSON(w, http.StatusOK, obj)
+	case http.MethodDelete:
+		s.store.delete(path)
+		writeJSON(w, http.StatusOK, nil)
+	default:
+		writeError(w, newAPIError(http.StatusMethodNotAllowed, 255, "Method %s is not supported", r.Method))
+	}
+}
@@ -0,0 +1,231 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/vmware/vsphere-automation-sdk-go/lib/vapi/std/errors"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/core"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/data"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/security"
+	nsx_policy "github.com/vmware/vsphere-automation-sdk-go/services/nsxt"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/domains"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra/realized_state"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/search"
+)
+
+func newTestConnector(srv *Server, password string) client.Connector {
+	securityCtx := core.NewSecurityContextImpl()
+	securityCtx.SetProperty(security.AUTHENTICATION_SCHEME_ID, security.USER_PASSWORD_SCHEME_ID)
+	securityCtx.SetProperty(security.USER_KEY, srv.Username)
+	securityCtx.SetProperty(security.PASSWORD_KEY, password)
+
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	return client.NewConnector(srv.URL(), client.UsingRest(nil), client.WithHttpClient(httpClient), client.WithSecurityContext(securityCtx))
+}
+
+func newTestServer(t *testing.T) (*Server, client.Connector) {
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	return srv, newTestConnector(srv, srv.Password)
+}
+
+func TestSimulatorAuthentication(t *testing.T) {
+	srv, _ := newTestServer(t)
+	connector := newTestConnector(srv, "wrong")
+
+	_, err := infra.NewSegmentsClient(connector).Get("any")
+	if _, ok := err.(errors.Unauthenticated); !ok {
+		t.Fatalf("expected Unauthenticated error, got %v", err)
+	}
+}
+
+func TestSimulatorCrud(t *testing.T) {
+	_, connector := newTestServer(t)
+	client := infra.NewSegmentsClient(connector)
+
+	displayName := "segment1"
+	description := "test segment"
+	err := client.Patch("seg1", model.Segment{DisplayName: &displayName})
+	if err != nil {
+		t.Fatalf("failed to create segment: %v", err)
+	}
+
+	obj, err := client.Get("seg1")
+	if err != nil {
+		t.Fatalf("failed to read segment: %v", err)
+	}
+	if *obj.Path != "/infra/segments/seg1" || *obj.DisplayName != displayName || *obj.Revision != 0 {
+		t.Fatalf("unexpected segment %s %s %d", *obj.Path, *obj.DisplayName, *obj.Revision)
+	}
+
+	obj.Description = &description
+	updated, err := client.Update("seg1", obj)
+	if err != nil {
+		t.Fatalf("failed to update segment: %v", err)
+	}
+	if *updated.Revision != 1 || *updated.Description != description {
+		t.Fatalf("unexpected revision %d after update", *updated.Revision)
+	}
+
+	// stale revision should be rejected
+	_, err = client.Update("seg1", obj)
+	if _, ok := err.(errors.InvalidRequest); !ok {
+		t.Fatalf("expected precondition failure, got %v", err)
+	}
+
+	list, err := client.List(nil, nil, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to list segments: %v", err)
+	}
+	if len(list.Results) != 1 {
+		t.Fatalf("expected 1 segment, got %d", len(list.Results))
+	}
+
+	if err := client.Delete("seg1"); err != nil {
+		t.Fatalf("failed to delete segment: %v", err)
+	}
+	_, err = client.Get("seg1")
+	if _, ok := err.(errors.NotFound); !ok {
+		t.Fatalf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestSimulatorMissingParent(t *testing.T) {
+	_, connector := newTestServer(t)
+
+	err := domains.NewGroupsClient(connector).Patch("nonexistent", "group1", model.Group{})
+	if _, ok := err.(errors.NotFound); !ok {
+		t.Fatalf("expected NotFound error, got %v", err)
+	}
+}
+
+func TestSimulatorHierarchicalPatch(t *testing.T) {
+	srv, connector := newTestServer(t)
+
+	converter := bindings.NewTypeConverter()
+	policyID := "policy1"
+	ruleID := "rule1"
+	action := model.Rule_ACTION_ALLOW
+	rule := model.Rule{Id: &ruleID, Action: &action, ResourceType: strPtr("Rule")}
+	policy := model.SecurityPolicy{Id: &policyID, Rules: []model.Rule{rule}, ResourceType: strPtr("SecurityPolicy")}
+	childPolicy := model.ChildSecurityPolicy{SecurityPolicy: &policy, ResourceType: "ChildSecurityPolicy"}
+	dataValue, errs := converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+	if errs != nil {
+		t.Fatalf("failed to convert policy: %v", errs[0])
+	}
+
+	domainID := "default"
+	childDomain := model.ChildResourceReference{
+		Id:           &domainID,
+		ResourceType: "ChildResourceReference",
+		TargetType:   strPtr("Domain"),
+		Children:     []*data.StructValue{dataValue.(*data.StructValue)},
+	}
+	domainValue, errs := converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+	if errs != nil {
+		t.Fatalf("failed to convert domain: %v", errs[0])
+	}
+
+	infraObj := model.Infra{
+		Children:     []*data.StructValue{domainValue.(*data.StructValue)},
+		ResourceType: strPtr("Infra"),
+	}
+	if err := nsx_policy.NewInfraClient(connector).Patch(infraObj, nil); err != nil {
+		t.Fatalf("failed to patch infra: %v", err)
+	}
+
+	if _, ok := srv.Get("/infra/domains/default/security-policies/policy1/rules/rule1"); !ok {
+		t.Fatalf("rule was not created")
+	}
+	obj, err := domains.NewSecurityPoliciesClient(connector).Get(domainID, policyID)
+	if err != nil {
+		t.Fatalf("failed to read policy: %v", err)
+	}
+	if len(obj.Rules) != 1 || *obj.Rules[0].Id != ruleID {
+		t.Fatalf("expected rule to be returned inline with the policy")
+	}
+
+	// deleting the policy via hierarchical API removes its rules
+	markedForDelete := true
+	childPolicy.MarkedForDelete = &markedForDelete
+	dataValue, _ = converter.ConvertToVapi(childPolicy, model.ChildSecurityPolicyBindingType())
+	childDomain.Children = []*data.StructValue{dataValue.(*data.StructValue)}
+	domainValue, _ = converter.ConvertToVapi(childDomain, model.ChildResourceReferenceBindingType())
+	infraObj.Children = []*data.StructValue{domainValue.(*data.StructValue)}
+	if err := nsx_policy.NewInfraClient(connector).Patch(infraObj, nil); err != nil {
+		t.Fatalf("failed to patch infra: %v", err)
+	}
+	if _, ok := srv.Get("/infra/domains/default/security-policies/policy1/rules/rule1"); ok {
+		t.Fatalf("rule was not deleted")
+	}
+}
+
+func TestSimulatorSearch(t *testing.T) {
+	srv, connector := newTestServer(t)
+	srv.Seed("/infra/sites/default/enforcement-points/default/transport-zones/tz1", map[string]interface{}{
+		"display_name":  "overlay-tz",
+		"resource_type": "PolicyTransportZone",
+	})
+	srv.Seed("/infra/sites/default/enforcement-points/default/transport-zones/tz2", map[string]interface{}{
+		"display_name":  "vlan-tz",
+		"resource_type": "PolicyTransportZone",
+	})
+
+	query := "resource_type:PolicyTransportZone AND display_name:overlay*"
+	result, err := search.NewQueryClient(connector).List(query, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if len(result.Results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(result.Results))
+	}
+
+	query = "resource_type:PolicyTransportZone AND display_name:*\\-tz"
+	result, err = search.NewQueryClient(connector).List(query, nil, nil, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if len(result.Results) != 2 {
+		t.Fatalf("expected 2 results for suffix search, got %d", len(result.Results))
+	}
+
+	pageSize := int64(1)
+	query = "resource_type:PolicyTransportZone"
+	result, err = search.NewQueryClient(connector).List(query, nil, nil, &pageSize, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to search: %v", err)
+	}
+	if len(result.Results) != 1 || result.Cursor == nil || *result.ResultCount != 2 {
+		t.Fatalf("expected paged result")
+	}
+}
+
+func TestSimulatorRealizedEntities(t *testing.T) {
+	_, connector := newTestServer(t)
+	displayName := "segment1"
+	if err := infra.NewSegmentsClient(connector).Patch("seg1", model.Segment{DisplayName: &displayName}); err != nil {
+		t.Fatalf("failed to create segment: %v", err)
+	}
+
+	client := realized_state.NewRealizedEntitiesClient(connector)
+	result, err := client.List("/infra/segments/seg1", nil)
+	if err != nil {
+		t.Fatalf("failed to read realized state: %v", err)
+	}
+	if len(result.Results) != 1 || *result.Results[0].State != "REALIZED" {
+		t.Fatalf("expected realized entity")
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
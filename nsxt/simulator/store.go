@@ -0,0 +1,560 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package simulator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Policy path segments that are not followed by an object ID
+var singletonSegments = map[string]string{
+	"infra":         "Infra",
+	"bgp":           "BgpRoutingConfig",
+	"ospf":          "OspfRoutingConfig",
+	"dns-forwarder": "PolicyDnsForwarder",
+	"state":         "SegmentConfigurationState",
+	"multicast":     "PolicyMulticastConfig",
+	// runtime status and statistics of policy objects
+	"detailed-status": "AggregatePolicyRuntimeInfo",
+	"statistics":      "AggregatePolicyRuntimeInfo",
+}
+
+// Resource types for well-known policy collections. Types for collections
+// not listed here are derived from collection name.
+var collectionResourceTypes = map[string]string{
+	"orgs":                                   "Org",
+	"projects":                               "Project",
+	"vpcs":                                   "Vpc",
+	"domains":                                "Domain",
+	"groups":                                 "Group",
+	"security-policies":                      "SecurityPolicy",
+	"gateway-policies":                       "GatewayPolicy",
+	"intrusion-service-policies":             "IdsSecurityPolicy",
+	"rules":                                  "Rule",
+	"services":                               "Service",
+	"segments":                               "Segment",
+	"ports":                                  "SegmentPort",
+	"tier-0s":                                "Tier0",
+	"tier-1s":                                "Tier1",
+	"locale-services":                        "LocaleServices",
+	"static-routes":                          "StaticRoutes",
+	"nat":                                    "PolicyNat",
+	"nat-rules":                              "PolicyNatRule",
+	"ip-pools":                               "IpAddressPool",
+	"ip-blocks":                              "IpAddressBlock",
+	"ip-allocations":                         "IpAddressAllocation",
+	"context-profiles":                       "PolicyContextProfile",
+	"dhcp-server-configs":                    "DhcpServerConfig",
+	"dhcp-relay-configs":                     "DhcpRelayConfig",
+	"dhcp-static-binding-configs":            "DhcpV4StaticBindingConfig",
+	"dns-forwarder-zones":                    "PolicyDnsForwarderZone",
+	"segment-discovery-profile-binding-maps": "SegmentDiscoveryProfileBindingMap",
+	"segment-qos-profile-binding-maps":       "SegmentQoSProfileBindingMap",
+	"segment-security-profile-binding-maps":  "SegmentSecurityProfileBindingMap",
+	"port-discovery-profile-binding-maps":    "PortDiscoveryProfileBindingMap",
+	"port-qos-profile-binding-maps":          "PortQoSProfileBindingMap",
+	"port-security-profile-binding-maps":     "PortSecurityProfileBindingMap",
+	"mac-discovery-profiles":                 "MacDiscoveryProfile",
+	"ip-discovery-profiles":                  "IPDiscoveryProfile",
+	"qos-profiles":                           "QoSProfile",
+	"spoofguard-profiles":                    "SpoofGuardProfile",
+	"segment-security-profiles":              "SegmentSecurityProfile",
+	"gateway-qos-profiles":                   "GatewayQosProfile",
+	"ipv6-ndra-profiles":                     "Ipv6NdraProfile",
+	"ipv6-dad-profiles":                      "Ipv6DadProfile",
+	"sites":                                  "Site",
+	"enforcement-points":                     "EnforcementPoint",
+	"transport-zones":                        "PolicyTransportZone",
+	"edge-clusters":                          "PolicyEdgeCluster",
+	"edge-nodes":                             "PolicyEdgeNode",
+	"prefix-lists":                           "PrefixList",
+	"route-maps":                             "Tier0RouteMap",
+	"community-lists":                        "CommunityList",
+	"neighbors":                              "BgpNeighborConfig",
+	"certificates":                           "TlsCertificate",
+	"cabundles":                              "CaBundle",
+	"crls":                                   "TlsCrl",
+	"firewall-session-timer-profiles":        "PolicyFirewallSessionTimerProfile",
+	"firewall-session-timer-profile-binding-maps": "PolicyFirewallSessionTimerProfileBindingMap",
+	"ipfix-dfw-collector-profiles":                "IPFIXDFWCollectorProfile",
+	"ipfix-dfw-profiles":                          "IPFIXDFWProfile",
+	"shares":                                      "Share",
+	"resources":                                   "SharedResource",
+	"tls-inspection-policies":                     "TlsPolicy",
+}
+
+// Resource types that share collection name with other types
+var resourceTypeCollections = map[string]string{
+	"Tier0Interface":               "interfaces",
+	"Tier1Interface":               "interfaces",
+	"L4PortSetServiceEntry":        "service-entries",
+	"ICMPTypeServiceEntry":         "service-entries",
+	"IGMPTypeServiceEntry":         "service-entries",
+	"IPProtocolServiceEntry":       "service-entries",
+	"EtherTypeServiceEntry":        "service-entries",
+	"ALGTypeServiceEntry":          "service-entries",
+	"NestedServiceServiceEntry":    "service-entries",
+	"DhcpV6StaticBindingConfig":    "dhcp-static-binding-configs",
+	"IpAddressPoolBlockSubnet":     "ip-subnets",
+	"IpAddressPoolStaticSubnet":    "ip-subnets",
+	"IdsRule":                      "rules",
+	"ChildResourceReference":       "",
+	"DomainDeploymentMap":          "domain-deployment-maps",
+	"SegmentQosProfileBindingMap":  "segment-qos-profile-binding-maps",
+	"PortQosProfileBindingMap":     "port-qos-profile-binding-maps",
+	"TlsRule":                      "rules",
+	"TlsInspectionExternalProfile": "tls-inspection-action-profiles",
+	"TlsInspectionInternalProfile": "tls-inspection-action-profiles",
+}
+
+// Attributes holding child objects that NSX returns inline with their parent
+var inlineChildren = map[string]struct {
+	attribute  string
+	collection string
+}{
+	"SecurityPolicy":    {"rules", "rules"},
+	"GatewayPolicy":     {"rules", "rules"},
+	"IdsSecurityPolicy": {"rules", "rules"},
+	"TlsPolicy":         {"rules", "rules"},
+	"Service":           {"service_entries", "service-entries"},
+}
+
+// Attributes that are managed by NSX and preserved across updates
+var systemAttributes = []string{
+	"id", "path", "parent_path", "relative_path", "resource_type", "marked_for_delete", "overridden",
+	"_revision", "_create_time", "_create_user", "_last_modified_time", "_last_modified_user",
+	"_system_owned", "_protection",
+}
+
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func newAPIError(status int, code int, format string, args ...interface{}) *apiError {
+	return &apiError{status: status, code: code, message: fmt.Sprintf(format, args...)}
+}
+
+func notFoundError(path string) *apiError {
+	return newAPIError(http.StatusNotFound, 500090, "The path=[%s] is invalid", path)
+}
+
+type object map[string]interface{}
+
+type store struct {
+	objects map[string]object
+	user    string
+}
+
+func newStore(user string) *store {
+	return &store{objects: make(map[string]object), user: user}
+}
+
+func (s *store) snapshot() map[string]object {
+	copied := make(map[string]object, len(s.objects))
+	for k, v := range s.objects {
+		copied[k] = v
+	}
+	return copied
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// isCollectionPath walks the path, expecting alternating collection names
+// and object IDs, with the exception of singleton segments
+func isCollectionPath(path string) bool {
+	expectCollection := true
+	for _, seg := range splitPath(path) {
+		if expectCollection {
+			if _, ok := singletonSegments[seg]; ok {
+				continue
+			}
+			expectCollection = false
+		} else {
+			expectCollection = true
+		}
+	}
+	return !expectCollection
+}
+
+// isImplicitContainer returns true for path anchors that always exist
+func (s *store) isImplicitContainer(path string) bool {
+	if path == "" || path == "/infra" || path == "/orgs/default" {
+		return true
+	}
+	if strings.HasSuffix(path, "/infra") {
+		_, ok := s.objects[strings.TrimSuffix(path, "/infra")]
+		return ok
+	}
+	return false
+}
+
+func parentPath(path string) string {
+	segs := splitPath(path)
+	last := segs[len(segs)-1]
+	if _, ok := singletonSegments[last]; ok {
+		return "/" + strings.Join(segs[:len(segs)-1], "/")
+	}
+	if len(segs) < 2 {
+		return ""
+	}
+	parent := strings.Join(segs[:len(segs)-2], "/")
+	if parent == "" {
+		return ""
+	}
+	return "/" + parent
+}
+
+func kebabToCamel(name string) string {
+	result := ""
+	for _, word := range strings.Split(name, "-") {
+		if len(word) == 0 {
+			continue
+		}
+		result += strings.ToUpper(word[:1]) + word[1:]
+	}
+	return result
+}
+
+func camelToKebab(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func inferResourceType(path string) string {
+	segs := splitPath(path)
+	last := segs[len(segs)-1]
+	if rType, ok := singletonSegments[last]; ok {
+		return rType
+	}
+	if len(segs) < 2 {
+		return ""
+	}
+	collection := segs[len(segs)-2]
+	if collection == "interfaces" {
+		if strings.Contains(path, "/tier-0s/") {
+			return "Tier0Interface"
+		}
+		return "Tier1Interface"
+	}
+	if rType, ok := collectionResourceTypes[collection]; ok {
+		return rType
+	}
+	return strings.TrimSuffix(kebabToCamel(collection), "s")
+}
+
+func collectionForResourceType(resourceType string) string {
+	if collection, ok := resourceTypeCollections[resourceType]; ok {
+		return collection
+	}
+	for collection, rType := range collectionResourceTypes {
+		if rType == resourceType {
+			return collection
+		}
+	}
+	return camelToKebab(resourceType) + "s"
+}
+
+func childPath(parent string, resourceType string, id string) string {
+	for seg, rType := range singletonSegments {
+		if rType == resourceType {
+			return parent + "/" + seg
+		}
+	}
+	return fmt.Sprintf("%s/%s/%s", parent, collectionForResourceType(resourceType), id)
+}
+
+func now() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func revisionOf(obj object) (int64, bool) {
+	value, ok := obj["_revision"]
+	if !ok || value == nil {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case interface{ Int64() (int64, error) }:
+		rev, err := v.Int64()
+		return rev, err == nil
+	}
+	return 0, false
+}
+
+// put creates or modifies object on given path. With replace set, attributes
+// that are not part of the body are removed (PUT semantics), otherwise body
+// is merged into the existing object (PATCH semantics)
+func (s *store) put(path string, body object, replace bool, enforceRevision bool) (object, *apiError) {
+	existing, exists := s.objects[path]
+	if !exists {
+		parent := parentPath(path)
+		if _, ok := s.objects[parent]; !ok && !s.isImplicitContainer(parent) {
+			return nil, newAPIError(http.StatusNotFound, 500012, "Parent object %s for %s was not found", parent, path)
+		}
+	}
+
+	if exists && enforceRevision {
+		if requested, ok := revisionOf(body); ok {
+			current, _ := revisionOf(existing)
+			if requested != current {
+				return nil, newAPIError(http.StatusPreconditionFailed, 604,
+					"The object AbstractObject [%s] was modified by somebody else (revision %d, expected %d)", path, requested, current)
+			}
+		} else if replace {
+			return nil, newAPIError(http.StatusPreconditionFailed, 604,
+				"Revision is required for modifying existing object %s", path)
+		}
+	}
+
+	var inline []interface{}
+	var inlineCollection string
+	var hasInline bool
+	rType, _ := body["resource_type"].(string)
+	if rType == "" && exists {
+		rType, _ = existing["resource_type"].(string)
+	}
+	if rType == "" {
+		rType = inferResourceType(path)
+	}
+	if spec, ok := inlineChildren[rType]; ok {
+		if value, present := body[spec.attribute]; present {
+			inline, _ = value.([]interface{})
+			inlineCollection = spec.collection
+			hasInline = true
+			delete(body, spec.attribute)
+		}
+	}
+
+	obj := make(object)
+	if exists && !replace {
+		for k, v := range existing {
+			obj[k] = v
+		}
+	}
+	for k, v := range body {
+		obj[k] = v
+	}
+	delete(obj, "children")
+
+	timestamp := now()
+	if exists {
+		for _, attr := range systemAttributes {
+			if v, ok := existing[attr]; ok {
+				obj[attr] = v
+			}
+		}
+		revision, _ := revisionOf(existing)
+		obj["_revision"] = revision + 1
+	} else {
+		segs := splitPath(path)
+		id := segs[len(segs)-1]
+		obj["id"] = id
+		obj["relative_path"] = id
+		obj["path"] = path
+		obj["parent_path"] = parentPath(path)
+		obj["marked_for_delete"] = false
+		obj["overridden"] = false
+		obj["_revision"] = int64(0)
+		obj["_create_time"] = timestamp
+		obj["_create_user"] = s.user
+		obj["_system_owned"] = false
+		obj["_protection"] = "NOT_PROTECTED"
+	}
+	obj["resource_type"] = rType
+	if _, ok := obj["display_name"]; !ok {
+		obj["display_name"] = obj["id"]
+	}
+	obj["_last_modified_time"] = timestamp
+	obj["_last_modified_user"] = s.user
+
+	s.objects[path] = obj
+
+	if hasInline {
+		collectionPath := path + "/" + inlineCollection
+		for _, childPath := range s.listPaths(collectionPath) {
+			s.delete(childPath)
+		}
+		for _, item := range inline {
+			child, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			id, _ := child["id"].(string)
+			if id == "" {
+				return nil, newAPIError(http.StatusBadRequest, 500013, "Inline child of %s is missing id", path)
+			}
+			if _, err := s.put(collectionPath+"/"+id, object(child), false, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return obj, nil
+}
+
+// get returns a copy of the object, with inline children populated
+func (s *store) get(path string) (object, bool) {
+	obj, ok := s.objects[path]
+	if !ok {
+		return nil, false
+	}
+	result := make(object, len(obj))
+	for k, v := range obj {
+		result[k] = v
+	}
+	rType, _ := obj["resource_type"].(string)
+	if spec, ok := inlineChildren[rType]; ok {
+		var children []interface{}
+		for _, childPath := range s.listPaths(path + "/" + spec.collection) {
+			child, _ := s.get(childPath)
+			children = append(children, child)
+		}
+		// NSX returns rules ordered by their sequence number
+		sort.SliceStable(children, func(i, j int) bool {
+			return sequenceNumberOf(children[i]) < sequenceNumberOf(children[j])
+		})
+		if len(children) > 0 {
+			result[spec.attribute] = children
+		}
+	}
+	return result, true
+}
+
+func sequenceNumberOf(child interface{}) float64 {
+	obj, _ := child.(object)
+	value, err := strconv.ParseFloat(fmt.Sprint(obj["sequence_number"]), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// listPaths returns sorted paths of objects directly under given collection
+func (s *store) listPaths(collectionPath string) []string {
+	var paths []string
+	prefix := collectionPath + "/"
+	for path := range s.objects {
+		if !strings.HasPrefix(path, prefix) {
+			continue
+		}
+		if strings.Contains(strings.TrimPrefix(path, prefix), "/") {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func (s *store) list(collectionPath string) []interface{} {
+	results := make([]interface{}, 0)
+	for _, path := range s.listPaths(collectionPath) {
+		obj, _ := s.get(path)
+		results = append(results, obj)
+	}
+	return results
+}
+
+// delete removes the object along with its entire subtree
+func (s *store) delete(path string) {
+	delete(s.objects, path)
+	prefix := path + "/"
+	for p := range s.objects {
+		if strings.HasPrefix(p, prefix) {
+			delete(s.objects, p)
+		}
+	}
+}
+
+// patchHierarchy applies H-API children list relative to parent path
+func (s *store) patchHierarchy(parent string, children []interface{}, enforceRevision bool) *apiError {
+	for _, item := range children {
+		child, ok := item.(map[string]interface{})
+		if !ok {
+			return newAPIError(http.StatusBadRequest, 500013, "Invalid child object under %s", parent)
+		}
+		wrapperType, _ := child["resource_type"].(string)
+		if wrapperType == "ChildResourceReference" {
+			id, _ := child["id"].(string)
+			targetType, _ := child["target_type"].(string)
+			path := childPath(parent, targetType, id)
+			if _, exists := s.objects[path]; !exists {
+				return notFoundError(path)
+			}
+			grandChildren, _ := child["children"].([]interface{})
+			if err := s.patchHierarchy(path, grandChildren, enforceRevision); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var inner map[string]interface{}
+		var innerKey string
+		for k, v := range child {
+			if len(k) > 0 && unicode.IsUpper(rune(k[0])) {
+				if m, ok := v.(map[string]interface{}); ok {
+					inner = m
+					innerKey = k
+					break
+				}
+			}
+		}
+		if inner == nil {
+			return newAPIError(http.StatusBadRequest, 500013, "Child %s under %s has no content", wrapperType, parent)
+		}
+		rType, _ := inner["resource_type"].(string)
+		if rType == "" {
+			rType = innerKey
+		}
+		id, _ := inner["id"].(string)
+		path := childPath(parent, rType, id)
+
+		if markedForDelete, _ := child["marked_for_delete"].(bool); markedForDelete {
+			s.delete(path)
+			continue
+		}
+
+		grandChildren, _ := inner["children"].([]interface{})
+		body := make(object, len(inner))
+		for k, v := range inner {
+			body[k] = v
+		}
+		if _, err := s.put(path, body, false, enforceRevision); err != nil {
+			return err
+		}
+		if err := s.patchHierarchy(path, grandChildren, enforceRevision); err != nil {
+			return err
+		}
+	}
+	return nil
+}
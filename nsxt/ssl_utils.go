@@ -4,6 +4,12 @@
 package nsxt
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -49,3 +55,72 @@ func getCertificateChainDepthSchema() *schema.Schema {
 		Default:     3,
 	}
 }
+
+// parsePEMCertificates decodes all CERTIFICATE blocks in PEM-encoded data.
+// Leaf certificate is expected to be the first one in the chain.
+func parsePEMCertificates(pemData string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(strings.TrimSpace(pemData))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return certs, nil
+}
+
+// parsePEMCrl decodes the first X509 CRL block in PEM-encoded data.
+func parsePEMCrl(pemData string) (*x509.RevocationList, error) {
+	rest := []byte(strings.TrimSpace(pemData))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded CRL found")
+		}
+		if block.Type == "X509 CRL" {
+			return x509.ParseRevocationList(block.Bytes)
+		}
+	}
+}
+
+func validatePEMCertificates() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (s []string, es []error) {
+		v, ok := i.(string)
+		if !ok {
+			es = append(es, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if _, err := parsePEMCertificates(v); err != nil {
+			es = append(es, fmt.Errorf("expected %s to contain PEM encoded certificates: %v", k, err))
+		}
+		return
+	}
+}
+
+func formatCertificateTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func getCertificateNotAfterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "Certificate expiry time in RFC3339 format",
+		Computed:    true,
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"hash/crc32"
 	"log"
+	"time"
 
 	"github.com/vmware/terraform-provider-nsxt/nsxt/util"
 
@@ -713,6 +714,20 @@ func getContextSchema(isRequired, isComputed, isVPC bool) *schema.Schema {
 	}
 }
 
+// getDataSourceContextSchema returns context schema for data sources, where
+// objects can be looked up either in project or in VPC within the project
+func getDataSourceContextSchema() *schema.Schema {
+	contextSchema := getContextSchema(false, false, false)
+	contextSchema.Elem.(*schema.Resource).Schema["vpc_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "Id of the VPC which the resource belongs to.",
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	return contextSchema
+}
+
 func getCustomizedMPTagsFromSchema(d *schema.ResourceData, schemaName string) []mp_model.Tag {
 	tags := d.Get(schemaName).(*schema.Set).List()
 	tagList := make([]mp_model.Tag, 0)
@@ -832,3 +847,33 @@ func setKeyValueListForSchema(kvList []mp_model.KeyValuePair) interface{} {
 	}
 	return kvIList
 }
+
+// getWaitTimeout returns how long to wait on NSX within given operation, as
+// configured in timeouts block. For backwards compatibility, deprecated timeout
+// attribute (in seconds) takes precedence if it was changed from its default.
+func getWaitTimeout(d *schema.ResourceData, key string, timeoutAttr string, timeoutAttrDefault int) time.Duration {
+	if timeoutAttr != "" {
+		if v, ok := d.GetOk(timeoutAttr); ok && v.(int) != timeoutAttrDefault {
+			return time.Duration(v.(int)) * time.Second
+		}
+	}
+	return d.Timeout(key)
+}
+
+// getCreateOrUpdateTimeoutKey returns timeouts block key for functions shared
+// between create and update
+func getCreateOrUpdateTimeoutKey(d *schema.ResourceData) string {
+	if d.IsNewResource() {
+		return schema.TimeoutCreate
+	}
+	return schema.TimeoutUpdate
+}
+
+// getCreateOrReadTimeoutKey returns timeouts block key for read function that
+// also completes the create operation
+func getCreateOrReadTimeoutKey(d *schema.ResourceData) string {
+	if d.IsNewResource() {
+		return schema.TimeoutCreate
+	}
+	return schema.TimeoutRead
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/infra"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/vmware/go-vmware-nsxt/trust"
 	"github.com/vmware/vsphere-automation-sdk-go/runtime/bindings"
@@ -779,3 +780,47 @@ func testAccGenerateTLSKeyPair() (string, string, error) {
 	privatePem = buf.String()
 	return publicPem, privatePem, nil
 }
+
+func TestGetWaitTimeout(t *testing.T) {
+	d := resourceNsxtPolicyIPAddressAllocation().TestResourceData()
+
+	d.Set("timeout", addressRealizationTimeoutDefault)
+	if timeout := getWaitTimeout(d, "create", "timeout", addressRealizationTimeoutDefault); timeout != 20*time.Minute {
+		t.Errorf("expected timeouts block value, got %v", timeout)
+	}
+
+	d.Set("timeout", 60)
+	if timeout := getWaitTimeout(d, "create", "timeout", addressRealizationTimeoutDefault); timeout != time.Minute {
+		t.Errorf("expected deprecated attribute value, got %v", timeout)
+	}
+
+	// timeouts block configured by the user
+	r := resourceNsxtPolicyIPAddressAllocation()
+	configured := 45 * time.Minute
+	r.Timeouts = &schema.ResourceTimeout{Create: &configured}
+	d = r.Data(nil)
+
+	d.Set("timeout", addressRealizationTimeoutDefault)
+	if timeout := getWaitTimeout(d, "create", "timeout", addressRealizationTimeoutDefault); timeout != configured {
+		t.Errorf("expected configured timeouts block value, got %v", timeout)
+	}
+	if timeout := getWaitTimeout(d, "delete", "timeout", addressRealizationTimeoutDefault); timeout != 20*time.Minute {
+		t.Errorf("expected default timeout for operation that was not configured, got %v", timeout)
+	}
+
+	// nested attribute, such as manager cluster api probing timeout
+	r = resourceNsxtManagerCluster()
+	r.Timeouts = &schema.ResourceTimeout{Create: &configured}
+	d = r.Data(nil)
+	if timeout := getWaitTimeout(d, "create", "api_probing.0.timeout", nodeConnectivityTimeout); timeout != configured {
+		t.Errorf("expected configured timeouts block value without api probing, got %v", timeout)
+	}
+	d.Set("api_probing", []interface{}{map[string]interface{}{"enabled": true, "timeout": nodeConnectivityTimeout}})
+	if timeout := getWaitTimeout(d, "create", "api_probing.0.timeout", nodeConnectivityTimeout); timeout != configured {
+		t.Errorf("expected configured timeouts block value with default api probing, got %v", timeout)
+	}
+	d.Set("api_probing", []interface{}{map[string]interface{}{"enabled": true, "timeout": 120}})
+	if timeout := getWaitTimeout(d, "create", "api_probing.0.timeout", nodeConnectivityTimeout); timeout != 2*time.Minute {
+		t.Errorf("expected api probing timeout, got %v", timeout)
+	}
+}
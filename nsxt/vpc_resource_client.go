@@ -0,0 +1,113 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"github.com/vmware/vsphere-automation-sdk-go/runtime/protocol/client"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/model"
+	"github.com/vmware/vsphere-automation-sdk-go/services/nsxt/orgs/projects/vpcs/nat"
+
+	utl "github.com/vmware/terraform-provider-nsxt/api/utl"
+)
+
+// VPC NAT rules managed by the user are placed in this NAT section
+const vpcUserNatID = model.PolicyNat_NAT_TYPE_USER
+
+// projectResourceSdkClient is implemented by SDK clients of objects placed
+// directly under project, such as VPC
+type projectResourceSdkClient[T any] interface {
+	Get(orgID string, projectID string, id string) (T, error)
+	Patch(orgID string, projectID string, id string, obj T) error
+	Delete(orgID string, projectID string, id string) error
+}
+
+// vpcResourceSdkClient is implemented by SDK clients of objects placed
+// directly under VPC, such as subnet or static route
+type vpcResourceSdkClient[T any] interface {
+	Get(orgID string, projectID string, vpcID string, id string) (T, error)
+	Patch(orgID string, projectID string, vpcID string, id string, obj T) error
+	Delete(orgID string, projectID string, vpcID string, id string) error
+}
+
+// projectResourceClient adapts project SDK client to policyResourceClient
+type projectResourceClient[T any] struct {
+	client    projectResourceSdkClient[T]
+	projectID string
+}
+
+func (c projectResourceClient[T]) Get(id string) (T, error) {
+	return c.client.Get(utl.DefaultOrgID, c.projectID, id)
+}
+
+func (c projectResourceClient[T]) Patch(id string, obj T, override *bool) error {
+	return c.client.Patch(utl.DefaultOrgID, c.projectID, id, obj)
+}
+
+func (c projectResourceClient[T]) Delete(id string, override *bool) error {
+	return c.client.Delete(utl.DefaultOrgID, c.projectID, id)
+}
+
+// vpcResourceClient adapts VPC SDK client to policyResourceClient
+type vpcResourceClient[T any] struct {
+	client  vpcResourceSdkClient[T]
+	parents []string
+}
+
+func (c vpcResourceClient[T]) Get(id string) (T, error) {
+	return c.client.Get(c.parents[0], c.parents[1], c.parents[2], id)
+}
+
+func (c vpcResourceClient[T]) Patch(id string, obj T, override *bool) error {
+	return c.client.Patch(c.parents[0], c.parents[1], c.parents[2], id, obj)
+}
+
+func (c vpcResourceClient[T]) Delete(id string, override *bool) error {
+	return c.client.Delete(c.parents[0], c.parents[1], c.parents[2], id)
+}
+
+// newProjectResourceClientFactory adapts SDK client constructor, such as
+// projects.NewVpcsClient, for use in metadata driven resource. Objects are
+// only supported in multitenancy context.
+func newProjectResourceClientFactory[T any, C projectResourceSdkClient[T]](newClient func(client.Connector) C) policyResourceClientFactory[T] {
+	return func(sessionContext utl.SessionContext, connector client.Connector) policyResourceClient[T] {
+		if sessionContext.ClientType != utl.Multitenancy {
+			return nil
+		}
+		return projectResourceClient[T]{client: newClient(connector), projectID: sessionContext.ProjectID}
+	}
+}
+
+// newVpcResourceClientFactory adapts SDK client constructor, such as
+// vpcs.NewSubnetsClient, for use in metadata driven resource. Objects are
+// only supported in VPC context.
+func newVpcResourceClientFactory[T any, C vpcResourceSdkClient[T]](newClient func(client.Connector) C) policyResourceClientFactory[T] {
+	return func(sessionContext utl.SessionContext, connector client.Connector) policyResourceClient[T] {
+		if sessionContext.ClientType != utl.VPC {
+			return nil
+		}
+		return vpcResourceClient[T]{client: newClient(connector), parents: getVpcParentsFromContext(sessionContext)}
+	}
+}
+
+// vpcUserNatRulesClient exposes NAT rules of VPC user NAT section with
+// vpcResourceSdkClient signatures
+type vpcUserNatRulesClient struct {
+	client nat.NatRulesClient
+}
+
+func newVpcUserNatRulesClient(connector client.Connector) vpcUserNatRulesClient {
+	return vpcUserNatRulesClient{client: nat.NewNatRulesClient(connector)}
+}
+
+func (c vpcUserNatRulesClient) Get(orgID string, projectID string, vpcID string, id string) (model.PolicyVpcNatRule, error) {
+	return c.client.Get(orgID, projectID, vpcID, vpcUserNatID, id)
+}
+
+func (c vpcUserNatRulesClient) Patch(orgID string, projectID string, vpcID string, id string, obj model.PolicyVpcNatRule) error {
+	return c.client.Patch(orgID, projectID, vpcID, vpcUserNatID, id, obj)
+}
+
+func (c vpcUserNatRulesClient) Delete(orgID string, projectID string, vpcID string, id string) error {
+	return c.client.Delete(orgID, projectID, vpcID, vpcUserNatID, id)
+}
@@ -0,0 +1,151 @@
+/* Copyright © 2024 Broadcom, Inc. All Rights Reserved.
+   SPDX-License-Identifier: MPL-2.0 */
+
+package nsxt
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/vmware/terraform-provider-nsxt/nsxt/simulator"
+)
+
+func TestVPCResourcesCrud(t *testing.T) {
+	srv := simulator.NewServer()
+	defer srv.Close()
+	srv.Seed("/orgs/default/projects/proj1", map[string]interface{}{"resource_type": "Project"})
+	srv.Seed("/orgs/default/projects/proj1/vpcs/vpc1/nat/USER", map[string]interface{}{"resource_type": "PolicyNat"})
+	m := getTestSimulatorProviderMeta(t, srv)
+
+	projectContext := []interface{}{map[string]interface{}{"project_id": "proj1"}}
+	vpcContext := []interface{}{map[string]interface{}{"project_id": "proj1", "vpc_id": "vpc1"}}
+	tests := []struct {
+		name         string
+		resource     *schema.Resource
+		config       map[string]interface{}
+		expectedPath string
+		checkObj     func(obj map[string]interface{}) bool
+	}{
+		{
+			name:     "vpc",
+			resource: resourceNsxtVPC(),
+			config: map[string]interface{}{
+				"context":             projectContext,
+				"private_ipv4_blocks": []interface{}{"/orgs/default/projects/proj1/infra/ip-blocks/block1"},
+				"service_gateway":     []interface{}{map[string]interface{}{"disable": false, "auto_snat": true}},
+			},
+			expectedPath: "/orgs/default/projects/proj1/vpcs/vpc2",
+			checkObj: func(obj map[string]interface{}) bool {
+				return len(obj["private_ipv4_blocks"].([]interface{})) == 1 && obj["service_gateway"].(map[string]interface{})["auto_snat"] == true
+			},
+		},
+		{
+			name:     "subnet",
+			resource: resourceNsxtVPCSubnet(),
+			config: map[string]interface{}{
+				"context":          vpcContext,
+				"ipv4_subnet_size": 16,
+				"access_mode":      "Isolated",
+			},
+			expectedPath: "/orgs/default/projects/proj1/vpcs/vpc1/subnets/subnet1",
+			checkObj: func(obj map[string]interface{}) bool {
+				return obj["access_mode"] == "Isolated" && fmt.Sprint(obj["ipv4_subnet_size"]) == "16"
+			},
+		},
+		{
+			name:     "static route",
+			resource: resourceNsxtVPCStaticRoute(),
+			config: map[string]interface{}{
+				"context":  vpcContext,
+				"network":  "10.0.0.0/24",
+				"next_hop": []interface{}{map[string]interface{}{"ip_address": "192.168.1.1", "admin_distance": 2}},
+			},
+			expectedPath: "/orgs/default/projects/proj1/vpcs/vpc1/static-routes/route1",
+			checkObj: func(obj map[string]interface{}) bool {
+				return obj["network"] == "10.0.0.0/24" && len(obj["next_hops"].([]interface{})) == 1
+			},
+		},
+		{
+			name:     "nat rule",
+			resource: resourceNsxtVPCNatRule(),
+			config: map[string]interface{}{
+				"context":            vpcContext,
+				"action":             "SNAT",
+				"source_network":     "10.0.0.0/24",
+				"translated_network": "192.168.1.10",
+			},
+			expectedPath: "/orgs/default/projects/proj1/vpcs/vpc1/nat/USER/nat-rules/rule1",
+			checkObj: func(obj map[string]interface{}) bool {
+				_, hasDestination := obj["destination_network"]
+				_, hasSequence := obj["sequence_number"]
+				return obj["action"] == "SNAT" && obj["enabled"] == true && !hasDestination && !hasSequence
+			},
+		},
+		{
+			name:     "ip address allocation",
+			resource: resourceNsxtVPCIPAddressAllocation(),
+			config: map[string]interface{}{
+				"context": vpcContext,
+			},
+			expectedPath: "/orgs/default/projects/proj1/vpcs/vpc1/ip-address-allocations/alloc1",
+			checkObj: func(obj map[string]interface{}) bool {
+				_, hasIP := obj["allocation_ip"]
+				return obj["ip_address_block_visibility"] == "EXTERNAL" && obj["ip_address_type"] == "IPV4" && !hasIP
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := test.resource
+			test.config["nsx_id"] = getPolicyIDFromPath(test.expectedPath)
+			test.config["display_name"] = test.name
+			d := schema.TestResourceDataRaw(t, r.Schema, test.config)
+			if diags := r.CreateContext(context.Background(), d, m); diags.HasError() {
+				t.Fatalf("create failed: %v", diags)
+			}
+			if d.Get("path").(string) != test.expectedPath {
+				t.Errorf("expected path %s, got %s", test.expectedPath, d.Get("path"))
+			}
+			obj, ok := srv.Get(test.expectedPath)
+			if !ok {
+				t.Fatal("object was not created on NSX")
+			}
+			if obj["display_name"] != test.name || !test.checkObj(obj) {
+				t.Errorf("unexpected object on NSX: %v", obj)
+			}
+
+			// import by path restores context
+			imported := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			imported.SetId(test.expectedPath)
+			if _, err := r.Importer.State(imported, m); err != nil {
+				t.Fatalf("import failed: %v", err)
+			}
+			if diags := r.ReadContext(context.Background(), imported, m); diags.HasError() {
+				t.Fatalf("read after import failed: %v", diags)
+			}
+			if imported.Get("display_name") != test.name || imported.Get("path") != test.expectedPath {
+				t.Errorf("unexpected state after import: %v", imported.State())
+			}
+
+			if diags := r.DeleteContext(context.Background(), d, m); diags.HasError() {
+				t.Fatalf("delete failed: %v", diags)
+			}
+			if _, ok := srv.Get(test.expectedPath); ok {
+				t.Errorf("object was not deleted on NSX")
+			}
+		})
+	}
+
+	// VPC objects require VPC context
+	d := schema.TestResourceDataRaw(t, resourceNsxtVPCSubnet().Schema, map[string]interface{}{
+		"display_name": "test",
+		"context":      projectContext,
+	})
+	if diags := resourceNsxtVPCSubnet().CreateContext(context.Background(), d, m); !diags.HasError() {
+		t.Errorf("expected subnet create without VPC to fail")
+	}
+}